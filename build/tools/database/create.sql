@@ -6,6 +6,33 @@ CREATE TYPE status AS ENUM (
     'deleted'
 );
 
+CREATE TYPE audit_action AS ENUM (
+    'disable',
+    'enable',
+    'delete',
+    'purge',
+    'restore',
+    'rollback'
+);
+
+CREATE TYPE release_schedule_state AS ENUM (
+    'pending',
+    'failed'
+);
+
+CREATE TYPE approval_decision AS ENUM (
+    'approve',
+    'reject'
+);
+
+CREATE TYPE realm_event_type AS ENUM (
+    'created',
+    'updated',
+    'released',
+    'disabled',
+    'deleted'
+);
+
 CREATE TABLE realms (
     key         UUID PRIMARY KEY,
     id          UUID NOT NULL,
@@ -14,6 +41,126 @@ CREATE TABLE realms (
     status      status  NOT NULL,
     created_at  TIMESTAMP   NOT NULL,
     updated_at  TIMESTAMP   NOT NULL,
-    deleted_at  TIMESTAMP
+    deleted_at  TIMESTAMP,
+    previous_status status,
+    revision    BIGINT  NOT NULL DEFAULT 1,
+    labels      JSONB   NOT NULL DEFAULT '{}',
+    attributes  JSONB   NOT NULL DEFAULT '{}',
+    parent_id   UUID,
+    cloned_from UUID,
+    template_id UUID,
+    template_version BIGINT,
+    slug        VARCHAR(63),
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED
+);
+
+CREATE UNIQUE INDEX realms_id_status_idx ON realms (id, status) WHERE status <> 'deleted';
+CREATE INDEX realms_labels_idx ON realms USING GIN (labels);
+CREATE INDEX realms_search_vector_idx ON realms USING GIN (search_vector);
+CREATE INDEX realms_parent_id_idx ON realms (parent_id);
+
+CREATE TABLE realm_slugs (
+    slug        VARCHAR(63) PRIMARY KEY,
+    realm_id    UUID NOT NULL,
+    created_at  TIMESTAMP   NOT NULL,
+    expires_at  TIMESTAMP
+);
+
+CREATE INDEX realm_slugs_realm_id_idx ON realm_slugs (realm_id);
+
+CREATE TABLE realm_audit_log (
+    key         UUID PRIMARY KEY,
+    realm_id    UUID NOT NULL,
+    action      audit_action NOT NULL,
+    reason      TEXT NOT NULL,
+    created_at  TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX realm_audit_log_realm_id_idx ON realm_audit_log (realm_id);
+
+CREATE TABLE realm_events (
+    sequence       BIGSERIAL PRIMARY KEY,
+    transaction_id BIGINT  NOT NULL DEFAULT pg_current_xact_id()::text::bigint,
+    realm_id       UUID NOT NULL,
+    event_type     realm_event_type NOT NULL,
+    status         status  NOT NULL,
+    occurred_at    TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX realm_events_position_idx ON realm_events (transaction_id, sequence);
+CREATE INDEX realm_events_realm_id_idx ON realm_events (realm_id, transaction_id, sequence);
+
+CREATE TABLE realm_revisions (
+    key         UUID PRIMARY KEY,
+    realm_id    UUID NOT NULL,
+    revision    BIGINT  NOT NULL,
+    name        VARCHAR(50) NOT NULL,
+    description TEXT,
+    status      status  NOT NULL,
+    created_at  TIMESTAMP   NOT NULL,
+    updated_at  TIMESTAMP   NOT NULL,
+    labels      JSONB   NOT NULL DEFAULT '{}',
+    attributes  JSONB   NOT NULL DEFAULT '{}',
+    parent_id   UUID,
+    released_by TEXT    NOT NULL,
+    released_at TIMESTAMP   NOT NULL,
+    UNIQUE (realm_id, revision)
+);
+
+CREATE TABLE realm_release_schedules (
+    realm_id        UUID PRIMARY KEY,
+    release_at      TIMESTAMP   NOT NULL,
+    scheduled_by    TEXT    NOT NULL,
+    state           release_schedule_state  NOT NULL,
+    attempts        INT     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP   NOT NULL,
+    last_error      TEXT    NOT NULL DEFAULT '',
+    created_at      TIMESTAMP   NOT NULL,
+    updated_at      TIMESTAMP   NOT NULL
 );
 
+CREATE INDEX realm_release_schedules_due_idx ON realm_release_schedules (next_attempt_at) WHERE state = 'pending';
+
+CREATE TABLE realm_release_approvals (
+    realm_id     UUID PRIMARY KEY,
+    revision     BIGINT  NOT NULL,
+    name         VARCHAR(50) NOT NULL,
+    description  TEXT,
+    created_at   TIMESTAMP   NOT NULL,
+    updated_at   TIMESTAMP   NOT NULL,
+    labels       JSONB   NOT NULL DEFAULT '{}',
+    attributes   JSONB   NOT NULL DEFAULT '{}',
+    parent_id    UUID,
+    requested_by TEXT    NOT NULL,
+    requested_at TIMESTAMP   NOT NULL
+);
+
+CREATE TABLE realm_release_approval_decisions (
+    key        UUID PRIMARY KEY,
+    realm_id   UUID NOT NULL,
+    revision   BIGINT  NOT NULL,
+    reviewer   TEXT    NOT NULL,
+    decision   approval_decision   NOT NULL,
+    comment    TEXT    NOT NULL,
+    decided_at TIMESTAMP   NOT NULL,
+    UNIQUE (realm_id, revision, reviewer)
+);
+
+CREATE TABLE realm_templates (
+    key               UUID PRIMARY KEY,
+    id                UUID NOT NULL,
+    version           BIGINT  NOT NULL,
+    name              VARCHAR(50) NOT NULL,
+    description       TEXT,
+    realm_description TEXT,
+    labels            JSONB   NOT NULL DEFAULT '{}',
+    attributes        JSONB   NOT NULL DEFAULT '{}',
+    parameters        JSONB   NOT NULL DEFAULT '[]',
+    created_by        TEXT    NOT NULL,
+    created_at        TIMESTAMP   NOT NULL,
+    deleted_at        TIMESTAMP,
+    UNIQUE (id, version)
+);
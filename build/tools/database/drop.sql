@@ -1,3 +1,27 @@
+DROP TABLE IF EXISTS "realm_events";
+
+DROP TABLE IF EXISTS "realm_slugs";
+
+DROP TABLE IF EXISTS "realm_templates";
+
+DROP TABLE IF EXISTS "realm_release_approval_decisions";
+
+DROP TABLE IF EXISTS "realm_release_approvals";
+
+DROP TABLE IF EXISTS "realm_release_schedules";
+
+DROP TABLE IF EXISTS "realm_revisions";
+
+DROP TABLE IF EXISTS "realm_audit_log";
+
 DROP TABLE IF EXISTS "realms";
 
+DROP TYPE IF EXISTS "realm_event_type";
+
+DROP TYPE IF EXISTS "approval_decision";
+
+DROP TYPE IF EXISTS "release_schedule_state";
+
+DROP TYPE IF EXISTS "audit_action";
+
 DROP TYPE IF EXISTS "status";
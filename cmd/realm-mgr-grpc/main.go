@@ -33,6 +33,18 @@ func run() exitCode {
 		return exitCodeAppInitError
 	}
 
+	workerCtx, cancelWorker := context.WithCancel(ctx)
+	defer cancelWorker()
+
+	// Run the scheduled release worker
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+
+		fmt.Println("INFO: Starting scheduled release worker...")
+		app.releaseWorker.Run(workerCtx)
+	}()
+
 	endChan := make(chan os.Signal, 1)
 	signal.Notify(endChan, syscall.SIGINT, syscall.SIGTERM)
 
@@ -50,6 +62,16 @@ func run() exitCode {
 	// until an interrupt signal is put on it
 	<-endChan
 
+	fmt.Println("INFO: Stopping scheduled release worker...")
+
+	cancelWorker()
+	<-workerDone
+
+	// Realm watches never end on their own and would block the graceful shutdown
+	fmt.Println("INFO: Stopping realm watches...")
+
+	app.realmWatcher.Stop()
+
 	fmt.Println("INFO: Killing gRPC server....")
 
 	if shutdownErr := app.grpcServer.Shutdown(ctx); shutdownErr != nil {
@@ -3,18 +3,24 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"google.golang.org/grpc"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	flag "github.com/spf13/pflag"
 
+	adaptercommon "github.com/alexZaicev/realm-mgr/internal/adapters/common"
 	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc"
 	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/attributeschema"
+	realmmgr_clock "github.com/alexZaicev/realm-mgr/internal/drivers/clock"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/config"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/grpcserver"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/pgdb"
+	"github.com/alexZaicev/realm-mgr/internal/usecases/realms"
 )
 
 const (
@@ -31,15 +37,43 @@ const (
 	configDBPass    = "database.password"
 	configDBName    = "database.name"
 	configDBSSLMode = "database.ssl_mode"
+
+	configRealmsRestoreRetentionDays = "realms.restore_retention_days"
+	configRealmsBatchGetMaxBatchSize = "realms.batch_get.max_batch_size"
+	configRealmsAttributesSchemas    = "realms.attributes.schemas"
+	configRealmsHierarchyMaxDepth    = "realms.hierarchy.max_depth"
+	configRealmsSlugsRedirectDays    = "realms.slugs.redirect_retention_days"
+	configRealmsStatsCacheTTLSeconds = "realms.stats.cache_ttl_seconds"
+
+	configReleaseApprovalsRequiredApprovals = "realms.release_approvals.required_approvals"
+
+	configScheduledReleasesPollIntervalSeconds = "realms.scheduled_releases.poll_interval_seconds"
+	configScheduledReleasesBatchSize           = "realms.scheduled_releases.batch_size"
+	configScheduledReleasesMaxAttempts         = "realms.scheduled_releases.max_attempts"
+	configScheduledReleasesRetryBackoffSeconds = "realms.scheduled_releases.retry_backoff_seconds"
+
+	configWatchPollIntervalMilliseconds = "realms.watch.poll_interval_milliseconds"
+	configWatchBatchSize                = "realms.watch.batch_size"
+
+	configExportPageSize  = "realms.export.page_size"
+	configImportBatchSize = "realms.import.batch_size"
 )
 
 type application struct {
-	grpcServer *grpcserver.Server
+	grpcServer    *grpcserver.Server
+	releaseWorker *adaptercommon.ScheduledReleaseWorker
+	realmWatcher  *adaptercommon.RealmWatcher
 }
 
-func newApplication(grpcServer *grpcserver.Server) *application {
+func newApplication(
+	grpcServer *grpcserver.Server,
+	releaseWorker *adaptercommon.ScheduledReleaseWorker,
+	realmWatcher *adaptercommon.RealmWatcher,
+) *application {
 	return &application{
-		grpcServer: grpcServer,
+		grpcServer:    grpcServer,
+		releaseWorker: releaseWorker,
+		realmWatcher:  realmWatcher,
 	}
 }
 
@@ -95,6 +129,176 @@ func newDBOptions() ([]pgdb.DBOption, error) {
 	return nil, nil
 }
 
+// newAttributeValidatorFromConfig compiles the JSON schemas of realm attributes. Schemas are
+// configured as JSON documents keyed by the top-level attribute they apply to.
+func newAttributeValidatorFromConfig(cfg config.Config) (*attributeschema.JSONSchemaValidator, error) {
+	schemaConfig, err := config.Get[map[string]interface{}](cfg, configRealmsAttributesSchemas)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make(map[string]string, len(schemaConfig))
+	for key, value := range schemaConfig {
+		schema, ok := value.(string)
+		if !ok {
+			return nil, realmmgr_errors.NewInvalidArgumentError(
+				fmt.Sprintf("%s.%s", configRealmsAttributesSchemas, key),
+				"must be a JSON schema document",
+			)
+		}
+		schemas[key] = schema
+	}
+
+	return attributeschema.NewJSONSchemaValidator(schemas)
+}
+
+func newBatchGetRealmsFromConfig(cfg config.Config) (*realms.BatchGetRealms, error) {
+	maxBatchSize, err := config.Get[int](cfg, configRealmsBatchGetMaxBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return realms.NewBatchGetRealms(maxBatchSize)
+}
+
+func newRealmHierarchyFromConfig(cfg config.Config) (*realms.RealmHierarchy, error) {
+	maxDepth, err := config.Get[int](cfg, configRealmsHierarchyMaxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	return realms.NewRealmHierarchy(maxDepth)
+}
+
+func newGetRealmStatsFromConfig(cfg config.Config) (*realms.GetRealmStats, error) {
+	cacheTTLSeconds, err := config.Get[int](cfg, configRealmsStatsCacheTTLSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return realms.NewGetRealmStats(time.Duration(cacheTTLSeconds) * time.Second)
+}
+
+func newRestoreRealmFromConfig(cfg config.Config) (*realms.RestoreRealm, error) {
+	retentionDays, err := config.Get[int](cfg, configRealmsRestoreRetentionDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return realms.NewRestoreRealm(time.Duration(retentionDays) * 24 * time.Hour)
+}
+
+func newRenameRealmSlugFromConfig(cfg config.Config) (*realms.RenameRealmSlug, error) {
+	redirectDays, err := config.Get[int](cfg, configRealmsSlugsRedirectDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return realms.NewRenameRealmSlug(time.Duration(redirectDays) * 24 * time.Hour)
+}
+
+func newReleaseRealmFromConfig(cfg config.Config, hierarchy *realms.RealmHierarchy) (*realms.ReleaseRealm, error) {
+	requiredApprovals, err := config.Get[int](cfg, configReleaseApprovalsRequiredApprovals)
+	if err != nil {
+		return nil, err
+	}
+
+	return realms.NewReleaseRealm(requiredApprovals, hierarchy)
+}
+
+func newRecordReleaseFailureFromConfig(cfg config.Config) (*realms.RecordReleaseFailure, error) {
+	maxAttempts, err := config.Get[int](cfg, configScheduledReleasesMaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	retryBackoffSeconds, err := config.Get[int](cfg, configScheduledReleasesRetryBackoffSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return realms.NewRecordReleaseFailure(maxAttempts, time.Duration(retryBackoffSeconds)*time.Second)
+}
+
+func newScheduledReleaseWorkerFromConfig(
+	cfg config.Config,
+	logger logging.Logger,
+	clock realmmgr_clock.Clock,
+	dataStoreManager adaptercommon.DataStoreManager,
+	dueLister adaptercommon.DueReleaseLister,
+	releaseClaimer adaptercommon.DueReleaseClaimer,
+	realmReleaser adaptercommon.RealmReleaser,
+	failureRecorder adaptercommon.ReleaseFailureRecorder,
+) (*adaptercommon.ScheduledReleaseWorker, error) {
+	pollIntervalSeconds, err := config.Get[int](cfg, configScheduledReleasesPollIntervalSeconds)
+	if err != nil {
+		return nil, err
+	}
+	batchSize, err := config.Get[int](cfg, configScheduledReleasesBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return adaptercommon.NewScheduledReleaseWorker(
+		logger,
+		clock,
+		dataStoreManager,
+		dueLister,
+		releaseClaimer,
+		realmReleaser,
+		failureRecorder,
+		time.Duration(pollIntervalSeconds)*time.Second,
+		batchSize,
+	)
+}
+
+func newRealmWatcherFromConfig(
+	cfg config.Config,
+	dataStoreManager adaptercommon.DataStoreManager,
+	eventLister adaptercommon.RealmEventLister,
+) (*adaptercommon.RealmWatcher, error) {
+	pollIntervalMilliseconds, err := config.Get[int](cfg, configWatchPollIntervalMilliseconds)
+	if err != nil {
+		return nil, err
+	}
+	batchSize, err := config.Get[int](cfg, configWatchBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return adaptercommon.NewRealmWatcher(
+		dataStoreManager,
+		eventLister,
+		time.Duration(pollIntervalMilliseconds)*time.Millisecond,
+		batchSize,
+	)
+}
+
+func newRealmTransferExecutorFromConfig(
+	cfg config.Config,
+	clock realmmgr_clock.Clock,
+	dataStoreManager adaptercommon.DataStoreManager,
+	exporter adaptercommon.RealmExporter,
+	importer adaptercommon.RealmImporter,
+) (*adaptercommon.RealmTransferExecutor, error) {
+	exportPageSize, err := config.Get[int](cfg, configExportPageSize)
+	if err != nil {
+		return nil, err
+	}
+	importBatchSize, err := config.Get[int](cfg, configImportBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return adaptercommon.NewRealmTransferExecutor(
+		clock,
+		dataStoreManager,
+		exporter,
+		importer,
+		exportPageSize,
+		importBatchSize,
+	)
+}
+
 func newGRPCServerFromConfig(
 	cfg config.Config,
 	services []grpcserver.Service,
@@ -122,8 +326,14 @@ func newGRPCServerOptions(logger logging.Logger) []grpc.ServerOption {
 	return []grpc.ServerOption{
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			interceptors.LoggerUnaryServerInterceptor(logger),
+			interceptors.ActorUnaryServerInterceptor(logger),
 			interceptors.ValidateUnaryServerInterceptor(logger),
 		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			interceptors.LoggerStreamServerInterceptor(logger),
+			interceptors.ActorStreamServerInterceptor(logger),
+			interceptors.ValidateStreamServerInterceptor(logger),
+		)),
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	adaptercommon "github.com/alexZaicev/realm-mgr/internal/adapters/common"
 	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres"
 	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/attributeschema"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/pgdb"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/uuidgenerator"
@@ -29,6 +30,9 @@ func initialize(ctx context.Context, cfgFilePath string) (*application, error) {
 		newConfigStore,
 		// Logger
 		newLoggerFromConfig,
+		// Attribute schemas
+		newAttributeValidatorFromConfig,
+		wire.Bind(new(attributeschema.Validator), new(*attributeschema.JSONSchemaValidator)),
 		// Repositories
 		newDBOptions,
 		newDBConnectionProviderFromConfig,
@@ -39,18 +43,95 @@ func initialize(ctx context.Context, cfgFilePath string) (*application, error) {
 		wire.Bind(new(adaptercommon.PgDatastoreLifeCycleManager), new(*postgres.DataStoreLifecycleManager)),
 		wire.Bind(new(adaptercommon.DataStoreManager), new(*adaptercommon.PgDataStoreManager)),
 		// UseCases
+		newRealmHierarchyFromConfig,
 		realms.NewGetRealm,
+		realms.NewGetRealmBySlug,
+		realms.NewGetRealmAncestors,
+		newBatchGetRealmsFromConfig,
+		realms.NewListRealms,
+		realms.NewSearchRealms,
+		newGetRealmStatsFromConfig,
 		realms.NewCreateRealm,
-		realms.NewReleaseRealm,
+		realms.NewCloneRealm,
+		newRenameRealmSlugFromConfig,
+		newReleaseRealmFromConfig,
 		realms.NewUpdateRealm,
+		realms.NewDisableRealm,
+		realms.NewEnableRealm,
+		realms.NewDeleteRealm,
+		newRestoreRealmFromConfig,
+		realms.NewDiscardDraft,
+		realms.NewRollbackRealm,
+		realms.NewScheduleRelease,
+		realms.NewCancelScheduledRelease,
+		realms.NewRequestReleaseApproval,
+		realms.NewReviewRelease,
+		realms.NewListDueReleases,
+		realms.NewClaimDueRelease,
+		newRecordReleaseFailureFromConfig,
+		realms.NewDiffRealm,
+		realms.NewGetRealmRevision,
+		realms.NewListRealmRevisions,
+		realms.NewListRealmEvents,
+		realms.NewExportRealms,
+		realms.NewImportRealms,
+		realms.NewCreateRealmTemplate,
+		realms.NewGetRealmTemplate,
+		realms.NewListRealmTemplates,
+		realms.NewUpdateRealmTemplate,
+		realms.NewDeleteRealmTemplate,
+		realms.NewCreateRealmFromTemplate,
 		// UseCase executors
 		wire.Bind(new(adaptercommon.RealmGetter), new(*realms.GetRealm)),
+		wire.Bind(new(adaptercommon.RealmBySlugGetter), new(*realms.GetRealmBySlug)),
+		wire.Bind(new(adaptercommon.RealmAncestorsGetter), new(*realms.GetRealmAncestors)),
+		wire.Bind(new(adaptercommon.RealmBatchGetter), new(*realms.BatchGetRealms)),
+		wire.Bind(new(adaptercommon.RealmLister), new(*realms.ListRealms)),
+		wire.Bind(new(adaptercommon.RealmSearcher), new(*realms.SearchRealms)),
+		wire.Bind(new(adaptercommon.RealmStatsGetter), new(*realms.GetRealmStats)),
 		wire.Bind(new(adaptercommon.RealmCreator), new(*realms.CreateRealm)),
+		wire.Bind(new(adaptercommon.RealmCloner), new(*realms.CloneRealm)),
+		wire.Bind(new(adaptercommon.RealmSlugRenamer), new(*realms.RenameRealmSlug)),
 		wire.Bind(new(adaptercommon.RealmReleaser), new(*realms.ReleaseRealm)),
+		wire.Bind(new(adaptercommon.RealmReleaseScheduler), new(*realms.ScheduleRelease)),
+		wire.Bind(new(adaptercommon.ScheduledReleaseCanceler), new(*realms.CancelScheduledRelease)),
+		wire.Bind(new(adaptercommon.RealmApprovalRequester), new(*realms.RequestReleaseApproval)),
+		wire.Bind(new(adaptercommon.RealmReleaseReviewer), new(*realms.ReviewRelease)),
 		wire.Bind(new(adaptercommon.RealmUpdater), new(*realms.UpdateRealm)),
+		wire.Bind(new(adaptercommon.RealmDisabler), new(*realms.DisableRealm)),
+		wire.Bind(new(adaptercommon.RealmEnabler), new(*realms.EnableRealm)),
+		wire.Bind(new(adaptercommon.RealmDeleter), new(*realms.DeleteRealm)),
+		wire.Bind(new(adaptercommon.RealmRestorer), new(*realms.RestoreRealm)),
+		wire.Bind(new(adaptercommon.RealmDraftDiscarder), new(*realms.DiscardDraft)),
+		wire.Bind(new(adaptercommon.RealmRollbacker), new(*realms.RollbackRealm)),
+		wire.Bind(new(adaptercommon.RealmDiffer), new(*realms.DiffRealm)),
+		wire.Bind(new(adaptercommon.RealmRevisionGetter), new(*realms.GetRealmRevision)),
+		wire.Bind(new(adaptercommon.RealmRevisionLister), new(*realms.ListRealmRevisions)),
 		adaptercommon.NewRealmUseCaseExecutor,
+		wire.Bind(new(adaptercommon.RealmTemplateCreator), new(*realms.CreateRealmTemplate)),
+		wire.Bind(new(adaptercommon.RealmTemplateGetter), new(*realms.GetRealmTemplate)),
+		wire.Bind(new(adaptercommon.RealmTemplateLister), new(*realms.ListRealmTemplates)),
+		wire.Bind(new(adaptercommon.RealmTemplateUpdater), new(*realms.UpdateRealmTemplate)),
+		wire.Bind(new(adaptercommon.RealmTemplateDeleter), new(*realms.DeleteRealmTemplate)),
+		wire.Bind(new(adaptercommon.RealmTemplateInstantiator), new(*realms.CreateRealmFromTemplate)),
+		adaptercommon.NewRealmTemplateUseCaseExecutor,
+		// Scheduled release worker
+		wire.Bind(new(adaptercommon.DueReleaseLister), new(*realms.ListDueReleases)),
+		wire.Bind(new(adaptercommon.DueReleaseClaimer), new(*realms.ClaimDueRelease)),
+		wire.Bind(new(adaptercommon.ReleaseFailureRecorder), new(*realms.RecordReleaseFailure)),
+		newScheduledReleaseWorkerFromConfig,
+		// Realm watcher
+		wire.Bind(new(adaptercommon.RealmEventLister), new(*realms.ListRealmEvents)),
+		newRealmWatcherFromConfig,
+		// Realm transfers
+		wire.Bind(new(adaptercommon.RealmExporter), new(*realms.ExportRealms)),
+		wire.Bind(new(adaptercommon.RealmImporter), new(*realms.ImportRealms)),
+		newRealmTransferExecutorFromConfig,
 		// gRPC server
 		wire.Bind(new(realmmgrgrpc.RealmOps), new(*adaptercommon.RealmUseCaseExecutor)),
+		wire.Bind(new(realmmgrgrpc.RealmTemplateOps), new(*adaptercommon.RealmTemplateUseCaseExecutor)),
+		wire.Bind(new(realmmgrgrpc.RealmWatchOps), new(*adaptercommon.RealmWatcher)),
+		wire.Bind(new(realmmgrgrpc.RealmTransferOps), new(*adaptercommon.RealmTransferExecutor)),
 		realmmgrgrpc.NewRealmManagerAPI,
 		realmmgrgrpc.NewHealthChecker,
 		newGRPCServices,
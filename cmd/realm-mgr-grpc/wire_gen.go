@@ -47,15 +47,110 @@ func initialize(ctx context.Context, cfgFilePath string) (*application, error) {
 	if err != nil {
 		return nil, err
 	}
-	getRealm := realms.NewGetRealm()
-	createRealm := realms.NewCreateRealm()
-	releaseRealm := realms.NewReleaseRealm()
-	updateRealm := realms.NewUpdateRealm()
-	realmUseCaseExecutor, err := common.NewRealmUseCaseExecutor(googleUUIDGenerator, stdLibClock, pgDataStoreManager, getRealm, createRealm, releaseRealm, updateRealm)
+	realmHierarchy, err := newRealmHierarchyFromConfig(config)
 	if err != nil {
 		return nil, err
 	}
-	realmManagerAPI, err := realmmgrgrpc.NewRealmManagerAPI(logger, realmUseCaseExecutor)
+	getRealm, err := realms.NewGetRealm(realmHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	getRealmBySlug, err := realms.NewGetRealmBySlug(getRealm)
+	if err != nil {
+		return nil, err
+	}
+	getRealmAncestors, err := realms.NewGetRealmAncestors(realmHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	batchGetRealms, err := newBatchGetRealmsFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	listRealms := realms.NewListRealms()
+	searchRealms := realms.NewSearchRealms()
+	getRealmStats, err := newGetRealmStatsFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	jsonSchemaValidator, err := newAttributeValidatorFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	createRealm, err := realms.NewCreateRealm(jsonSchemaValidator, realmHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	cloneRealm, err := realms.NewCloneRealm(jsonSchemaValidator, realmHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	renameRealmSlug, err := newRenameRealmSlugFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	releaseRealm, err := newReleaseRealmFromConfig(config, realmHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	scheduleRelease := realms.NewScheduleRelease()
+	cancelScheduledRelease := realms.NewCancelScheduledRelease()
+	requestReleaseApproval := realms.NewRequestReleaseApproval()
+	reviewRelease := realms.NewReviewRelease()
+	updateRealm, err := realms.NewUpdateRealm(jsonSchemaValidator, realmHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	disableRealm, err := realms.NewDisableRealm(realmHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	enableRealm := realms.NewEnableRealm()
+	deleteRealm, err := realms.NewDeleteRealm(realmHierarchy)
+	if err != nil {
+		return nil, err
+	}
+	restoreRealm, err := newRestoreRealmFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	discardDraft := realms.NewDiscardDraft()
+	rollbackRealm := realms.NewRollbackRealm()
+	diffRealm := realms.NewDiffRealm()
+	getRealmRevision := realms.NewGetRealmRevision()
+	listRealmRevisions := realms.NewListRealmRevisions()
+	realmUseCaseExecutor, err := common.NewRealmUseCaseExecutor(googleUUIDGenerator, stdLibClock, pgDataStoreManager, getRealm, getRealmBySlug, getRealmAncestors, batchGetRealms, listRealms, searchRealms, getRealmStats, createRealm, cloneRealm, renameRealmSlug, releaseRealm, scheduleRelease, cancelScheduledRelease, requestReleaseApproval, reviewRelease, updateRealm, disableRealm, enableRealm, deleteRealm, restoreRealm, discardDraft, rollbackRealm, diffRealm, getRealmRevision, listRealmRevisions)
+	if err != nil {
+		return nil, err
+	}
+	createRealmTemplate := realms.NewCreateRealmTemplate()
+	getRealmTemplate := realms.NewGetRealmTemplate()
+	listRealmTemplates := realms.NewListRealmTemplates()
+	updateRealmTemplate := realms.NewUpdateRealmTemplate()
+	deleteRealmTemplate := realms.NewDeleteRealmTemplate()
+	createRealmFromTemplate, err := realms.NewCreateRealmFromTemplate(createRealm)
+	if err != nil {
+		return nil, err
+	}
+	realmTemplateUseCaseExecutor, err := common.NewRealmTemplateUseCaseExecutor(googleUUIDGenerator, stdLibClock, pgDataStoreManager, createRealmTemplate, getRealmTemplate, listRealmTemplates, updateRealmTemplate, deleteRealmTemplate, createRealmFromTemplate)
+	if err != nil {
+		return nil, err
+	}
+	listRealmEvents := realms.NewListRealmEvents()
+	realmWatcher, err := newRealmWatcherFromConfig(config, pgDataStoreManager, listRealmEvents)
+	if err != nil {
+		return nil, err
+	}
+	exportRealms := realms.NewExportRealms()
+	importRealms, err := realms.NewImportRealms(jsonSchemaValidator)
+	if err != nil {
+		return nil, err
+	}
+	realmTransferExecutor, err := newRealmTransferExecutorFromConfig(config, stdLibClock, pgDataStoreManager, exportRealms, importRealms)
+	if err != nil {
+		return nil, err
+	}
+	realmManagerAPI, err := realmmgrgrpc.NewRealmManagerAPI(logger, realmUseCaseExecutor, realmTemplateUseCaseExecutor, realmWatcher, realmTransferExecutor)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +160,16 @@ func initialize(ctx context.Context, cfgFilePath string) (*application, error) {
 	if err != nil {
 		return nil, err
 	}
-	mainApplication := newApplication(server)
+	listDueReleases := realms.NewListDueReleases()
+	claimDueRelease := realms.NewClaimDueRelease()
+	recordReleaseFailure, err := newRecordReleaseFailureFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	scheduledReleaseWorker, err := newScheduledReleaseWorkerFromConfig(config, logger, stdLibClock, pgDataStoreManager, listDueReleases, claimDueRelease, releaseRealm, recordReleaseFailure)
+	if err != nil {
+		return nil, err
+	}
+	mainApplication := newApplication(server, scheduledReleaseWorker, realmWatcher)
 	return mainApplication, nil
 }
@@ -0,0 +1,359 @@
+package common
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	realmmgr_clock "github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/uuidgenerator"
+	"github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+type RealmTemplateCreator interface {
+	CreateRealmTemplate(
+		ctx context.Context,
+		repos realms.CreateRealmTemplateRepos,
+		input realms.CreateRealmTemplateInput,
+	) (entities.RealmTemplate, error)
+}
+
+type RealmTemplateGetter interface {
+	GetRealmTemplate(
+		ctx context.Context,
+		repos realms.GetRealmTemplateRepos,
+		input realms.GetRealmTemplateInput,
+	) (entities.RealmTemplate, error)
+}
+
+type RealmTemplateLister interface {
+	ListRealmTemplates(
+		ctx context.Context,
+		repos realms.ListRealmTemplatesRepos,
+		input realms.ListRealmTemplatesInput,
+	) (entities.RealmTemplatePage, error)
+}
+
+type RealmTemplateUpdater interface {
+	UpdateRealmTemplate(
+		ctx context.Context,
+		repos realms.UpdateRealmTemplateRepos,
+		input realms.UpdateRealmTemplateInput,
+	) (entities.RealmTemplate, error)
+}
+
+type RealmTemplateDeleter interface {
+	DeleteRealmTemplate(ctx context.Context, repos realms.DeleteRealmTemplateRepos, input realms.DeleteRealmTemplateInput) error
+}
+
+type RealmTemplateInstantiator interface {
+	CreateRealmFromTemplate(
+		ctx context.Context,
+		repos realms.CreateRealmRepos,
+		input realms.CreateRealmFromTemplateInput,
+	) (entities.Realm, error)
+}
+
+// RealmTemplateUseCaseExecutor runs the realm template use cases, each of them in its own
+// transaction the same way RealmUseCaseExecutor runs the realm use cases.
+type RealmTemplateUseCaseExecutor struct {
+	uuidGen          uuidgenerator.Generator
+	clock            realmmgr_clock.Clock
+	dataStoreManager DataStoreManager
+
+	templateCreator      RealmTemplateCreator
+	templateGetter       RealmTemplateGetter
+	templateLister       RealmTemplateLister
+	templateUpdater      RealmTemplateUpdater
+	templateDeleter      RealmTemplateDeleter
+	templateInstantiator RealmTemplateInstantiator
+}
+
+func NewRealmTemplateUseCaseExecutor(
+	uuidGen uuidgenerator.Generator,
+	clock realmmgr_clock.Clock,
+	dataStoreManager DataStoreManager,
+	templateCreator RealmTemplateCreator,
+	templateGetter RealmTemplateGetter,
+	templateLister RealmTemplateLister,
+	templateUpdater RealmTemplateUpdater,
+	templateDeleter RealmTemplateDeleter,
+	templateInstantiator RealmTemplateInstantiator,
+) (*RealmTemplateUseCaseExecutor, error) {
+	if uuidGen == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("uuidGen", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if clock == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if dataStoreManager == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("dataStoreManager", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if templateCreator == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("templateCreator", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if templateGetter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("templateGetter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if templateLister == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("templateLister", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if templateUpdater == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("templateUpdater", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if templateDeleter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("templateDeleter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if templateInstantiator == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("templateInstantiator", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &RealmTemplateUseCaseExecutor{
+		uuidGen:              uuidGen,
+		clock:                clock,
+		dataStoreManager:     dataStoreManager,
+		templateCreator:      templateCreator,
+		templateGetter:       templateGetter,
+		templateLister:       templateLister,
+		templateUpdater:      templateUpdater,
+		templateDeleter:      templateDeleter,
+		templateInstantiator: templateInstantiator,
+	}, nil
+}
+
+func (e *RealmTemplateUseCaseExecutor) CreateRealmTemplate(
+	ctx context.Context,
+	logger logging.Logger,
+	template entities.RealmTemplate,
+	validateOnly bool,
+) (entities.RealmTemplate, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.RealmTemplate{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.CreateRealmTemplateRepos{
+		Logger:     logger,
+		UUIDGen:    e.uuidGen,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.CreateRealmTemplateInput{
+		Name:             template.Name,
+		Description:      template.Description,
+		RealmDescription: template.RealmDescription,
+		Labels:           template.Labels,
+		Attributes:       template.Attributes,
+		Parameters:       template.Parameters,
+		CreatedBy:        template.CreatedBy,
+	}
+
+	createdTemplate, err := e.templateCreator.CreateRealmTemplate(ctx, repos, input)
+	if err != nil {
+		return entities.RealmTemplate{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.RealmTemplate{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return createdTemplate, nil
+}
+
+func (e *RealmTemplateUseCaseExecutor) GetRealmTemplate(
+	ctx context.Context,
+	logger logging.Logger,
+	templateID uuid.UUID,
+	version int64,
+) (entities.RealmTemplate, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.GetRealmTemplateRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.GetRealmTemplateInput{
+		TemplateID: templateID,
+		Version:    version,
+	}
+
+	template, err := e.templateGetter.GetRealmTemplate(ctx, repos, input)
+	if err != nil {
+		return entities.RealmTemplate{}, err
+	}
+
+	return template, nil
+}
+
+func (e *RealmTemplateUseCaseExecutor) ListRealmTemplates(
+	ctx context.Context,
+	logger logging.Logger,
+	pageSize int,
+	pageToken string,
+) (entities.RealmTemplatePage, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.ListRealmTemplatesRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.ListRealmTemplatesInput{
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+
+	page, err := e.templateLister.ListRealmTemplates(ctx, repos, input)
+	if err != nil {
+		return entities.RealmTemplatePage{}, err
+	}
+
+	return page, nil
+}
+
+func (e *RealmTemplateUseCaseExecutor) UpdateRealmTemplate(
+	ctx context.Context,
+	logger logging.Logger,
+	template entities.RealmTemplate,
+	expectedVersion int64,
+	validateOnly bool,
+) (entities.RealmTemplate, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.RealmTemplate{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.UpdateRealmTemplateRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.UpdateRealmTemplateInput{
+		TemplateID:       template.ID,
+		ExpectedVersion:  expectedVersion,
+		Name:             template.Name,
+		Description:      template.Description,
+		RealmDescription: template.RealmDescription,
+		Labels:           template.Labels,
+		Attributes:       template.Attributes,
+		Parameters:       template.Parameters,
+		UpdatedBy:        template.CreatedBy,
+	}
+
+	updatedTemplate, err := e.templateUpdater.UpdateRealmTemplate(ctx, repos, input)
+	if err != nil {
+		return entities.RealmTemplate{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.RealmTemplate{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return updatedTemplate, nil
+}
+
+func (e *RealmTemplateUseCaseExecutor) DeleteRealmTemplate(
+	ctx context.Context,
+	logger logging.Logger,
+	templateID uuid.UUID,
+	validateOnly bool,
+) error {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.DeleteRealmTemplateRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.DeleteRealmTemplateInput{
+		TemplateID: templateID,
+	}
+
+	if deleteErr := e.templateDeleter.DeleteRealmTemplate(ctx, repos, input); deleteErr != nil {
+		return deleteErr
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return nil
+}
+
+func (e *RealmTemplateUseCaseExecutor) CreateRealmFromTemplate(
+	ctx context.Context,
+	logger logging.Logger,
+	templateID uuid.UUID,
+	templateVersion int64,
+	name string,
+	parameters map[string]string,
+	parentID uuid.UUID,
+	validateOnly bool,
+) (entities.Realm, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.CreateRealmRepos{
+		Logger:     logger,
+		UUIDGen:    e.uuidGen,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.CreateRealmFromTemplateInput{
+		TemplateID:      templateID,
+		TemplateVersion: templateVersion,
+		Name:            name,
+		Parameters:      parameters,
+		ParentID:        parentID,
+	}
+
+	realm, err := e.templateInstantiator.CreateRealmFromTemplate(ctx, repos, input)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return realm, nil
+}
+
+// commitRepositories commits the changes made by a use case unless validateOnly is set, see
+// RealmUseCaseExecutor.commitRepositories.
+func (e *RealmTemplateUseCaseExecutor) commitRepositories(
+	logger logging.Logger,
+	validateOnly bool,
+	repository repositories.RealmManagerRepository,
+	auditRepository repositories.RealmManagerAuditRepository,
+) error {
+	if validateOnly {
+		return nil
+	}
+	return CommitRepositories(logger, e.dataStoreManager, repository, auditRepository)
+}
@@ -0,0 +1,325 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	realmmgr_clock "github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+	"github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+type RealmExporter interface {
+	ExportRealms(ctx context.Context, repos realms.ExportRealmsRepos, input realms.ExportRealmsInput) (entities.RealmExportPage, error)
+}
+
+type RealmImporter interface {
+	ImportRealms(ctx context.Context, repos realms.ImportRealmsRepos, input realms.ImportRealmsInput) ([]entities.RealmImportResult, error)
+}
+
+// RealmTransferExecutor moves realms in bulk. Exports are read page by page outside of any
+// transaction, imports are written in transactions of a configured number of records.
+type RealmTransferExecutor struct {
+	clock            realmmgr_clock.Clock
+	dataStoreManager DataStoreManager
+
+	exporter RealmExporter
+	importer RealmImporter
+
+	exportPageSize  int
+	importBatchSize int
+}
+
+func NewRealmTransferExecutor(
+	clock realmmgr_clock.Clock,
+	dataStoreManager DataStoreManager,
+	exporter RealmExporter,
+	importer RealmImporter,
+	exportPageSize int,
+	importBatchSize int,
+) (*RealmTransferExecutor, error) {
+	if clock == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if dataStoreManager == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("dataStoreManager", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if exporter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("exporter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if importer == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("importer", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if exportPageSize <= 0 || exportPageSize > realms.MaxExportRealmsPageSize {
+		return nil, realmmgr_errors.NewInvalidArgumentError("exportPageSize", "must be between 1 and 1000")
+	}
+	if importBatchSize <= 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("importBatchSize", "must be positive")
+	}
+	return &RealmTransferExecutor{
+		clock:            clock,
+		dataStoreManager: dataStoreManager,
+		exporter:         exporter,
+		importer:         importer,
+		exportPageSize:   exportPageSize,
+		importBatchSize:  importBatchSize,
+	}, nil
+}
+
+// ExportRealms passes an export record of every realm that is not deleted to send, until all
+// realms were exported or send fails.
+func (e *RealmTransferExecutor) ExportRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	includeDrafts bool,
+	send func(record entities.RealmExportRecord) error,
+) error {
+	repos := realms.ExportRealmsRepos{
+		Logger:     logger,
+		Repository: e.dataStoreManager.NewNonTransactionalReadDatastore(ctx),
+	}
+
+	input := realms.ExportRealmsInput{
+		IncludeDrafts: includeDrafts,
+		PageSize:      e.exportPageSize,
+	}
+
+	for {
+		page, err := e.exporter.ExportRealms(ctx, repos, input)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range page.Records {
+			if sendErr := send(record); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if page.NextPageToken == "" {
+			return nil
+		}
+		input.PageToken = page.NextPageToken
+	}
+}
+
+// ImportRealms imports the records returned by recv until it returns io.EOF. Every batch of
+// records is imported in a transaction of its own, which is committed before the next batch
+// is read, so batches committed before a failure stay imported.
+//
+// recv returns an invalid argument error for a record that cannot be read. The record is
+// rejected on its own and the import carries on with the next one, any other error of recv
+// ends the import.
+//
+// Under the fail policy the import is aborted at the first realm that already exists. The
+// batch holding the realm is rolled back, and the records following it are not read.
+//
+// When validateOnly is set, all batches are imported in a single transaction that is rolled
+// back at the end, so the summary tells the outcome of the import without applying it.
+func (e *RealmTransferExecutor) ImportRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	conflictPolicy entities.ImportConflictPolicy,
+	validateOnly bool,
+	recv func() (entities.RealmExportRecord, error),
+) (entities.RealmImportSummary, error) {
+	summary := entities.RealmImportSummary{
+		Results: make([]entities.RealmImportResult, 0),
+	}
+
+	var validateRepository repositories.RealmManagerRepository
+	if validateOnly {
+		repository, _, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+		if err != nil {
+			logger.WithError(err).Error("failed to configure repositories")
+			return entities.RealmImportSummary{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+		}
+		defer rollbackFn()
+		validateRepository = repository
+	}
+
+	for {
+		batch, eof, err := e.readImportBatch(recv)
+		if err != nil {
+			return entities.RealmImportSummary{}, err
+		}
+
+		if len(batch) > 0 {
+			var results []entities.RealmImportResult
+			var aborted bool
+			var importErr error
+			if validateOnly {
+				results, aborted, importErr = e.importBatch(ctx, logger, conflictPolicy, validateRepository, batch)
+			} else {
+				results, aborted, importErr = e.commitImportBatch(ctx, logger, conflictPolicy, batch)
+			}
+			if importErr != nil {
+				return entities.RealmImportSummary{}, importErr
+			}
+			summary.Results = append(summary.Results, results...)
+			if aborted {
+				summary.Aborted = true
+				return summary, nil
+			}
+		}
+
+		if eof {
+			return summary, nil
+		}
+	}
+}
+
+// importRecord is a record read for import, or the error it could not be read with.
+type importRecord struct {
+	record entities.RealmExportRecord
+	err    error
+}
+
+// readImportBatch reads the records of the next batch, it reports whether all records were
+// read.
+func (e *RealmTransferExecutor) readImportBatch(
+	recv func() (entities.RealmExportRecord, error),
+) ([]importRecord, bool, error) {
+	batch := make([]importRecord, 0, e.importBatchSize)
+	for len(batch) < e.importBatchSize {
+		record, err := recv()
+		if errors.Is(err, io.EOF) {
+			return batch, true, nil
+		}
+		if err != nil {
+			if _, ok := err.(*realmmgr_errors.InvalidArgumentError); !ok {
+				return nil, false, err
+			}
+		}
+		batch = append(batch, importRecord{record: record, err: err})
+	}
+	return batch, false, nil
+}
+
+// commitImportBatch imports the records of a batch within a transaction of its own, which is
+// committed unless the import was aborted.
+func (e *RealmTransferExecutor) commitImportBatch(
+	ctx context.Context,
+	logger logging.Logger,
+	conflictPolicy entities.ImportConflictPolicy,
+	batch []importRecord,
+) ([]entities.RealmImportResult, bool, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return nil, false, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	results, aborted, err := e.importBatch(ctx, logger, conflictPolicy, repository, batch)
+	if err != nil || aborted {
+		return results, aborted, err
+	}
+
+	if commitErr := CommitRepositories(logger, e.dataStoreManager, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return nil, false, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return results, false, nil
+}
+
+// importBatch imports the records of a batch with repository and returns the results in the
+// order of the batch, records that could not be read are rejected with the error they were
+// read with. It reports whether the import was aborted by a realm that already exists, the
+// changes made for the batch must not be committed then.
+func (e *RealmTransferExecutor) importBatch(
+	ctx context.Context,
+	logger logging.Logger,
+	conflictPolicy entities.ImportConflictPolicy,
+	repository repositories.RealmManagerRepository,
+	batch []importRecord,
+) ([]entities.RealmImportResult, bool, error) {
+	records := make([]entities.RealmExportRecord, 0, len(batch))
+	for _, read := range batch {
+		if read.err == nil {
+			records = append(records, read.record)
+		}
+	}
+
+	imported := make([]entities.RealmImportResult, 0)
+	aborted := false
+	if len(records) > 0 {
+		var err error
+		if imported, aborted, err = e.importRecords(ctx, logger, conflictPolicy, repository, records); err != nil {
+			return nil, false, err
+		}
+	}
+
+	results := make([]entities.RealmImportResult, 0, len(batch))
+	next := 0
+	for _, read := range batch {
+		if aborted && next == len(imported) {
+			// the records following the one that aborted the import are not imported
+			break
+		}
+		if read.err != nil {
+			logger.WithError(read.err).Info("realm import rejected")
+			results = append(results, entities.RealmImportResult{
+				Outcome: entities.RealmImportOutcomeRejected,
+				Err:     read.err,
+			})
+			continue
+		}
+		results = append(results, imported[next])
+		next++
+	}
+
+	return results, aborted, nil
+}
+
+// importRecords imports the records with repository, it reports whether the import was
+// aborted by a realm that already exists.
+func (e *RealmTransferExecutor) importRecords(
+	ctx context.Context,
+	logger logging.Logger,
+	conflictPolicy entities.ImportConflictPolicy,
+	repository repositories.RealmManagerRepository,
+	records []entities.RealmExportRecord,
+) ([]entities.RealmImportResult, bool, error) {
+	repos := realms.ImportRealmsRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.ImportRealmsInput{
+		Records:        records,
+		ConflictPolicy: conflictPolicy,
+	}
+
+	results, err := e.importer.ImportRealms(ctx, repos, input)
+	if err != nil {
+		if _, ok := err.(*realmmgr_errors.FailedPreconditionError); ok && conflictPolicy == entities.ImportConflictPolicyFail {
+			conflict := results[len(results)-1]
+			logger.WithField("realm-id", conflict.RealmID).Info("realm import aborted by existing realm")
+
+			// the batch is rolled back, so the realms imported before the conflict are not
+			for i := range results[:len(results)-1] {
+				outcome := results[i].Outcome
+				if outcome != entities.RealmImportOutcomeCreated && outcome != entities.RealmImportOutcomeUpdated {
+					continue
+				}
+				results[i].Outcome = entities.RealmImportOutcomeRejected
+				results[i].Err = realmmgr_errors.NewAbortedError(
+					fmt.Sprintf("import was aborted by realm with ID %s that already exists", conflict.RealmID),
+					nil,
+				)
+			}
+			return results, true, nil
+		}
+		return nil, false, err
+	}
+
+	return results, false, nil
+}
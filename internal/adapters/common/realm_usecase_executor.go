@@ -2,11 +2,13 @@ package common
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
 	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
 	realmmgr_clock "github.com/alexZaicev/realm-mgr/internal/drivers/clock"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/uuidgenerator"
@@ -17,27 +19,157 @@ type RealmGetter interface {
 	GetRealm(ctx context.Context, repos realms.GetRealmRepos, input realms.GetRealmInput) (entities.Realm, error)
 }
 
+type RealmBySlugGetter interface {
+	GetRealmBySlug(ctx context.Context, repos realms.GetRealmBySlugRepos, input realms.GetRealmBySlugInput) (entities.Realm, error)
+}
+
+type RealmAncestorsGetter interface {
+	GetRealmAncestors(
+		ctx context.Context,
+		repos realms.GetRealmAncestorsRepos,
+		input realms.GetRealmAncestorsInput,
+	) ([]entities.Realm, error)
+}
+
+type RealmBatchGetter interface {
+	BatchGetRealms(
+		ctx context.Context,
+		repos realms.BatchGetRealmsRepos,
+		input realms.BatchGetRealmsInput,
+	) ([]entities.RealmBatchResult, error)
+}
+
+type RealmLister interface {
+	ListRealms(ctx context.Context, repos realms.ListRealmsRepos, input realms.ListRealmsInput) (entities.RealmPage, error)
+}
+
+type RealmSearcher interface {
+	SearchRealms(ctx context.Context, repos realms.SearchRealmsRepos, input realms.SearchRealmsInput) (entities.RealmSearchPage, error)
+}
+
+type RealmStatsGetter interface {
+	GetRealmStats(ctx context.Context, repos realms.GetRealmStatsRepos, input realms.GetRealmStatsInput) (entities.RealmStats, error)
+}
+
 type RealmCreator interface {
 	CreateRealm(ctx context.Context, repos realms.CreateRealmRepos, input realms.CreateRealmInput) (entities.Realm, error)
 }
 
+type RealmCloner interface {
+	CloneRealm(ctx context.Context, repos realms.CloneRealmRepos, input realms.CloneRealmInput) (entities.Realm, error)
+}
+
+type RealmSlugRenamer interface {
+	RenameRealmSlug(ctx context.Context, repos realms.RenameRealmSlugRepos, input realms.RenameRealmSlugInput) (entities.Realm, error)
+}
+
 type RealmReleaser interface {
 	ReleaseRealm(ctx context.Context, repos realms.ReleaseRealmRepos, input realms.ReleaseRealmInput) (entities.Realm, error)
 }
 
+type RealmReleaseScheduler interface {
+	ScheduleRelease(
+		ctx context.Context,
+		repos realms.ScheduleReleaseRepos,
+		input realms.ScheduleReleaseInput,
+	) (entities.ReleaseSchedule, error)
+}
+
+type ScheduledReleaseCanceler interface {
+	CancelScheduledRelease(ctx context.Context, repos realms.CancelScheduledReleaseRepos, input realms.CancelScheduledReleaseInput) error
+}
+
+type RealmApprovalRequester interface {
+	RequestReleaseApproval(
+		ctx context.Context,
+		repos realms.RequestReleaseApprovalRepos,
+		input realms.RequestReleaseApprovalInput,
+	) (entities.ReleaseApproval, error)
+}
+
+type RealmReleaseReviewer interface {
+	ReviewRelease(ctx context.Context, repos realms.ReviewReleaseRepos, input realms.ReviewReleaseInput) (entities.ReleaseApproval, error)
+}
+
 type RealmUpdater interface {
 	UpdateRealm(ctx context.Context, repos realms.UpdateRealmRepos, input realms.UpdateRealmInput) (entities.Realm, error)
 }
 
+type RealmDisabler interface {
+	DisableRealm(ctx context.Context, repos realms.DisableRealmRepos, input realms.DisableRealmInput) (entities.Realm, error)
+}
+
+type RealmEnabler interface {
+	EnableRealm(ctx context.Context, repos realms.EnableRealmRepos, input realms.EnableRealmInput) (entities.Realm, error)
+}
+
+type RealmDeleter interface {
+	DeleteRealm(ctx context.Context, repos realms.DeleteRealmRepos, input realms.DeleteRealmInput) error
+}
+
+type RealmRestorer interface {
+	RestoreRealm(ctx context.Context, repos realms.RestoreRealmRepos, input realms.RestoreRealmInput) (entities.Realm, error)
+}
+
+type RealmDraftDiscarder interface {
+	DiscardDraft(ctx context.Context, repos realms.DiscardDraftRepos, input realms.DiscardDraftInput) error
+}
+
+type RealmRollbacker interface {
+	RollbackRealm(ctx context.Context, repos realms.RollbackRealmRepos, input realms.RollbackRealmInput) (entities.Realm, error)
+}
+
+type RealmDiffer interface {
+	DiffRealm(ctx context.Context, repos realms.DiffRealmRepos, input realms.DiffRealmInput) ([]entities.RealmFieldChange, error)
+}
+
+type RealmRevisionGetter interface {
+	GetRealmRevision(
+		ctx context.Context,
+		repos realms.GetRealmRevisionRepos,
+		input realms.GetRealmRevisionInput,
+	) (entities.RealmRevision, error)
+}
+
+type RealmRevisionLister interface {
+	ListRealmRevisions(
+		ctx context.Context,
+		repos realms.ListRealmRevisionsRepos,
+		input realms.ListRealmRevisionsInput,
+	) (entities.RealmRevisionPage, error)
+}
+
 type RealmUseCaseExecutor struct {
 	uuidGen          uuidgenerator.Generator
 	clock            realmmgr_clock.Clock
 	dataStoreManager DataStoreManager
 
-	realmGetter   RealmGetter
-	realmCreator  RealmCreator
-	realmReleaser RealmReleaser
-	realmUpdater  RealmUpdater
+	realmGetter       RealmGetter
+	slugGetter        RealmBySlugGetter
+	ancestorsGetter   RealmAncestorsGetter
+	batchGetter       RealmBatchGetter
+	realmLister       RealmLister
+	realmSearcher     RealmSearcher
+	statsGetter       RealmStatsGetter
+	realmCreator      RealmCreator
+	realmCloner       RealmCloner
+	slugRenamer       RealmSlugRenamer
+	realmReleaser     RealmReleaser
+	releaseScheduler  RealmReleaseScheduler
+	releaseCanceler   ScheduledReleaseCanceler
+	approvalRequester RealmApprovalRequester
+	releaseReviewer   RealmReleaseReviewer
+	realmUpdater      RealmUpdater
+	realmDisabler     RealmDisabler
+	realmEnabler      RealmEnabler
+	realmDeleter      RealmDeleter
+	realmRestorer     RealmRestorer
+	realmDiscarder    RealmDraftDiscarder
+	realmRollbacker   RealmRollbacker
+	realmDiffer       RealmDiffer
+
+	revisionGetter RealmRevisionGetter
+	revisionLister RealmRevisionLister
 }
 
 func NewRealmUseCaseExecutor(
@@ -45,9 +177,30 @@ func NewRealmUseCaseExecutor(
 	clock realmmgr_clock.Clock,
 	dataStoreManager DataStoreManager,
 	realmGetter RealmGetter,
+	slugGetter RealmBySlugGetter,
+	ancestorsGetter RealmAncestorsGetter,
+	batchGetter RealmBatchGetter,
+	realmLister RealmLister,
+	realmSearcher RealmSearcher,
+	statsGetter RealmStatsGetter,
 	realmCreator RealmCreator,
+	realmCloner RealmCloner,
+	slugRenamer RealmSlugRenamer,
 	realmReleaser RealmReleaser,
+	releaseScheduler RealmReleaseScheduler,
+	releaseCanceler ScheduledReleaseCanceler,
+	approvalRequester RealmApprovalRequester,
+	releaseReviewer RealmReleaseReviewer,
 	realmUpdater RealmUpdater,
+	realmDisabler RealmDisabler,
+	realmEnabler RealmEnabler,
+	realmDeleter RealmDeleter,
+	realmRestorer RealmRestorer,
+	realmDiscarder RealmDraftDiscarder,
+	realmRollbacker RealmRollbacker,
+	realmDiffer RealmDiffer,
+	revisionGetter RealmRevisionGetter,
+	revisionLister RealmRevisionLister,
 ) (*RealmUseCaseExecutor, error) {
 	if uuidGen == nil {
 		return nil, realmmgr_errors.NewInvalidArgumentError("uuidGen", realmmgr_errors.ErrMsgCannotBeNil)
@@ -61,23 +214,107 @@ func NewRealmUseCaseExecutor(
 	if realmGetter == nil {
 		return nil, realmmgr_errors.NewInvalidArgumentError("realmGetter", realmmgr_errors.ErrMsgCannotBeNil)
 	}
+	if slugGetter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("slugGetter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if ancestorsGetter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("ancestorsGetter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if batchGetter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("batchGetter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmLister == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmLister", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmSearcher == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmSearcher", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if statsGetter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("statsGetter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
 	if realmCreator == nil {
 		return nil, realmmgr_errors.NewInvalidArgumentError("realmCreator", realmmgr_errors.ErrMsgCannotBeNil)
 	}
+	if realmCloner == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmCloner", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if slugRenamer == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("slugRenamer", realmmgr_errors.ErrMsgCannotBeNil)
+	}
 	if realmReleaser == nil {
 		return nil, realmmgr_errors.NewInvalidArgumentError("realmReleaser", realmmgr_errors.ErrMsgCannotBeNil)
 	}
+	if releaseScheduler == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("releaseScheduler", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if releaseCanceler == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("releaseCanceler", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if approvalRequester == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("approvalRequester", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if releaseReviewer == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("releaseReviewer", realmmgr_errors.ErrMsgCannotBeNil)
+	}
 	if realmUpdater == nil {
 		return nil, realmmgr_errors.NewInvalidArgumentError("realmUpdater", realmmgr_errors.ErrMsgCannotBeNil)
 	}
+	if realmDisabler == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmDisabler", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmEnabler == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmEnabler", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmDeleter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmDeleter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmRestorer == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmRestorer", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmDiscarder == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmDiscarder", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmRollbacker == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmRollbacker", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmDiffer == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmDiffer", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if revisionGetter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("revisionGetter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if revisionLister == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("revisionLister", realmmgr_errors.ErrMsgCannotBeNil)
+	}
 	return &RealmUseCaseExecutor{
-		uuidGen:          uuidGen,
-		clock:            clock,
-		dataStoreManager: dataStoreManager,
-		realmGetter:      realmGetter,
-		realmCreator:     realmCreator,
-		realmReleaser:    realmReleaser,
-		realmUpdater:     realmUpdater,
+		uuidGen:           uuidGen,
+		clock:             clock,
+		dataStoreManager:  dataStoreManager,
+		realmGetter:       realmGetter,
+		slugGetter:        slugGetter,
+		ancestorsGetter:   ancestorsGetter,
+		batchGetter:       batchGetter,
+		realmLister:       realmLister,
+		realmSearcher:     realmSearcher,
+		statsGetter:       statsGetter,
+		realmCreator:      realmCreator,
+		realmCloner:       realmCloner,
+		slugRenamer:       slugRenamer,
+		realmReleaser:     realmReleaser,
+		releaseScheduler:  releaseScheduler,
+		releaseCanceler:   releaseCanceler,
+		approvalRequester: approvalRequester,
+		releaseReviewer:   releaseReviewer,
+		realmUpdater:      realmUpdater,
+		realmDisabler:     realmDisabler,
+		realmEnabler:      realmEnabler,
+		realmDeleter:      realmDeleter,
+		realmRestorer:     realmRestorer,
+		realmDiscarder:    realmDiscarder,
+		realmRollbacker:   realmRollbacker,
+		realmDiffer:       realmDiffer,
+		revisionGetter:    revisionGetter,
+		revisionLister:    revisionLister,
 	}, nil
 }
 
@@ -86,6 +323,7 @@ func (e *RealmUseCaseExecutor) GetRealm(
 	logger logging.Logger,
 	realmID uuid.UUID,
 	status entities.Status,
+	view entities.RealmView,
 ) (entities.Realm, error) {
 	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
 
@@ -97,6 +335,7 @@ func (e *RealmUseCaseExecutor) GetRealm(
 	input := realms.GetRealmInput{
 		RealmID: realmID,
 		Status:  status,
+		View:    view,
 	}
 
 	realm, err := e.realmGetter.GetRealm(ctx, repos, input)
@@ -107,7 +346,219 @@ func (e *RealmUseCaseExecutor) GetRealm(
 	return realm, nil
 }
 
-func (e *RealmUseCaseExecutor) CreateRealm(ctx context.Context, logger logging.Logger, name, description string) (entities.Realm, error) {
+func (e *RealmUseCaseExecutor) GetRealmBySlug(
+	ctx context.Context,
+	logger logging.Logger,
+	slug string,
+	status entities.Status,
+	view entities.RealmView,
+) (entities.Realm, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.GetRealmBySlugRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.GetRealmBySlugInput{
+		Slug:   slug,
+		Status: status,
+		View:   view,
+	}
+
+	realm, err := e.slugGetter.GetRealmBySlug(ctx, repos, input)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	return realm, nil
+}
+
+func (e *RealmUseCaseExecutor) GetRealmAncestors(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	status entities.Status,
+) ([]entities.Realm, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.GetRealmAncestorsRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.GetRealmAncestorsInput{
+		RealmID: realmID,
+		Status:  status,
+	}
+
+	ancestors, err := e.ancestorsGetter.GetRealmAncestors(ctx, repos, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return ancestors, nil
+}
+
+func (e *RealmUseCaseExecutor) BatchGetRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	realmIDs []uuid.UUID,
+	status entities.Status,
+) ([]entities.RealmBatchResult, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.BatchGetRealmsRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.BatchGetRealmsInput{
+		RealmIDs: realmIDs,
+		Status:   status,
+	}
+
+	results, err := e.batchGetter.BatchGetRealms(ctx, repos, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (e *RealmUseCaseExecutor) ListRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	status entities.Status,
+	sorting entities.RealmSorting,
+	pageSize int,
+	pageToken string,
+	labelSelector string,
+) (entities.RealmPage, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.ListRealmsRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.ListRealmsInput{
+		Status:        status,
+		Sorting:       sorting,
+		PageSize:      pageSize,
+		PageToken:     pageToken,
+		LabelSelector: labelSelector,
+	}
+
+	page, err := e.realmLister.ListRealms(ctx, repos, input)
+	if err != nil {
+		return entities.RealmPage{}, err
+	}
+
+	return page, nil
+}
+
+// ListChildRealms lists the direct children of a realm, sorted by name.
+func (e *RealmUseCaseExecutor) ListChildRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	parentID uuid.UUID,
+	status entities.Status,
+	pageSize int,
+	pageToken string,
+) (entities.RealmPage, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.ListRealmsRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.ListRealmsInput{
+		Status:    status,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+		ParentID:  parentID,
+	}
+
+	page, err := e.realmLister.ListRealms(ctx, repos, input)
+	if err != nil {
+		return entities.RealmPage{}, err
+	}
+
+	return page, nil
+}
+
+func (e *RealmUseCaseExecutor) SearchRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	query string,
+	status entities.Status,
+	pageSize int,
+	pageToken string,
+) (entities.RealmSearchPage, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.SearchRealmsRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.SearchRealmsInput{
+		Query:     query,
+		Status:    status,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+
+	page, err := e.realmSearcher.SearchRealms(ctx, repos, input)
+	if err != nil {
+		return entities.RealmSearchPage{}, err
+	}
+
+	return page, nil
+}
+
+func (e *RealmUseCaseExecutor) GetRealmStats(
+	ctx context.Context,
+	logger logging.Logger,
+	labelSelector string,
+	createdAfter, createdBefore time.Time,
+	staleDraftDays int,
+) (entities.RealmStats, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.GetRealmStatsRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.GetRealmStatsInput{
+		LabelSelector:  labelSelector,
+		CreatedAfter:   createdAfter,
+		CreatedBefore:  createdBefore,
+		StaleDraftDays: staleDraftDays,
+	}
+
+	stats, err := e.statsGetter.GetRealmStats(ctx, repos, input)
+	if err != nil {
+		return entities.RealmStats{}, err
+	}
+
+	return stats, nil
+}
+
+func (e *RealmUseCaseExecutor) CreateRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	name, slug, description string,
+	labels map[string]string,
+	attributes map[string]interface{},
+	parentID uuid.UUID,
+	validateOnly bool,
+) (entities.Realm, error) {
 	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
 	if err != nil {
 		logger.WithError(err).Error("failed to configure repositories")
@@ -124,7 +575,11 @@ func (e *RealmUseCaseExecutor) CreateRealm(ctx context.Context, logger logging.L
 
 	input := realms.CreateRealmInput{
 		Name:        name,
+		Slug:        slug,
 		Description: description,
+		Labels:      labels,
+		Attributes:  attributes,
+		ParentID:    parentID,
 	}
 
 	realm, err := e.realmCreator.CreateRealm(ctx, repos, input)
@@ -132,7 +587,7 @@ func (e *RealmUseCaseExecutor) CreateRealm(ctx context.Context, logger logging.L
 		return entities.Realm{}, err
 	}
 
-	if commitErr := CommitRepositories(logger, e.dataStoreManager, repository, auditRepository); err != nil {
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
 		logger.WithError(commitErr).Error("failed to commit transaction")
 		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
 	}
@@ -140,8 +595,14 @@ func (e *RealmUseCaseExecutor) CreateRealm(ctx context.Context, logger logging.L
 	return realm, nil
 }
 
-//nolint:dupl // similar to UpdateRealm
-func (e *RealmUseCaseExecutor) ReleaseRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID) (entities.Realm, error) {
+func (e *RealmUseCaseExecutor) CloneRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	sourceID uuid.UUID,
+	sourceStatus entities.Status,
+	name string,
+	validateOnly bool,
+) (entities.Realm, error) {
 	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
 	if err != nil {
 		logger.WithError(err).Error("failed to configure repositories")
@@ -149,22 +610,63 @@ func (e *RealmUseCaseExecutor) ReleaseRealm(ctx context.Context, logger logging.
 	}
 	defer rollbackFn()
 
-	repos := realms.ReleaseRealmRepos{
+	repos := realms.CloneRealmRepos{
+		Logger:     logger,
+		UUIDGen:    e.uuidGen,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.CloneRealmInput{
+		SourceID:     sourceID,
+		SourceStatus: sourceStatus,
+		Name:         name,
+	}
+
+	realm, err := e.realmCloner.CloneRealm(ctx, repos, input)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return realm, nil
+}
+
+func (e *RealmUseCaseExecutor) RenameRealmSlug(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	slug string,
+	validateOnly bool,
+) (entities.Realm, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.RenameRealmSlugRepos{
 		Logger:     logger,
 		Clock:      e.clock,
 		Repository: repository,
 	}
 
-	input := realms.ReleaseRealmInput{
+	input := realms.RenameRealmSlugInput{
 		RealmID: realmID,
+		Slug:    slug,
 	}
 
-	realm, err := e.realmReleaser.ReleaseRealm(ctx, repos, input)
+	realm, err := e.slugRenamer.RenameRealmSlug(ctx, repos, input)
 	if err != nil {
 		return entities.Realm{}, err
 	}
 
-	if commitErr := CommitRepositories(logger, e.dataStoreManager, repository, auditRepository); err != nil {
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
 		logger.WithError(commitErr).Error("failed to commit transaction")
 		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
 	}
@@ -172,11 +674,14 @@ func (e *RealmUseCaseExecutor) ReleaseRealm(ctx context.Context, logger logging.
 	return realm, nil
 }
 
-//nolint:dupl // similar to ReleaseRealm
-func (e *RealmUseCaseExecutor) UpdateRealm(
+//nolint:dupl // similar to UpdateRealm
+func (e *RealmUseCaseExecutor) ReleaseRealm(
 	ctx context.Context,
 	logger logging.Logger,
-	realmToUpdate entities.Realm,
+	realmID uuid.UUID,
+	expectedRevision int64,
+	releasedBy string,
+	validateOnly bool,
 ) (entities.Realm, error) {
 	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
 	if err != nil {
@@ -185,25 +690,558 @@ func (e *RealmUseCaseExecutor) UpdateRealm(
 	}
 	defer rollbackFn()
 
-	repos := realms.UpdateRealmRepos{
+	repos := realms.ReleaseRealmRepos{
 		Logger:     logger,
 		Clock:      e.clock,
 		Repository: repository,
 	}
 
-	input := realms.UpdateRealmInput{
-		Realm: realmToUpdate,
+	input := realms.ReleaseRealmInput{
+		RealmID:          realmID,
+		ExpectedRevision: expectedRevision,
+		ReleasedBy:       releasedBy,
 	}
 
-	realm, err := e.realmUpdater.UpdateRealm(ctx, repos, input)
+	realm, err := e.realmReleaser.ReleaseRealm(ctx, repos, input)
 	if err != nil {
 		return entities.Realm{}, err
 	}
 
-	if commitErr := CommitRepositories(logger, e.dataStoreManager, repository, auditRepository); err != nil {
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
 		logger.WithError(commitErr).Error("failed to commit transaction")
 		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
 	}
 
 	return realm, nil
 }
+
+func (e *RealmUseCaseExecutor) ScheduleRelease(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	releaseAt time.Time,
+	scheduledBy string,
+	validateOnly bool,
+) (entities.ReleaseSchedule, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.ScheduleReleaseRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.ScheduleReleaseInput{
+		RealmID:     realmID,
+		ReleaseAt:   releaseAt,
+		ScheduledBy: scheduledBy,
+	}
+
+	schedule, err := e.releaseScheduler.ScheduleRelease(ctx, repos, input)
+	if err != nil {
+		return entities.ReleaseSchedule{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return schedule, nil
+}
+
+func (e *RealmUseCaseExecutor) CancelScheduledRelease(ctx context.Context, logger logging.Logger, realmID uuid.UUID, validateOnly bool) error {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.CancelScheduledReleaseRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.CancelScheduledReleaseInput{
+		RealmID: realmID,
+	}
+
+	if cancelErr := e.releaseCanceler.CancelScheduledRelease(ctx, repos, input); cancelErr != nil {
+		return cancelErr
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return nil
+}
+
+func (e *RealmUseCaseExecutor) RequestReleaseApproval(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	requestedBy string,
+	validateOnly bool,
+) (entities.ReleaseApproval, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.RequestReleaseApprovalRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.RequestReleaseApprovalInput{
+		RealmID:     realmID,
+		RequestedBy: requestedBy,
+	}
+
+	approval, err := e.approvalRequester.RequestReleaseApproval(ctx, repos, input)
+	if err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return approval, nil
+}
+
+func (e *RealmUseCaseExecutor) ReviewRelease(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	reviewer string,
+	decision entities.ApprovalDecision,
+	expectedRevision int64,
+	comment string,
+	validateOnly bool,
+) (entities.ReleaseApproval, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.ReviewReleaseRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.ReviewReleaseInput{
+		RealmID:          realmID,
+		Reviewer:         reviewer,
+		Decision:         decision,
+		Comment:          comment,
+		ExpectedRevision: expectedRevision,
+	}
+
+	approval, err := e.releaseReviewer.ReviewRelease(ctx, repos, input)
+	if err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return approval, nil
+}
+
+//nolint:dupl // similar to ReleaseRealm
+func (e *RealmUseCaseExecutor) UpdateRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	realmToUpdate entities.Realm,
+	updateMask []entities.RealmField,
+	expectedRevision int64,
+	validateOnly bool,
+) (entities.Realm, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.UpdateRealmRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.UpdateRealmInput{
+		Realm:            realmToUpdate,
+		UpdateMask:       updateMask,
+		ExpectedRevision: expectedRevision,
+	}
+
+	realm, err := e.realmUpdater.UpdateRealm(ctx, repos, input)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return realm, nil
+}
+
+//nolint:dupl // similar to EnableRealm
+func (e *RealmUseCaseExecutor) DisableRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	reason string,
+	cascade bool,
+	validateOnly bool,
+) (entities.Realm, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.DisableRealmRepos{
+		Logger:          logger,
+		Clock:           e.clock,
+		Repository:      repository,
+		AuditRepository: auditRepository,
+	}
+
+	input := realms.DisableRealmInput{
+		RealmID: realmID,
+		Reason:  reason,
+		Cascade: cascade,
+	}
+
+	realm, err := e.realmDisabler.DisableRealm(ctx, repos, input)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return realm, nil
+}
+
+//nolint:dupl // similar to DisableRealm
+func (e *RealmUseCaseExecutor) EnableRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	reason string,
+	validateOnly bool,
+) (entities.Realm, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.EnableRealmRepos{
+		Logger:          logger,
+		Clock:           e.clock,
+		Repository:      repository,
+		AuditRepository: auditRepository,
+	}
+
+	input := realms.EnableRealmInput{
+		RealmID: realmID,
+		Reason:  reason,
+	}
+
+	realm, err := e.realmEnabler.EnableRealm(ctx, repos, input)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return realm, nil
+}
+
+func (e *RealmUseCaseExecutor) DeleteRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	force bool,
+	cascade bool,
+	reason string,
+	validateOnly bool,
+) error {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.DeleteRealmRepos{
+		Logger:          logger,
+		Clock:           e.clock,
+		Repository:      repository,
+		AuditRepository: auditRepository,
+	}
+
+	input := realms.DeleteRealmInput{
+		RealmID: realmID,
+		Force:   force,
+		Cascade: cascade,
+		Reason:  reason,
+	}
+
+	if deleteErr := e.realmDeleter.DeleteRealm(ctx, repos, input); deleteErr != nil {
+		return deleteErr
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return nil
+}
+
+func (e *RealmUseCaseExecutor) RestoreRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	reason string,
+	validateOnly bool,
+) (entities.Realm, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.RestoreRealmRepos{
+		Logger:          logger,
+		Clock:           e.clock,
+		Repository:      repository,
+		AuditRepository: auditRepository,
+	}
+
+	input := realms.RestoreRealmInput{
+		RealmID: realmID,
+		Reason:  reason,
+	}
+
+	realm, err := e.realmRestorer.RestoreRealm(ctx, repos, input)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return realm, nil
+}
+
+func (e *RealmUseCaseExecutor) DiscardDraft(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	deleteRealm bool,
+	validateOnly bool,
+) error {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.DiscardDraftRepos{
+		Logger:     logger,
+		Clock:      e.clock,
+		Repository: repository,
+	}
+
+	input := realms.DiscardDraftInput{
+		RealmID:     realmID,
+		DeleteRealm: deleteRealm,
+	}
+
+	if discardErr := e.realmDiscarder.DiscardDraft(ctx, repos, input); discardErr != nil {
+		return discardErr
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return nil
+}
+
+func (e *RealmUseCaseExecutor) RollbackRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	revision int64,
+	reason string,
+	validateOnly bool,
+) (entities.Realm, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	repos := realms.RollbackRealmRepos{
+		Logger:          logger,
+		Clock:           e.clock,
+		Repository:      repository,
+		AuditRepository: auditRepository,
+	}
+
+	input := realms.RollbackRealmInput{
+		RealmID:  realmID,
+		Revision: revision,
+		Reason:   reason,
+	}
+
+	realm, err := e.realmRollbacker.RollbackRealm(ctx, repos, input)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return realm, nil
+}
+
+func (e *RealmUseCaseExecutor) DiffRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	from, to entities.RealmVersion,
+) ([]entities.RealmFieldChange, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.DiffRealmRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.DiffRealmInput{
+		RealmID: realmID,
+		From:    from,
+		To:      to,
+	}
+
+	changes, err := e.realmDiffer.DiffRealm(ctx, repos, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (e *RealmUseCaseExecutor) GetRealmRevision(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	revision int64,
+	asOf time.Time,
+) (entities.RealmRevision, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.GetRealmRevisionRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.GetRealmRevisionInput{
+		RealmID:  realmID,
+		Revision: revision,
+		AsOf:     asOf,
+	}
+
+	realmRevision, err := e.revisionGetter.GetRealmRevision(ctx, repos, input)
+	if err != nil {
+		return entities.RealmRevision{}, err
+	}
+
+	return realmRevision, nil
+}
+
+func (e *RealmUseCaseExecutor) ListRealmRevisions(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	pageSize int,
+	pageToken string,
+) (entities.RealmRevisionPage, error) {
+	repository := e.dataStoreManager.NewNonTransactionalReadDatastore(ctx)
+
+	repos := realms.ListRealmRevisionsRepos{
+		Logger:     logger,
+		Repository: repository,
+	}
+
+	input := realms.ListRealmRevisionsInput{
+		RealmID:   realmID,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+
+	page, err := e.revisionLister.ListRealmRevisions(ctx, repos, input)
+	if err != nil {
+		return entities.RealmRevisionPage{}, err
+	}
+
+	return page, nil
+}
+
+// commitRepositories commits the changes made by a use case, unless validateOnly is set. A
+// dry run leaves the transaction to the deferred rollback, so the caller receives the outcome
+// of the use case, including all validation and conflict errors, without any changes applied.
+func (e *RealmUseCaseExecutor) commitRepositories(
+	logger logging.Logger,
+	validateOnly bool,
+	repository repositories.RealmManagerRepository,
+	auditRepository repositories.RealmManagerAuditRepository,
+) error {
+	if validateOnly {
+		return nil
+	}
+	return CommitRepositories(logger, e.dataStoreManager, repository, auditRepository)
+}
@@ -0,0 +1,130 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+	"github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// BatchMutateRealms applies the mutations in order within a single transaction. Changes are
+// committed only when every mutation succeeds, otherwise nothing is applied and the first
+// failure is returned as a BatchMutationError holding its index.
+func (e *RealmUseCaseExecutor) BatchMutateRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	mutations []entities.RealmMutation,
+	actor string,
+	validateOnly bool,
+) ([]entities.Realm, error) {
+	if len(mutations) == 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("mutations", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, e.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return nil, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	mutatedRealms := make([]entities.Realm, len(mutations))
+	for i, mutation := range mutations {
+		realm, mutateErr := e.mutateRealm(ctx, logger, repository, auditRepository, mutation, actor)
+		if mutateErr != nil {
+			logger.WithError(mutateErr).WithField("mutation-index", i).Info("batch mutation failed")
+			return nil, realmmgr_errors.NewBatchMutationError(i, mutateErr)
+		}
+		mutatedRealms[i] = realm
+	}
+
+	if commitErr := e.commitRepositories(logger, validateOnly, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return nil, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return mutatedRealms, nil
+}
+
+// mutateRealm runs the use case of a single batch mutation against the shared repositories.
+func (e *RealmUseCaseExecutor) mutateRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	auditRepository repositories.RealmManagerAuditRepository,
+	mutation entities.RealmMutation,
+	actor string,
+) (entities.Realm, error) {
+	switch mutation.Type {
+	case entities.RealmMutationCreate:
+		return e.realmCreator.CreateRealm(
+			ctx,
+			realms.CreateRealmRepos{
+				Logger:     logger,
+				UUIDGen:    e.uuidGen,
+				Clock:      e.clock,
+				Repository: repository,
+			},
+			realms.CreateRealmInput{
+				Name:        mutation.Realm.Name,
+				Slug:        mutation.Realm.Slug,
+				Description: mutation.Realm.Description,
+				Labels:      mutation.Realm.Labels,
+				Attributes:  mutation.Realm.Attributes,
+				ParentID:    mutation.Realm.ParentID,
+			},
+		)
+	case entities.RealmMutationUpdate:
+		return e.realmUpdater.UpdateRealm(
+			ctx,
+			realms.UpdateRealmRepos{
+				Logger:     logger,
+				Clock:      e.clock,
+				Repository: repository,
+			},
+			realms.UpdateRealmInput{
+				Realm:            mutation.Realm,
+				UpdateMask:       mutation.UpdateMask,
+				ExpectedRevision: mutation.ExpectedRevision,
+			},
+		)
+	case entities.RealmMutationRelease:
+		return e.realmReleaser.ReleaseRealm(
+			ctx,
+			realms.ReleaseRealmRepos{
+				Logger:     logger,
+				Clock:      e.clock,
+				Repository: repository,
+			},
+			realms.ReleaseRealmInput{
+				RealmID:          mutation.Realm.ID,
+				ExpectedRevision: mutation.ExpectedRevision,
+				ReleasedBy:       actor,
+			},
+		)
+	case entities.RealmMutationDisable:
+		return e.realmDisabler.DisableRealm(
+			ctx,
+			realms.DisableRealmRepos{
+				Logger:          logger,
+				Clock:           e.clock,
+				Repository:      repository,
+				AuditRepository: auditRepository,
+			},
+			realms.DisableRealmInput{
+				RealmID: mutation.Realm.ID,
+				Reason:  mutation.Reason,
+				Cascade: mutation.Cascade,
+			},
+		)
+	default:
+		return entities.Realm{}, realmmgr_errors.NewInvalidArgumentError(
+			"mutation",
+			fmt.Sprintf("has unexpected type %d", mutation.Type),
+		)
+	}
+}
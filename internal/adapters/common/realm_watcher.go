@@ -0,0 +1,172 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+	"github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+type RealmEventLister interface {
+	ListRealmEvents(ctx context.Context, repos realms.ListRealmEventsRepos, input realms.ListRealmEventsInput) (entities.RealmEventPage, error)
+}
+
+// RealmWatcher streams realm events to watchers by polling the realm event log. Every
+// watcher polls on its own, starting from the sequence token it was given.
+type RealmWatcher struct {
+	dataStoreManager DataStoreManager
+	eventLister      RealmEventLister
+
+	pollInterval time.Duration
+	batchSize    int
+
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+func NewRealmWatcher(
+	dataStoreManager DataStoreManager,
+	eventLister RealmEventLister,
+	pollInterval time.Duration,
+	batchSize int,
+) (*RealmWatcher, error) {
+	if dataStoreManager == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("dataStoreManager", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if eventLister == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("eventLister", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if pollInterval <= 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("pollInterval", "must be positive")
+	}
+	if batchSize <= 0 || batchSize > realms.MaxListRealmEventsLimit {
+		return nil, realmmgr_errors.NewInvalidArgumentError("batchSize", "must be between 1 and 1000")
+	}
+	return &RealmWatcher{
+		dataStoreManager: dataStoreManager,
+		eventLister:      eventLister,
+		pollInterval:     pollInterval,
+		batchSize:        batchSize,
+		stopped:          make(chan struct{}),
+	}, nil
+}
+
+// Stop ends all watches, watches started afterwards end right after their first event.
+// Watches never end on their own, so they have to be stopped before the gRPC server can
+// shut down gracefully.
+func (w *RealmWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopped)
+	})
+}
+
+// WatchRealms passes the realm events matching realmID and status to send, in the order they
+// were recorded, until ctx is done, the watcher is stopped or send fails. Events following sequenceToken are sent,
+// or only events recorded from now on when the token is blank.
+//
+// The first event passed to send carries nothing but the sequence token the watch starts
+// from, so a watcher disconnected before any event matched can resume without missing any.
+func (w *RealmWatcher) WatchRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	realmID uuid.UUID,
+	status entities.Status,
+	sequenceToken string,
+	send func(event entities.WatchedRealmEvent) error,
+) error {
+	input := realms.ListRealmEventsInput{
+		RealmID:       realmID,
+		Status:        status,
+		SequenceToken: sequenceToken,
+		Limit:         w.batchSize,
+	}
+
+	// the first listing validates the token, or positions a watch without one at the
+	// latest event, before anything is sent
+	page, err := w.listRealmEvents(ctx, logger, input)
+	if err != nil {
+		return err
+	}
+
+	startToken := input.SequenceToken
+	if startToken == "" {
+		startToken = page.NextToken
+	}
+	if sendErr := send(entities.WatchedRealmEvent{SequenceToken: startToken}); sendErr != nil {
+		return sendErr
+	}
+
+	for {
+		for _, event := range page.Events {
+			if sendErr := send(event); sendErr != nil {
+				return sendErr
+			}
+		}
+		input.SequenceToken = page.NextToken
+
+		// a full batch means more events are waiting, so they are listed without delay
+		if len(page.Events) < w.batchSize {
+			if !w.wait(ctx) {
+				return nil
+			}
+		} else if w.done(ctx) {
+			return nil
+		}
+
+		page, err = w.listRealmEvents(ctx, logger, input)
+		if err != nil {
+			if ctx.Err() != nil {
+				// the watcher went away while events were listed
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// done reports whether ctx is done or the watcher was stopped.
+func (w *RealmWatcher) done(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	case <-w.stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait blocks for the poll interval, it reports false when ctx was done or the watcher was
+// stopped in the meantime.
+func (w *RealmWatcher) wait(ctx context.Context) bool {
+	timer := time.NewTimer(w.pollInterval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-w.stopped:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (w *RealmWatcher) listRealmEvents(
+	ctx context.Context,
+	logger logging.Logger,
+	input realms.ListRealmEventsInput,
+) (entities.RealmEventPage, error) {
+	repos := realms.ListRealmEventsRepos{
+		Logger:     logger,
+		Repository: w.dataStoreManager.NewNonTransactionalReadDatastore(ctx),
+	}
+
+	return w.eventLister.ListRealmEvents(ctx, repos, input)
+}
@@ -0,0 +1,263 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realmmgr_clock "github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+	"github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+type DueReleaseLister interface {
+	ListDueReleases(ctx context.Context, repos realms.ListDueReleasesRepos, input realms.ListDueReleasesInput) ([]entities.ReleaseSchedule, error)
+}
+
+type DueReleaseClaimer interface {
+	ClaimDueRelease(ctx context.Context, repos realms.ClaimDueReleaseRepos, input realms.ClaimDueReleaseInput) (entities.ReleaseSchedule, error)
+}
+
+type ReleaseFailureRecorder interface {
+	RecordReleaseFailure(
+		ctx context.Context,
+		repos realms.RecordReleaseFailureRepos,
+		input realms.RecordReleaseFailureInput,
+	) (entities.ReleaseSchedule, error)
+}
+
+// ScheduledReleaseWorker periodically releases the drafts whose scheduled release is due.
+// Every release runs in its own transaction, failed releases are recorded on the schedule
+// to be retried later.
+//
+// Workers of all replicas list the same due schedules. Every release transaction claims its
+// schedule first, so a schedule is released by a single worker, and the others skip it.
+type ScheduledReleaseWorker struct {
+	logger           logging.Logger
+	clock            realmmgr_clock.Clock
+	dataStoreManager DataStoreManager
+
+	dueLister       DueReleaseLister
+	releaseClaimer  DueReleaseClaimer
+	realmReleaser   RealmReleaser
+	failureRecorder ReleaseFailureRecorder
+
+	pollInterval time.Duration
+	batchSize    int
+}
+
+func NewScheduledReleaseWorker(
+	logger logging.Logger,
+	clock realmmgr_clock.Clock,
+	dataStoreManager DataStoreManager,
+	dueLister DueReleaseLister,
+	releaseClaimer DueReleaseClaimer,
+	realmReleaser RealmReleaser,
+	failureRecorder ReleaseFailureRecorder,
+	pollInterval time.Duration,
+	batchSize int,
+) (*ScheduledReleaseWorker, error) {
+	if logger == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if clock == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if dataStoreManager == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("dataStoreManager", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if dueLister == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("dueLister", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if releaseClaimer == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("releaseClaimer", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if realmReleaser == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("realmReleaser", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if failureRecorder == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("failureRecorder", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if pollInterval <= 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("pollInterval", "must be positive")
+	}
+	if batchSize <= 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("batchSize", "must be positive")
+	}
+	return &ScheduledReleaseWorker{
+		logger:           logger.WithField("worker", "scheduled-release"),
+		clock:            clock,
+		dataStoreManager: dataStoreManager,
+		dueLister:        dueLister,
+		releaseClaimer:   releaseClaimer,
+		realmReleaser:    realmReleaser,
+		failureRecorder:  failureRecorder,
+		pollInterval:     pollInterval,
+		batchSize:        batchSize,
+	}, nil
+}
+
+// Run polls for due releases every poll interval until ctx is done.
+func (w *ScheduledReleaseWorker) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			w.ReleaseDue(ctx)
+			timer.Reset(w.pollInterval)
+		}
+	}
+}
+
+// ReleaseDue releases a single batch of due drafts and returns the number of drafts released.
+func (w *ScheduledReleaseWorker) ReleaseDue(ctx context.Context) int {
+	repos := realms.ListDueReleasesRepos{
+		Logger:     w.logger,
+		Clock:      w.clock,
+		Repository: w.dataStoreManager.NewNonTransactionalReadDatastore(ctx),
+	}
+
+	input := realms.ListDueReleasesInput{
+		Limit: w.batchSize,
+	}
+
+	schedules, err := w.dueLister.ListDueReleases(ctx, repos, input)
+	if err != nil {
+		w.logger.WithError(err).Error("failed to list due releases")
+		return 0
+	}
+
+	released := 0
+	for _, schedule := range schedules {
+		logger := w.logger.WithFields(map[string]interface{}{
+			"realm-id": schedule.RealmID,
+			"attempt":  schedule.Attempts + 1,
+		})
+
+		claimed, releaseErr := w.release(ctx, logger, schedule)
+		if releaseErr != nil {
+			logger.WithError(releaseErr).Warn("scheduled release failed")
+			w.recordFailure(ctx, logger, schedule, releaseErr)
+			continue
+		}
+		if !claimed {
+			logger.Info("scheduled release claimed by another worker or no longer due")
+			continue
+		}
+
+		logger.Info("scheduled release completed")
+		released++
+	}
+
+	return released
+}
+
+// release releases the draft of the schedule, it reports whether the schedule was claimed.
+// Schedules claimed by another worker, or changed since they were listed, are left alone.
+func (w *ScheduledReleaseWorker) release(
+	ctx context.Context,
+	logger logging.Logger,
+	schedule entities.ReleaseSchedule,
+) (bool, error) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, w.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return false, realmmgr_errors.NewInternalError("failed to configure repositories", err)
+	}
+	defer rollbackFn()
+
+	claimRepos := realms.ClaimDueReleaseRepos{
+		Logger:     logger,
+		Clock:      w.clock,
+		Repository: repository,
+	}
+
+	claimInput := realms.ClaimDueReleaseInput{
+		RealmID: schedule.RealmID,
+	}
+
+	claimed, err := w.releaseClaimer.ClaimDueRelease(ctx, claimRepos, claimInput)
+	if err != nil {
+		if _, ok := err.(*realmmgr_errors.NotFoundError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	if claimed.Attempts != schedule.Attempts {
+		// another worker attempted the release since the schedule was listed
+		return false, nil
+	}
+
+	repos := realms.ReleaseRealmRepos{
+		Logger:     logger,
+		Clock:      w.clock,
+		Repository: repository,
+	}
+
+	input := realms.ReleaseRealmInput{
+		RealmID:    schedule.RealmID,
+		ReleasedBy: schedule.ScheduledBy,
+	}
+
+	if _, releaseErr := w.realmReleaser.ReleaseRealm(ctx, repos, input); releaseErr != nil {
+		return true, releaseErr
+	}
+
+	if commitErr := CommitRepositories(logger, w.dataStoreManager, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return true, realmmgr_errors.NewInternalError("failed to commit transaction", commitErr)
+	}
+
+	return true, nil
+}
+
+func (w *ScheduledReleaseWorker) recordFailure(
+	ctx context.Context,
+	logger logging.Logger,
+	schedule entities.ReleaseSchedule,
+	releaseErr error,
+) {
+	repository, auditRepository, rollbackFn, err := TransactionalRepositories(ctx, logger, w.dataStoreManager)
+	if err != nil {
+		logger.WithError(err).Error("failed to configure repositories")
+		return
+	}
+	defer rollbackFn()
+
+	repos := realms.RecordReleaseFailureRepos{
+		Logger:     logger,
+		Clock:      w.clock,
+		Repository: repository,
+	}
+
+	input := realms.RecordReleaseFailureInput{
+		RealmID:  schedule.RealmID,
+		Attempts: schedule.Attempts,
+		Reason:   releaseErr.Error(),
+	}
+
+	updatedSchedule, err := w.failureRecorder.RecordReleaseFailure(ctx, repos, input)
+	if err != nil {
+		if _, ok := err.(*realmmgr_errors.NotFoundError); ok {
+			// the schedule was cancelled, completed or attempted by another worker in the meantime
+			logger.Info("release schedule no longer due")
+			return
+		}
+		logger.WithError(err).Error("failed to record release failure")
+		return
+	}
+
+	if commitErr := CommitRepositories(logger, w.dataStoreManager, repository, auditRepository); commitErr != nil {
+		logger.WithError(commitErr).Error("failed to commit transaction")
+		return
+	}
+
+	if updatedSchedule.State == entities.ReleaseScheduleStateFailed {
+		logger.Error("scheduled release ran out of attempts")
+	}
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// ClaimDueReleaseSchedule locks the pending schedule of the realm for the rest of the
+// transaction, provided its next release attempt is due at dueAt. Schedules locked by another
+// transaction are skipped rather than waited for, a not found error is returned for them just
+// like for schedules that are gone or no longer due.
+func (d *DataStore) ClaimDueReleaseSchedule(
+	ctx context.Context,
+	realmID uuid.UUID,
+	dueAt time.Time,
+) (entities.ReleaseSchedule, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectReleaseScheduleColumns...).
+		From(models.ReleaseScheduleTableName).
+		Where(sq.Eq{
+			models.ReleaseScheduleColumnRealmID.WithTable(): realmID,
+			models.ReleaseScheduleColumnState.WithTable():   models.ReleaseScheduleStateEnumValues[entities.ReleaseScheduleStatePending],
+		}).
+		Where(sq.LtOrEq{
+			models.ReleaseScheduleColumnNextAttemptAt.WithTable(): dueAt,
+		}).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	schedule, err := scanReleaseSchedule(query.RunWith(d.db).QueryRowContext(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.ReleaseSchedule{}, realmmgr_errors.NewNotFoundError("due release schedule not found", err)
+		}
+		return entities.ReleaseSchedule{}, err
+	}
+
+	return schedule, nil
+}
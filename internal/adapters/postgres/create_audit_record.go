@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var insertAuditColumns = []string{
+	models.AuditColumnKey.String(),
+	models.AuditColumnRealmID.String(),
+	models.AuditColumnAction.String(),
+	models.AuditColumnReason.String(),
+	models.AuditColumnCreatedAt.String(),
+}
+
+func (d *DataStore) CreateAuditRecord(ctx context.Context, record entities.AuditRecord) error {
+	key, err := d.uuidgen.New()
+	if err != nil {
+		return realmmgr_errors.NewInternalError("failed to generate UUID key", err)
+	}
+
+	action, ok := models.AuditActionEnumValues[record.Action]
+	if !ok {
+		return realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected audit action type: %d", record.Action),
+			nil,
+		)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.AuditTableName).
+		Columns(insertAuditColumns...).
+		Values(
+			key,
+			record.RealmID,
+			action,
+			record.Reason,
+			record.CreatedAt,
+		)
+
+	if _, insertErr := query.RunWith(d.db).ExecContext(ctx); insertErr != nil {
+		return realmmgr_errors.NewInternalError("audit record insert failed", insertErr)
+	}
+
+	return nil
+}
@@ -2,9 +2,11 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 
 	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
 	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
@@ -19,8 +21,19 @@ var insertRealmColumns = []string{
 	models.RealmColumnStatus.String(),
 	models.RealmColumnCreatedAt.String(),
 	models.RealmColumnUpdatedAt.String(),
+	models.RealmColumnRevision.String(),
+	models.RealmColumnLabels.String(),
+	models.RealmColumnAttributes.String(),
+	models.RealmColumnParentID.String(),
+	models.RealmColumnClonedFrom.String(),
+	models.RealmColumnTemplateID.String(),
+	models.RealmColumnTemplateVer.String(),
+	models.RealmColumnSlug.String(),
 }
 
+// CreateRealm stores a new realm row. A realm has at most one row in every status but deleted,
+// an aborted error is returned when a row of the realm in the same status was created in the
+// meantime.
 func (d *DataStore) CreateRealm(ctx context.Context, realm entities.Realm) error {
 	key, err := d.uuidgen.New()
 	if err != nil {
@@ -35,6 +48,16 @@ func (d *DataStore) CreateRealm(ctx context.Context, realm entities.Realm) error
 		)
 	}
 
+	labels, err := models.LabelsToDB(realm.Labels)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := models.AttributesToDB(realm.Attributes)
+	if err != nil {
+		return err
+	}
+
 	query := sq.StatementBuilder.
 		PlaceholderFormat(sq.Dollar).
 		Insert(models.RealmTableName).
@@ -47,10 +70,37 @@ func (d *DataStore) CreateRealm(ctx context.Context, realm entities.Realm) error
 			status,
 			realm.CreatedAt,
 			realm.UpdatedAt,
-		)
+			realm.Revision,
+			labels,
+			attributes,
+			models.NullUUIDToDB(realm.ParentID),
+			models.NullUUIDToDB(realm.ClonedFrom),
+			models.NullUUIDToDB(realm.Template.ID),
+			sql.NullInt64{Int64: realm.Template.Version, Valid: realm.Template.ID != uuid.Nil},
+			sql.NullString{String: realm.Slug, Valid: realm.Slug != ""},
+		).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%s, %s) WHERE %s <> '%s' DO NOTHING",
+			models.RealmColumnID,
+			models.RealmColumnStatus,
+			models.RealmColumnStatus,
+			models.StatusEnumValues[entities.StatusDeleted],
+		))
 
-	if _, insertErr := query.RunWith(d.db).ExecContext(ctx); insertErr != nil {
-		return realmmgr_errors.NewInternalError("realm insert failed", insertErr)
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm insert failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm insert failed", err)
+	}
+	if affected == 0 {
+		return realmmgr_errors.NewAbortedError(
+			fmt.Sprintf("realm with ID %s in status %s was created concurrently", realm.ID, status),
+			nil,
+		)
 	}
 
 	return nil
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var insertEventColumns = []string{
+	models.EventColumnRealmID.String(),
+	models.EventColumnType.String(),
+	models.EventColumnStatus.String(),
+	models.EventColumnOccurredAt.String(),
+}
+
+// CreateRealmEvent records a change made to a realm, the transaction and sequence of the
+// event are assigned by the database.
+func (d *DataStore) CreateRealmEvent(ctx context.Context, event entities.RealmEvent) error {
+	eventType, ok := models.RealmEventTypeEnumValues[event.Type]
+	if !ok {
+		return realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected realm event type: %d", event.Type),
+			nil,
+		)
+	}
+
+	dbStatus, ok := models.StatusEnumValues[event.Status]
+	if !ok {
+		return realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected status type: %d", event.Status),
+			nil,
+		)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.EventTableName).
+		Columns(insertEventColumns...).
+		Values(
+			event.RealmID,
+			eventType,
+			dbStatus,
+			event.OccurredAt,
+		)
+
+	if _, err := query.RunWith(d.db).ExecContext(ctx); err != nil {
+		return realmmgr_errors.NewInternalError("realm event insert failed", err)
+	}
+
+	return nil
+}
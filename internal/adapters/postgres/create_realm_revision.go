@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var insertRevisionColumns = []string{
+	models.RevisionColumnKey.String(),
+	models.RevisionColumnRealmID.String(),
+	models.RevisionColumnRevision.String(),
+	models.RevisionColumnName.String(),
+	models.RevisionColumnDesc.String(),
+	models.RevisionColumnStatus.String(),
+	models.RevisionColumnCreatedAt.String(),
+	models.RevisionColumnUpdatedAt.String(),
+	models.RevisionColumnLabels.String(),
+	models.RevisionColumnAttributes.String(),
+	models.RevisionColumnParentID.String(),
+	models.RevisionColumnReleasedBy.String(),
+	models.RevisionColumnReleasedAt.String(),
+}
+
+func (d *DataStore) CreateRealmRevision(ctx context.Context, revision entities.RealmRevision) error {
+	key, err := d.uuidgen.New()
+	if err != nil {
+		return realmmgr_errors.NewInternalError("failed to generate UUID key", err)
+	}
+
+	status, ok := models.StatusEnumValues[revision.Realm.Status]
+	if !ok {
+		return realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected status type: %d", revision.Realm.Status),
+			nil,
+		)
+	}
+
+	labels, err := models.LabelsToDB(revision.Realm.Labels)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := models.AttributesToDB(revision.Realm.Attributes)
+	if err != nil {
+		return err
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.RevisionTableName).
+		Columns(insertRevisionColumns...).
+		Values(
+			key,
+			revision.Realm.ID,
+			revision.Realm.Revision,
+			revision.Realm.Name,
+			revision.Realm.Description,
+			status,
+			revision.Realm.CreatedAt,
+			revision.Realm.UpdatedAt,
+			labels,
+			attributes,
+			models.NullUUIDToDB(revision.Realm.ParentID),
+			revision.ReleasedBy,
+			revision.ReleasedAt,
+		)
+
+	if _, insertErr := query.RunWith(d.db).ExecContext(ctx); insertErr != nil {
+		return realmmgr_errors.NewInternalError("realm revision insert failed", insertErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// CreateRealmSlug stores a new slug of a realm. An aborted error is returned when the slug
+// was taken in the meantime.
+func (d *DataStore) CreateRealmSlug(ctx context.Context, slug entities.RealmSlug) error {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.SlugTableName).
+		Columns(
+			models.SlugColumnSlug.String(),
+			models.SlugColumnRealmID.String(),
+			models.SlugColumnCreatedAt.String(),
+			models.SlugColumnExpiresAt.String(),
+		).
+		Values(
+			slug.Slug,
+			slug.RealmID,
+			slug.CreatedAt,
+			sql.NullTime{Time: slug.ExpiresAt, Valid: !slug.ExpiresAt.IsZero()},
+		).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", models.SlugColumnSlug))
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm slug insert failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm slug insert failed", err)
+	}
+	if affected == 0 {
+		return realmmgr_errors.NewAbortedError(
+			fmt.Sprintf("realm slug %s was taken concurrently", slug.Slug),
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var insertTemplateColumns = []string{
+	models.TemplateColumnKey.String(),
+	models.TemplateColumnID.String(),
+	models.TemplateColumnVersion.String(),
+	models.TemplateColumnName.String(),
+	models.TemplateColumnDesc.String(),
+	models.TemplateColumnRealmDesc.String(),
+	models.TemplateColumnLabels.String(),
+	models.TemplateColumnAttributes.String(),
+	models.TemplateColumnParameters.String(),
+	models.TemplateColumnCreatedBy.String(),
+	models.TemplateColumnCreatedAt.String(),
+}
+
+// CreateRealmTemplate stores a new version of a template. An aborted error is returned when
+// the version was stored in the meantime.
+func (d *DataStore) CreateRealmTemplate(ctx context.Context, template entities.RealmTemplate) error {
+	key, err := d.uuidgen.New()
+	if err != nil {
+		return realmmgr_errors.NewInternalError("failed to generate UUID key", err)
+	}
+
+	labels, err := models.LabelsToDB(template.Labels)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := models.AttributesToDB(template.Attributes)
+	if err != nil {
+		return err
+	}
+
+	parameters, err := models.TemplateParametersToDB(template.Parameters)
+	if err != nil {
+		return err
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.TemplateTableName).
+		Columns(insertTemplateColumns...).
+		Values(
+			key,
+			template.ID,
+			template.Version,
+			template.Name,
+			template.Description,
+			template.RealmDescription,
+			labels,
+			attributes,
+			parameters,
+			template.CreatedBy,
+			template.CreatedAt,
+		).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%s, %s) DO NOTHING",
+			models.TemplateColumnID,
+			models.TemplateColumnVersion,
+		))
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm template insert failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm template insert failed", err)
+	}
+	if affected == 0 {
+		return realmmgr_errors.NewAbortedError(
+			fmt.Sprintf("realm template with ID %s was changed concurrently", template.ID),
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// DeleteRealmAtRevision deletes the realm row in status, provided the row is still at revision.
+// An aborted error is returned when the row was changed in the meantime.
+func (d *DataStore) DeleteRealmAtRevision(
+	ctx context.Context,
+	realmID uuid.UUID,
+	status entities.Status,
+	revision int64,
+) error {
+	dbStatus, ok := models.StatusEnumValues[status]
+	if !ok {
+		return realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected status type: %d", status),
+			nil,
+		)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Delete(models.RealmTableName).
+		Where(sq.Eq{
+			models.RealmColumnID.String():       realmID,
+			models.RealmColumnStatus.String():   dbStatus,
+			models.RealmColumnRevision.String(): revision,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm delete failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm delete failed", err)
+	}
+	if affected == 0 {
+		return realmmgr_errors.NewAbortedError(
+			fmt.Sprintf("realm with ID %s was changed concurrently", realmID),
+			nil,
+		)
+	}
+
+	return nil
+}
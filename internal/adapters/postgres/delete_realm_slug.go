@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// DeleteRealmSlug deletes a single slug and returns the number of slugs affected.
+func (d *DataStore) DeleteRealmSlug(ctx context.Context, slug string) (int64, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Delete(models.SlugTableName).
+		Where(sq.Eq{
+			models.SlugColumnSlug.String(): slug,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm slug delete failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm slug delete failed", err)
+	}
+
+	return affected, nil
+}
+
+// DeleteRealmSlugs deletes the current slug and all redirects of the realm.
+func (d *DataStore) DeleteRealmSlugs(ctx context.Context, realmID uuid.UUID) error {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Delete(models.SlugTableName).
+		Where(sq.Eq{
+			models.SlugColumnRealmID.String(): realmID,
+		})
+
+	if _, err := query.RunWith(d.db).ExecContext(ctx); err != nil {
+		return realmmgr_errors.NewInternalError("realm slugs delete failed", err)
+	}
+
+	return nil
+}
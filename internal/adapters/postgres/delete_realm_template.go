@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// DeleteRealmTemplate marks all versions of the template as deleted and returns the number
+// of versions affected. The versions are kept for realms that were created from them.
+func (d *DataStore) DeleteRealmTemplate(ctx context.Context, templateID uuid.UUID, deletedAt time.Time) (int64, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Update(models.TemplateTableName).
+		Set(models.TemplateColumnDeletedAt.String(), deletedAt).
+		Where(sq.Eq{
+			models.TemplateColumnID.String():        templateID,
+			models.TemplateColumnDeletedAt.String(): nil,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm template delete failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm template delete failed", err)
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// DeleteReleaseApproval removes the approval request of a realm together with all
+// decisions made on it.
+func (d *DataStore) DeleteReleaseApproval(ctx context.Context, realmID uuid.UUID) error {
+	decisionQuery := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Delete(models.DecisionTableName).
+		Where(sq.Eq{
+			models.DecisionColumnRealmID.String(): realmID,
+		})
+
+	if _, err := decisionQuery.RunWith(d.db).ExecContext(ctx); err != nil {
+		return realmmgr_errors.NewInternalError("release approval decision delete failed", err)
+	}
+
+	approvalQuery := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Delete(models.ApprovalTableName).
+		Where(sq.Eq{
+			models.ApprovalColumnRealmID.String(): realmID,
+		})
+
+	if _, err := approvalQuery.RunWith(d.db).ExecContext(ctx); err != nil {
+		return realmmgr_errors.NewInternalError("release approval delete failed", err)
+	}
+
+	return nil
+}
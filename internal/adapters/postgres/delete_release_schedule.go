@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// DeleteReleaseSchedule removes the release schedule of a realm and returns the number
+// of rows affected.
+func (d *DataStore) DeleteReleaseSchedule(ctx context.Context, realmID uuid.UUID) (int64, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Delete(models.ReleaseScheduleTableName).
+		Where(sq.Eq{
+			models.ReleaseScheduleColumnRealmID.String(): realmID,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("release schedule delete failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("release schedule delete failed", err)
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// ExpireRealmSlug turns the current slug of a realm into a redirect expiring at expiresAt and
+// returns the number of slugs affected.
+func (d *DataStore) ExpireRealmSlug(ctx context.Context, slug string, expiresAt time.Time) (int64, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Update(models.SlugTableName).
+		Set(models.SlugColumnExpiresAt.String(), expiresAt).
+		Where(sq.Eq{
+			models.SlugColumnSlug.String():      slug,
+			models.SlugColumnExpiresAt.String(): nil,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm slug update failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm slug update failed", err)
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// GetLatestRealmEventCursor returns the cursor of the latest realm event of a finished
+// transaction, a zero cursor when there is no such event yet. Events of running transactions
+// are ordered after it once they commit.
+func (d *DataStore) GetLatestRealmEventCursor(ctx context.Context) (entities.RealmEventCursor, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(
+			models.EventColumnTransaction.WithTable(),
+			models.EventColumnSequence.WithTable(),
+		).
+		From(models.EventTableName).
+		Where(fmt.Sprintf("%s < %s", models.EventColumnTransaction.WithTable(), finishedTransactionsBound)).
+		OrderBy(
+			fmt.Sprintf("%s DESC", models.EventColumnTransaction.WithTable()),
+			fmt.Sprintf("%s DESC", models.EventColumnSequence.WithTable()),
+		).
+		Limit(1)
+
+	var cursor entities.RealmEventCursor
+	err := query.RunWith(d.db).QueryRowContext(ctx).Scan(&cursor.Transaction, &cursor.Sequence)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entities.RealmEventCursor{}, nil
+	}
+	if err != nil {
+		return entities.RealmEventCursor{}, realmmgr_errors.NewInternalError("realm event cursor select failed", err)
+	}
+
+	return cursor, nil
+}
@@ -22,6 +22,15 @@ var selectRealmColumns = []string{
 	models.RealmColumnCreatedAt.WithTable(),
 	models.RealmColumnUpdatedAt.WithTable(),
 	models.RealmColumnDeletedAt.WithTable(),
+	models.RealmColumnPreviousStatus.WithTable(),
+	models.RealmColumnRevision.WithTable(),
+	models.RealmColumnLabels.WithTable(),
+	models.RealmColumnAttributes.WithTable(),
+	models.RealmColumnParentID.WithTable(),
+	models.RealmColumnClonedFrom.WithTable(),
+	models.RealmColumnTemplateID.WithTable(),
+	models.RealmColumnTemplateVer.WithTable(),
+	models.RealmColumnSlug.WithTable(),
 }
 
 func (d *DataStore) GetRealm(ctx context.Context, realmID uuid.UUID, status entities.Status) (entities.Realm, error) {
@@ -42,12 +51,35 @@ func (d *DataStore) GetRealm(ctx context.Context, realmID uuid.UUID, status enti
 			models.RealmColumnStatus.WithTable(): dbStatus,
 		})
 
+	realm, err := scanRealm(query.RunWith(d.db).QueryRowContext(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Realm{}, realmmgr_errors.NewNotFoundError("realm not found", err)
+		}
+		return entities.Realm{}, err
+	}
+
+	return realm, nil
+}
+
+// scanRealm reads a single realm selected with selectRealmColumns, columns selected after
+// them are read into extra. sql.ErrNoRows is returned unwrapped so callers can decide
+// whether a missing row is an error.
+func scanRealm(row sq.RowScanner, extra ...interface{}) (entities.Realm, error) {
 	var realm entities.Realm
 
 	var statusDBVal string
 	var deletedAt sql.NullTime
+	var previousStatusDBVal sql.NullString
+	var labelsDBVal []byte
+	var attributesDBVal []byte
+	var parentID uuid.NullUUID
+	var clonedFrom uuid.NullUUID
+	var templateID uuid.NullUUID
+	var templateVersion sql.NullInt64
+	var slug sql.NullString
 
-	if err := query.RunWith(d.db).QueryRowContext(ctx).Scan(
+	dest := []interface{}{
 		&realm.ID,
 		&realm.Name,
 		&realm.Description,
@@ -55,9 +87,20 @@ func (d *DataStore) GetRealm(ctx context.Context, realmID uuid.UUID, status enti
 		&realm.CreatedAt,
 		&realm.UpdatedAt,
 		&deletedAt,
-	); err != nil {
+		&previousStatusDBVal,
+		&realm.Revision,
+		&labelsDBVal,
+		&attributesDBVal,
+		&parentID,
+		&clonedFrom,
+		&templateID,
+		&templateVersion,
+		&slug,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return entities.Realm{}, realmmgr_errors.NewNotFoundError("realm not found", err)
+			return entities.Realm{}, err
 		}
 		return entities.Realm{}, realmmgr_errors.NewInternalError("realm select failed", err)
 	}
@@ -75,5 +118,35 @@ func (d *DataStore) GetRealm(ctx context.Context, realmID uuid.UUID, status enti
 		realm.DeletedAt = deletedAt.Time
 	}
 
+	if previousStatusDBVal.Valid {
+		previousStatus, ok := models.StatusDBValues[previousStatusDBVal.String]
+		if !ok {
+			return entities.Realm{}, realmmgr_errors.NewUnknownError(
+				fmt.Sprintf("unexpected status type: %s", previousStatusDBVal.String),
+				nil,
+			)
+		}
+		realm.PreviousStatus = previousStatus
+	}
+
+	labels, err := models.LabelsFromDB(labelsDBVal)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+	realm.Labels = labels
+
+	attributes, err := models.AttributesFromDB(attributesDBVal)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+	realm.Attributes = attributes
+	realm.ParentID = parentID.UUID
+	realm.ClonedFrom = clonedFrom.UUID
+	realm.Template = entities.RealmTemplateRef{
+		ID:      templateID.UUID,
+		Version: templateVersion.Int64,
+	}
+	realm.Slug = slug.String
+
 	return realm, nil
 }
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var selectRevisionColumns = []string{
+	models.RevisionColumnRealmID.WithTable(),
+	models.RevisionColumnRevision.WithTable(),
+	models.RevisionColumnName.WithTable(),
+	models.RevisionColumnDesc.WithTable(),
+	models.RevisionColumnStatus.WithTable(),
+	models.RevisionColumnCreatedAt.WithTable(),
+	models.RevisionColumnUpdatedAt.WithTable(),
+	models.RevisionColumnLabels.WithTable(),
+	models.RevisionColumnAttributes.WithTable(),
+	models.RevisionColumnParentID.WithTable(),
+	models.RevisionColumnReleasedBy.WithTable(),
+	models.RevisionColumnReleasedAt.WithTable(),
+}
+
+func (d *DataStore) GetRealmRevision(ctx context.Context, realmID uuid.UUID, revision int64) (entities.RealmRevision, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectRevisionColumns...).
+		From(models.RevisionTableName).
+		Where(sq.Eq{
+			models.RevisionColumnRealmID.WithTable():  realmID,
+			models.RevisionColumnRevision.WithTable(): revision,
+		})
+
+	return d.getRealmRevision(ctx, query)
+}
+
+// GetRealmRevisionAt returns the revision that was the released state of the realm at
+// the given point in time, i.e. the latest revision released at or before it.
+func (d *DataStore) GetRealmRevisionAt(ctx context.Context, realmID uuid.UUID, at time.Time) (entities.RealmRevision, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectRevisionColumns...).
+		From(models.RevisionTableName).
+		Where(sq.Eq{
+			models.RevisionColumnRealmID.WithTable(): realmID,
+		}).
+		Where(sq.LtOrEq{
+			models.RevisionColumnReleasedAt.WithTable(): at,
+		}).
+		OrderBy(fmt.Sprintf("%s DESC", models.RevisionColumnRevision.WithTable())).
+		Limit(1)
+
+	return d.getRealmRevision(ctx, query)
+}
+
+func (d *DataStore) getRealmRevision(ctx context.Context, query sq.SelectBuilder) (entities.RealmRevision, error) {
+	revision, err := scanRealmRevision(query.RunWith(d.db).QueryRowContext(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.RealmRevision{}, realmmgr_errors.NewNotFoundError("realm revision not found", err)
+		}
+		return entities.RealmRevision{}, err
+	}
+
+	return revision, nil
+}
+
+// scanRealmRevision reads a single revision selected with selectRevisionColumns. sql.ErrNoRows
+// is returned unwrapped so callers can decide whether a missing row is an error.
+func scanRealmRevision(row sq.RowScanner) (entities.RealmRevision, error) {
+	var revision entities.RealmRevision
+
+	var statusDBVal string
+	var labelsDBVal []byte
+	var attributesDBVal []byte
+	var parentID uuid.NullUUID
+
+	if err := row.Scan(
+		&revision.Realm.ID,
+		&revision.Realm.Revision,
+		&revision.Realm.Name,
+		&revision.Realm.Description,
+		&statusDBVal,
+		&revision.Realm.CreatedAt,
+		&revision.Realm.UpdatedAt,
+		&labelsDBVal,
+		&attributesDBVal,
+		&parentID,
+		&revision.ReleasedBy,
+		&revision.ReleasedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.RealmRevision{}, err
+		}
+		return entities.RealmRevision{}, realmmgr_errors.NewInternalError("realm revision select failed", err)
+	}
+
+	realmStatus, ok := models.StatusDBValues[statusDBVal]
+	if !ok {
+		return entities.RealmRevision{}, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected status type: %s", statusDBVal),
+			nil,
+		)
+	}
+	revision.Realm.Status = realmStatus
+
+	labels, err := models.LabelsFromDB(labelsDBVal)
+	if err != nil {
+		return entities.RealmRevision{}, err
+	}
+	revision.Realm.Labels = labels
+
+	attributes, err := models.AttributesFromDB(attributesDBVal)
+	if err != nil {
+		return entities.RealmRevision{}, err
+	}
+	revision.Realm.Attributes = attributes
+	revision.Realm.ParentID = parentID.UUID
+
+	return revision, nil
+}
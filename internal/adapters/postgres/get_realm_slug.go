@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// GetRealmSlug returns the realm slug, redirects are returned even when they have expired.
+func (d *DataStore) GetRealmSlug(ctx context.Context, slug string) (entities.RealmSlug, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(
+			models.SlugColumnSlug.String(),
+			models.SlugColumnRealmID.String(),
+			models.SlugColumnCreatedAt.String(),
+			models.SlugColumnExpiresAt.String(),
+		).
+		From(models.SlugTableName).
+		Where(sq.Eq{
+			models.SlugColumnSlug.String(): slug,
+		})
+
+	var realmSlug entities.RealmSlug
+	var expiresAt sql.NullTime
+
+	if err := query.RunWith(d.db).QueryRowContext(ctx).Scan(
+		&realmSlug.Slug,
+		&realmSlug.RealmID,
+		&realmSlug.CreatedAt,
+		&expiresAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.RealmSlug{}, realmmgr_errors.NewNotFoundError("realm slug not found", err)
+		}
+		return entities.RealmSlug{}, realmmgr_errors.NewInternalError("realm slug select failed", err)
+	}
+
+	if expiresAt.Valid {
+		realmSlug.ExpiresAt = expiresAt.Time
+	}
+
+	return realmSlug, nil
+}
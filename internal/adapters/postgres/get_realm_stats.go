@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// GetRealmStats aggregates the realms matching the options. Realms are counted per status
+// by one query and per day of creation by another, both of them outside of a transaction, so
+// realms changed in between may be counted differently by the two.
+func (d *DataStore) GetRealmStats(ctx context.Context, options entities.RealmStatsOptions) (entities.RealmStats, error) {
+	var stats entities.RealmStats
+
+	if err := d.countRealmsByStatus(ctx, options, &stats); err != nil {
+		return entities.RealmStats{}, err
+	}
+
+	createdPerDay, err := d.countRealmsByDay(ctx, options)
+	if err != nil {
+		return entities.RealmStats{}, err
+	}
+	stats.CreatedPerDay = createdPerDay
+
+	return stats, nil
+}
+
+func (d *DataStore) countRealmsByStatus(ctx context.Context, options entities.RealmStatsOptions, stats *entities.RealmStats) error {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(
+			models.RealmColumnStatus.WithTable(),
+			fmt.Sprintf("count(DISTINCT %s)", models.RealmColumnID.WithTable()),
+		).
+		Column(sq.Expr(
+			fmt.Sprintf(
+				"count(DISTINCT %s) FILTER (WHERE %s < ?)",
+				models.RealmColumnID.WithTable(),
+				models.RealmColumnUpdatedAt.WithTable(),
+			),
+			options.StaleDraftsBefore,
+		)).
+		From(models.RealmTableName).
+		GroupBy(models.RealmColumnStatus.WithTable())
+
+	query, err := withRealmStatsFilters(query, options)
+	if err != nil {
+		return err
+	}
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm stats select failed", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			dbStatus          string
+			count, staleCount int64
+		)
+		if scanErr := rows.Scan(&dbStatus, &count, &staleCount); scanErr != nil {
+			return realmmgr_errors.NewInternalError("realm stats scan failed", scanErr)
+		}
+
+		status, ok := models.StatusDBValues[dbStatus]
+		if !ok {
+			return realmmgr_errors.NewUnknownError(fmt.Sprintf("unexpected status type: %s", dbStatus), nil)
+		}
+
+		switch status {
+		case entities.StatusActive:
+			stats.ActiveCount = count
+		case entities.StatusDraft:
+			stats.DraftCount = count
+			stats.StaleDraftCount = staleCount
+		case entities.StatusDisabled:
+			stats.DisabledCount = count
+		case entities.StatusDeleted:
+			stats.DeletedCount = count
+		}
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return realmmgr_errors.NewInternalError("realm stats select failed", rowsErr)
+	}
+
+	return nil
+}
+
+func (d *DataStore) countRealmsByDay(ctx context.Context, options entities.RealmStatsOptions) ([]entities.RealmDailyCount, error) {
+	day := fmt.Sprintf("date_trunc('day', %s)", models.RealmColumnCreatedAt.WithTable())
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(
+			day,
+			fmt.Sprintf("count(DISTINCT %s)", models.RealmColumnID.WithTable()),
+		).
+		From(models.RealmTableName).
+		Where(sq.GtOrEq{models.RealmColumnCreatedAt.WithTable(): options.DailyFrom}).
+		Where(sq.Lt{models.RealmColumnCreatedAt.WithTable(): options.DailyTo}).
+		GroupBy(day).
+		OrderBy(day)
+
+	query, err := withRealmStatsFilters(query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm daily stats select failed", err)
+	}
+	defer rows.Close()
+
+	counts := make([]entities.RealmDailyCount, 0)
+	for rows.Next() {
+		var count entities.RealmDailyCount
+		if scanErr := rows.Scan(&count.Day, &count.Count); scanErr != nil {
+			return nil, realmmgr_errors.NewInternalError("realm daily stats scan failed", scanErr)
+		}
+		counts = append(counts, count)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm daily stats select failed", rowsErr)
+	}
+
+	return counts, nil
+}
+
+// withRealmStatsFilters restricts the query to the realms matching the label selector and
+// creation range of the options.
+func withRealmStatsFilters(query sq.SelectBuilder, options entities.RealmStatsOptions) (sq.SelectBuilder, error) {
+	if !options.CreatedAfter.IsZero() {
+		query = query.Where(sq.GtOrEq{models.RealmColumnCreatedAt.WithTable(): options.CreatedAfter})
+	}
+	if !options.CreatedBefore.IsZero() {
+		query = query.Where(sq.Lt{models.RealmColumnCreatedAt.WithTable(): options.CreatedBefore})
+	}
+	return withLabelSelector(query, options.LabelSelector)
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var selectTemplateColumns = []string{
+	models.TemplateColumnID.WithTable(),
+	models.TemplateColumnVersion.WithTable(),
+	models.TemplateColumnName.WithTable(),
+	models.TemplateColumnDesc.WithTable(),
+	models.TemplateColumnRealmDesc.WithTable(),
+	models.TemplateColumnLabels.WithTable(),
+	models.TemplateColumnAttributes.WithTable(),
+	models.TemplateColumnParameters.WithTable(),
+	models.TemplateColumnCreatedBy.WithTable(),
+	models.TemplateColumnCreatedAt.WithTable(),
+	models.TemplateColumnDeletedAt.WithTable(),
+}
+
+// GetRealmTemplate returns the given version of the template, or its latest version when
+// version is zero. Versions of deleted templates are returned with DeletedAt set.
+func (d *DataStore) GetRealmTemplate(ctx context.Context, templateID uuid.UUID, version int64) (entities.RealmTemplate, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectTemplateColumns...).
+		From(models.TemplateTableName).
+		Where(sq.Eq{
+			models.TemplateColumnID.WithTable(): templateID,
+		}).
+		OrderBy(fmt.Sprintf("%s DESC", models.TemplateColumnVersion.WithTable())).
+		Limit(1)
+
+	if version > 0 {
+		query = query.Where(sq.Eq{
+			models.TemplateColumnVersion.WithTable(): version,
+		})
+	}
+
+	template, err := scanRealmTemplate(query.RunWith(d.db).QueryRowContext(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.RealmTemplate{}, realmmgr_errors.NewNotFoundError("realm template not found", err)
+		}
+		return entities.RealmTemplate{}, err
+	}
+
+	return template, nil
+}
+
+// scanRealmTemplate reads a single template selected with selectTemplateColumns. sql.ErrNoRows
+// is returned unwrapped so callers can decide whether a missing row is an error.
+func scanRealmTemplate(row sq.RowScanner) (entities.RealmTemplate, error) {
+	var template entities.RealmTemplate
+
+	var labelsDBVal []byte
+	var attributesDBVal []byte
+	var parametersDBVal []byte
+	var deletedAt sql.NullTime
+
+	if err := row.Scan(
+		&template.ID,
+		&template.Version,
+		&template.Name,
+		&template.Description,
+		&template.RealmDescription,
+		&labelsDBVal,
+		&attributesDBVal,
+		&parametersDBVal,
+		&template.CreatedBy,
+		&template.CreatedAt,
+		&deletedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.RealmTemplate{}, err
+		}
+		return entities.RealmTemplate{}, realmmgr_errors.NewInternalError("realm template select failed", err)
+	}
+
+	if deletedAt.Valid {
+		template.DeletedAt = deletedAt.Time
+	}
+
+	labels, err := models.LabelsFromDB(labelsDBVal)
+	if err != nil {
+		return entities.RealmTemplate{}, err
+	}
+	template.Labels = labels
+
+	attributes, err := models.AttributesFromDB(attributesDBVal)
+	if err != nil {
+		return entities.RealmTemplate{}, err
+	}
+	template.Attributes = attributes
+
+	parameters, err := models.TemplateParametersFromDB(parametersDBVal)
+	if err != nil {
+		return entities.RealmTemplate{}, err
+	}
+	template.Parameters = parameters
+
+	return template, nil
+}
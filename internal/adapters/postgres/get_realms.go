@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// GetRealms selects all realms with one of the given IDs in the given status. Realms that do
+// not exist are left out of the result, which is in no particular order.
+func (d *DataStore) GetRealms(ctx context.Context, realmIDs []uuid.UUID, status entities.Status) ([]entities.Realm, error) {
+	dbStatus, ok := models.StatusEnumValues[status]
+	if !ok {
+		return nil, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected status type: %d", status),
+			nil,
+		)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectRealmColumns...).
+		From(models.RealmTableName).
+		Where(sq.Expr(fmt.Sprintf("%s = ANY(?)", models.RealmColumnID.WithTable()), realmIDs)).
+		Where(sq.Eq{
+			models.RealmColumnStatus.WithTable(): dbStatus,
+		})
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm select failed", err)
+	}
+	defer rows.Close()
+
+	realms := make([]entities.Realm, 0, len(realmIDs))
+	for rows.Next() {
+		realm, scanErr := scanRealm(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		realms = append(realms, realm)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm select failed", rowsErr)
+	}
+
+	return realms, nil
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var selectApprovalColumns = []string{
+	models.ApprovalColumnRealmID.WithTable(),
+	models.ApprovalColumnRevision.WithTable(),
+	models.ApprovalColumnName.WithTable(),
+	models.ApprovalColumnDesc.WithTable(),
+	models.ApprovalColumnCreatedAt.WithTable(),
+	models.ApprovalColumnUpdatedAt.WithTable(),
+	models.ApprovalColumnLabels.WithTable(),
+	models.ApprovalColumnAttributes.WithTable(),
+	models.ApprovalColumnParentID.WithTable(),
+	models.ApprovalColumnRequestedBy.WithTable(),
+	models.ApprovalColumnRequestedAt.WithTable(),
+}
+
+var selectDecisionColumns = []string{
+	models.DecisionColumnRealmID.WithTable(),
+	models.DecisionColumnRevision.WithTable(),
+	models.DecisionColumnReviewer.WithTable(),
+	models.DecisionColumnDecision.WithTable(),
+	models.DecisionColumnComment.WithTable(),
+	models.DecisionColumnDecidedAt.WithTable(),
+}
+
+// GetReleaseApproval returns the approval request of a realm with the decisions made on
+// the requested revision, decisions on earlier revisions are not returned.
+func (d *DataStore) GetReleaseApproval(ctx context.Context, realmID uuid.UUID) (entities.ReleaseApproval, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectApprovalColumns...).
+		From(models.ApprovalTableName).
+		Where(sq.Eq{
+			models.ApprovalColumnRealmID.WithTable(): realmID,
+		})
+
+	var approval entities.ReleaseApproval
+	var labelsDBVal []byte
+	var attributesDBVal []byte
+	var parentID uuid.NullUUID
+	if err := query.RunWith(d.db).QueryRowContext(ctx).Scan(
+		&approval.Realm.ID,
+		&approval.Realm.Revision,
+		&approval.Realm.Name,
+		&approval.Realm.Description,
+		&approval.Realm.CreatedAt,
+		&approval.Realm.UpdatedAt,
+		&labelsDBVal,
+		&attributesDBVal,
+		&parentID,
+		&approval.RequestedBy,
+		&approval.RequestedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.ReleaseApproval{}, realmmgr_errors.NewNotFoundError("release approval not found", err)
+		}
+		return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("release approval select failed", err)
+	}
+	approval.Realm.Status = entities.StatusDraft
+
+	labels, err := models.LabelsFromDB(labelsDBVal)
+	if err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+	approval.Realm.Labels = labels
+
+	attributes, err := models.AttributesFromDB(attributesDBVal)
+	if err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+	approval.Realm.Attributes = attributes
+	approval.Realm.ParentID = parentID.UUID
+
+	decisions, err := d.listReleaseApprovalDecisions(ctx, realmID, approval.Realm.Revision)
+	if err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+	approval.Decisions = decisions
+
+	return approval, nil
+}
+
+func (d *DataStore) listReleaseApprovalDecisions(
+	ctx context.Context,
+	realmID uuid.UUID,
+	revision int64,
+) ([]entities.ReleaseApprovalDecision, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectDecisionColumns...).
+		From(models.DecisionTableName).
+		Where(sq.Eq{
+			models.DecisionColumnRealmID.WithTable():  realmID,
+			models.DecisionColumnRevision.WithTable(): revision,
+		}).
+		OrderBy(models.DecisionColumnDecidedAt.WithTable())
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("release approval decision select failed", err)
+	}
+	defer rows.Close()
+
+	decisions := make([]entities.ReleaseApprovalDecision, 0)
+	for rows.Next() {
+		var decision entities.ReleaseApprovalDecision
+		var decisionDBVal string
+
+		if scanErr := rows.Scan(
+			&decision.RealmID,
+			&decision.Revision,
+			&decision.Reviewer,
+			&decisionDBVal,
+			&decision.Comment,
+			&decision.DecidedAt,
+		); scanErr != nil {
+			return nil, realmmgr_errors.NewInternalError("release approval decision select failed", scanErr)
+		}
+
+		dbDecision, ok := models.ApprovalDecisionDBValues[decisionDBVal]
+		if !ok {
+			return nil, realmmgr_errors.NewUnknownError(
+				fmt.Sprintf("unexpected approval decision type: %s", decisionDBVal),
+				nil,
+			)
+		}
+		decision.Decision = dbDecision
+
+		decisions = append(decisions, decision)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("release approval decision select failed", rowsErr)
+	}
+
+	return decisions, nil
+}
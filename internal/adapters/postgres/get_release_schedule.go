@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var selectReleaseScheduleColumns = []string{
+	models.ReleaseScheduleColumnRealmID.WithTable(),
+	models.ReleaseScheduleColumnReleaseAt.WithTable(),
+	models.ReleaseScheduleColumnScheduledBy.WithTable(),
+	models.ReleaseScheduleColumnState.WithTable(),
+	models.ReleaseScheduleColumnAttempts.WithTable(),
+	models.ReleaseScheduleColumnNextAttemptAt.WithTable(),
+	models.ReleaseScheduleColumnLastError.WithTable(),
+	models.ReleaseScheduleColumnCreatedAt.WithTable(),
+	models.ReleaseScheduleColumnUpdatedAt.WithTable(),
+}
+
+func (d *DataStore) GetReleaseSchedule(ctx context.Context, realmID uuid.UUID) (entities.ReleaseSchedule, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectReleaseScheduleColumns...).
+		From(models.ReleaseScheduleTableName).
+		Where(sq.Eq{
+			models.ReleaseScheduleColumnRealmID.WithTable(): realmID,
+		})
+
+	schedule, err := scanReleaseSchedule(query.RunWith(d.db).QueryRowContext(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.ReleaseSchedule{}, realmmgr_errors.NewNotFoundError("release schedule not found", err)
+		}
+		return entities.ReleaseSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// scanReleaseSchedule reads a single schedule selected with selectReleaseScheduleColumns.
+// sql.ErrNoRows is returned unwrapped so callers can decide whether a missing row is an error.
+func scanReleaseSchedule(row sq.RowScanner) (entities.ReleaseSchedule, error) {
+	var schedule entities.ReleaseSchedule
+
+	var stateDBVal string
+
+	if err := row.Scan(
+		&schedule.RealmID,
+		&schedule.ReleaseAt,
+		&schedule.ScheduledBy,
+		&stateDBVal,
+		&schedule.Attempts,
+		&schedule.NextAttemptAt,
+		&schedule.LastError,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.ReleaseSchedule{}, err
+		}
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewInternalError("release schedule select failed", err)
+	}
+
+	state, ok := models.ReleaseScheduleStateDBValues[stateDBVal]
+	if !ok {
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected release schedule state: %s", stateDBVal),
+			nil,
+		)
+	}
+	schedule.State = state
+
+	return schedule, nil
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// ListDueReleaseSchedules lists pending schedules whose next release attempt is due at
+// dueAt, the longest overdue first.
+func (d *DataStore) ListDueReleaseSchedules(ctx context.Context, dueAt time.Time, limit int) ([]entities.ReleaseSchedule, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectReleaseScheduleColumns...).
+		From(models.ReleaseScheduleTableName).
+		Where(sq.Eq{
+			models.ReleaseScheduleColumnState.WithTable(): models.ReleaseScheduleStateEnumValues[entities.ReleaseScheduleStatePending],
+		}).
+		Where(sq.LtOrEq{
+			models.ReleaseScheduleColumnNextAttemptAt.WithTable(): dueAt,
+		}).
+		OrderBy(models.ReleaseScheduleColumnNextAttemptAt.WithTable())
+
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("release schedule select failed", err)
+	}
+	defer rows.Close()
+
+	schedules := make([]entities.ReleaseSchedule, 0)
+	for rows.Next() {
+		schedule, scanErr := scanReleaseSchedule(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("release schedule select failed", rowsErr)
+	}
+
+	return schedules, nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// ListExistingRealmIDs selects those of the given realm IDs that have rows in any status,
+// including deleted. The result is in no particular order.
+func (d *DataStore) ListExistingRealmIDs(ctx context.Context, realmIDs []uuid.UUID) ([]uuid.UUID, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(models.RealmColumnID.WithTable()).
+		Distinct().
+		From(models.RealmTableName).
+		Where(sq.Expr(fmt.Sprintf("%s = ANY(?)", models.RealmColumnID.WithTable()), realmIDs))
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm ID select failed", err)
+	}
+	defer rows.Close()
+
+	existingIDs := make([]uuid.UUID, 0, len(realmIDs))
+	for rows.Next() {
+		var realmID uuid.UUID
+		if scanErr := rows.Scan(&realmID); scanErr != nil {
+			return nil, realmmgr_errors.NewInternalError("realm ID scan failed", scanErr)
+		}
+		existingIDs = append(existingIDs, realmID)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm ID select failed", rowsErr)
+	}
+
+	return existingIDs, nil
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// ListRealmChildIDs selects the IDs of the realms that have one of the given realms as their
+// parent in any status other than deleted. The result is in no particular order.
+func (d *DataStore) ListRealmChildIDs(ctx context.Context, parentIDs []uuid.UUID) ([]uuid.UUID, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(models.RealmColumnID.WithTable()).
+		Distinct().
+		From(models.RealmTableName).
+		Where(sq.Expr(fmt.Sprintf("%s = ANY(?)", models.RealmColumnParentID.WithTable()), parentIDs)).
+		Where(sq.NotEq{
+			models.RealmColumnStatus.WithTable(): models.StatusEnumValues[entities.StatusDeleted],
+		})
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm child select failed", err)
+	}
+	defer rows.Close()
+
+	childIDs := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var childID uuid.UUID
+		if scanErr := rows.Scan(&childID); scanErr != nil {
+			return nil, realmmgr_errors.NewInternalError("realm child scan failed", scanErr)
+		}
+		childIDs = append(childIDs, childID)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm child select failed", rowsErr)
+	}
+
+	return childIDs, nil
+}
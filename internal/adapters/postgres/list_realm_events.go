@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// finishedTransactionsBound is the ID of the oldest transaction still running. Transactions
+// below it committed or rolled back, and transactions started later are assigned IDs above it.
+const finishedTransactionsBound = "pg_snapshot_xmin(pg_current_snapshot())::text::bigint"
+
+var selectEventColumns = []string{
+	models.EventColumnTransaction.WithTable(),
+	models.EventColumnSequence.WithTable(),
+	models.EventColumnRealmID.WithTable(),
+	models.EventColumnType.WithTable(),
+	models.EventColumnStatus.WithTable(),
+	models.EventColumnOccurredAt.WithTable(),
+}
+
+// ListRealmEvents returns the realm events ordered after options.After, oldest first.
+//
+// Only events of finished transactions are returned. Sequences are assigned when events are
+// inserted rather than when they commit, so an event of a running transaction may be ordered
+// before events that are already committed. Ordering by transaction first, and holding back
+// events of every transaction from the oldest running one on, makes sure no event is ever
+// ordered before an event that was already returned.
+func (d *DataStore) ListRealmEvents(
+	ctx context.Context,
+	options entities.ListRealmEventsOptions,
+) ([]entities.RealmEvent, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectEventColumns...).
+		From(models.EventTableName).
+		Where(fmt.Sprintf("%s < %s", models.EventColumnTransaction.WithTable(), finishedTransactionsBound)).
+		Where(
+			sq.Expr(
+				fmt.Sprintf("(%s, %s) > (?, ?)", models.EventColumnTransaction.WithTable(), models.EventColumnSequence.WithTable()),
+				options.After.Transaction,
+				options.After.Sequence,
+			),
+		).
+		OrderBy(
+			fmt.Sprintf("%s ASC", models.EventColumnTransaction.WithTable()),
+			fmt.Sprintf("%s ASC", models.EventColumnSequence.WithTable()),
+		)
+
+	if options.RealmID != uuid.Nil {
+		query = query.Where(sq.Eq{
+			models.EventColumnRealmID.WithTable(): options.RealmID,
+		})
+	}
+
+	if options.Status != 0 {
+		dbStatus, ok := models.StatusEnumValues[options.Status]
+		if !ok {
+			return nil, realmmgr_errors.NewUnknownError(
+				fmt.Sprintf("unexpected status type: %d", options.Status),
+				nil,
+			)
+		}
+		query = query.Where(sq.Eq{
+			models.EventColumnStatus.WithTable(): []string{
+				dbStatus,
+				models.StatusEnumValues[entities.StatusDeleted],
+			},
+		})
+	}
+
+	if options.Limit > 0 {
+		query = query.Limit(uint64(options.Limit))
+	}
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm event select failed", err)
+	}
+	defer rows.Close()
+
+	events := make([]entities.RealmEvent, 0)
+	for rows.Next() {
+		event, scanErr := scanRealmEvent(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		events = append(events, event)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm event select failed", rowsErr)
+	}
+
+	return events, nil
+}
+
+func scanRealmEvent(row sq.RowScanner) (entities.RealmEvent, error) {
+	var event entities.RealmEvent
+	var eventType, dbStatus string
+
+	if err := row.Scan(
+		&event.Transaction,
+		&event.Sequence,
+		&event.RealmID,
+		&eventType,
+		&dbStatus,
+		&event.OccurredAt,
+	); err != nil {
+		return entities.RealmEvent{}, realmmgr_errors.NewInternalError("realm event scan failed", err)
+	}
+
+	var ok bool
+	event.Type, ok = models.RealmEventTypeDBValues[eventType]
+	if !ok {
+		return entities.RealmEvent{}, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected realm event type: %s", eventType),
+			nil,
+		)
+	}
+
+	event.Status, ok = models.StatusDBValues[dbStatus]
+	if !ok {
+		return entities.RealmEvent{}, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected status type: %s", dbStatus),
+			nil,
+		)
+	}
+
+	return event, nil
+}
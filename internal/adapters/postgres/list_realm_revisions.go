@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// ListRealmRevisions returns revisions of the realm, newest first.
+func (d *DataStore) ListRealmRevisions(
+	ctx context.Context,
+	options entities.ListRealmRevisionsOptions,
+) ([]entities.RealmRevision, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectRevisionColumns...).
+		From(models.RevisionTableName).
+		Where(sq.Eq{
+			models.RevisionColumnRealmID.WithTable(): options.RealmID,
+		}).
+		OrderBy(fmt.Sprintf("%s DESC", models.RevisionColumnRevision.WithTable()))
+
+	if options.Before > 0 {
+		query = query.Where(sq.Lt{
+			models.RevisionColumnRevision.WithTable(): options.Before,
+		})
+	}
+
+	if options.Limit > 0 {
+		query = query.Limit(uint64(options.Limit))
+	}
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm revision select failed", err)
+	}
+	defer rows.Close()
+
+	revisions := make([]entities.RealmRevision, 0)
+	for rows.Next() {
+		revision, scanErr := scanRealmRevision(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm revision select failed", rowsErr)
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// ListRealmTemplates returns the latest version of every template that was not deleted,
+// ordered by template ID.
+func (d *DataStore) ListRealmTemplates(
+	ctx context.Context,
+	options entities.ListRealmTemplatesOptions,
+) ([]entities.RealmTemplate, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectTemplateColumns...).
+		Options(fmt.Sprintf("DISTINCT ON (%s)", models.TemplateColumnID.WithTable())).
+		From(models.TemplateTableName).
+		Where(sq.Eq{
+			models.TemplateColumnDeletedAt.WithTable(): nil,
+		}).
+		OrderBy(
+			models.TemplateColumnID.WithTable(),
+			fmt.Sprintf("%s DESC", models.TemplateColumnVersion.WithTable()),
+		)
+
+	if options.After != uuid.Nil {
+		query = query.Where(sq.Gt{
+			models.TemplateColumnID.WithTable(): options.After,
+		})
+	}
+
+	if options.Limit > 0 {
+		query = query.Limit(uint64(options.Limit))
+	}
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm template select failed", err)
+	}
+	defer rows.Close()
+
+	templates := make([]entities.RealmTemplate, 0)
+	for rows.Next() {
+		template, scanErr := scanRealmTemplate(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		templates = append(templates, template)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm template select failed", rowsErr)
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var realmSortColumns = map[entities.SortField]models.RealmColumn{
+	entities.SortFieldName:      models.RealmColumnName,
+	entities.SortFieldCreatedAt: models.RealmColumnCreatedAt,
+	entities.SortFieldUpdatedAt: models.RealmColumnUpdatedAt,
+}
+
+func (d *DataStore) ListRealms(ctx context.Context, options entities.ListRealmsOptions) ([]entities.Realm, error) {
+	dbStatus, ok := models.StatusEnumValues[options.Status]
+	if !ok {
+		return nil, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected status type: %d", options.Status),
+			nil,
+		)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectRealmColumns...).
+		From(models.RealmTableName).
+		Where(sq.Eq{
+			models.RealmColumnStatus.WithTable(): dbStatus,
+		})
+
+	if options.ParentID != uuid.Nil {
+		query = query.Where(sq.Eq{
+			models.RealmColumnParentID.WithTable(): options.ParentID,
+		})
+	}
+
+	query, err := withLabelSelector(query, options.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err = withRealmSorting(query, options.Sorting, options.After)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Limit > 0 {
+		query = query.Limit(uint64(options.Limit))
+	}
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm select failed", err)
+	}
+	defer rows.Close()
+
+	realms := make([]entities.Realm, 0)
+	for rows.Next() {
+		realm, scanErr := scanRealm(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		realms = append(realms, realm)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm select failed", rowsErr)
+	}
+
+	return realms, nil
+}
+
+// withLabelSelector restricts the query to realms matching all label requirements. Label
+// values are matched with JSONB containment and keys with the existence operator, both of
+// which are served by the GIN index on the labels column.
+func withLabelSelector(query sq.SelectBuilder, requirements []entities.LabelRequirement) (sq.SelectBuilder, error) {
+	column := models.RealmColumnLabels.WithTable()
+
+	for _, requirement := range requirements {
+		contains := make([]sq.Sqlizer, 0, len(requirement.Values))
+		for _, value := range requirement.Values {
+			labels, err := models.LabelsToDB(map[string]string{requirement.Key: value})
+			if err != nil {
+				return query, err
+			}
+			contains = append(contains, sq.Expr(fmt.Sprintf("%s @> ?::jsonb", column), labels))
+		}
+
+		switch requirement.Operator {
+		case entities.LabelOperatorEquals, entities.LabelOperatorIn:
+			query = query.Where(sq.Or(contains))
+		case entities.LabelOperatorNotEquals, entities.LabelOperatorNotIn:
+			for _, expr := range contains {
+				query = query.Where(sq.Expr("NOT ?", expr))
+			}
+		case entities.LabelOperatorExists:
+			query = query.Where(sq.Expr(fmt.Sprintf("%s ?? ?", column), requirement.Key))
+		case entities.LabelOperatorDoesNotExist:
+			query = query.Where(sq.Expr(fmt.Sprintf("NOT %s ?? ?", column), requirement.Key))
+		default:
+			return query, realmmgr_errors.NewUnknownError(
+				fmt.Sprintf("unexpected label operator: %d", requirement.Operator),
+				nil,
+			)
+		}
+	}
+
+	return query, nil
+}
+
+// withRealmSorting applies keyset pagination to the query. Realms are ordered by
+// the requested column with the realm ID as a tie-breaker, so that the cursor
+// always identifies a unique position in the result set.
+func withRealmSorting(
+	query sq.SelectBuilder,
+	sorting entities.RealmSorting,
+	after *entities.RealmCursor,
+) (sq.SelectBuilder, error) {
+	sortColumn, ok := realmSortColumns[sorting.Field]
+	if !ok {
+		return query, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected sort field: %d", sorting.Field),
+			nil,
+		)
+	}
+
+	var direction, comparator string
+	switch sorting.Direction {
+	case entities.SortDirectionAsc:
+		direction, comparator = "ASC", ">"
+	case entities.SortDirectionDesc:
+		direction, comparator = "DESC", "<"
+	default:
+		return query, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected sort direction: %d", sorting.Direction),
+			nil,
+		)
+	}
+
+	if after != nil {
+		var cursorValue interface{}
+		switch sorting.Field {
+		case entities.SortFieldName:
+			cursorValue = after.Name
+		case entities.SortFieldCreatedAt:
+			cursorValue = after.CreatedAt
+		case entities.SortFieldUpdatedAt:
+			cursorValue = after.UpdatedAt
+		}
+
+		query = query.Where(
+			sq.Expr(
+				fmt.Sprintf("(%s, %s) %s (?, ?)", sortColumn.WithTable(), models.RealmColumnID.WithTable(), comparator),
+				cursorValue,
+				after.ID,
+			),
+		)
+	}
+
+	return query.OrderBy(
+		fmt.Sprintf("%s %s", sortColumn.WithTable(), direction),
+		fmt.Sprintf("%s %s", models.RealmColumnID.WithTable(), direction),
+	), nil
+}
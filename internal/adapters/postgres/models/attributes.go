@@ -0,0 +1,37 @@
+package models
+
+import (
+	"encoding/json"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// AttributesToDB encodes realm attributes as a JSON object for a JSONB column. A nil map
+// is stored as an empty object.
+func AttributesToDB(attributes map[string]interface{}) (string, error) {
+	if attributes == nil {
+		attributes = map[string]interface{}{}
+	}
+
+	encoded, err := json.Marshal(attributes)
+	if err != nil {
+		return "", realmmgr_errors.NewInternalError("failed to encode realm attributes", err)
+	}
+
+	return string(encoded), nil
+}
+
+// AttributesFromDB decodes a JSONB attributes column. Empty objects are returned as a nil
+// map to match realms created without attributes.
+func AttributesFromDB(value []byte) (map[string]interface{}, error) {
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(value, &attributes); err != nil {
+		return nil, realmmgr_errors.NewInternalError("failed to decode realm attributes", err)
+	}
+
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	return attributes, nil
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type AuditColumn string
+
+func (c AuditColumn) String() string {
+	return string(c)
+}
+
+func (c AuditColumn) WithTable() string {
+	return fmt.Sprintf("%s.%s", AuditTableName, c)
+}
+
+const (
+	AuditTableName = "realm_audit_log"
+
+	AuditColumnKey       AuditColumn = "key"
+	AuditColumnRealmID   AuditColumn = "realm_id"
+	AuditColumnAction    AuditColumn = "action"
+	AuditColumnReason    AuditColumn = "reason"
+	AuditColumnCreatedAt AuditColumn = "created_at"
+)
+
+var (
+	AuditActionEnumValues = map[entities.AuditAction]string{
+		entities.AuditActionDisable:  "disable",
+		entities.AuditActionEnable:   "enable",
+		entities.AuditActionDelete:   "delete",
+		entities.AuditActionPurge:    "purge",
+		entities.AuditActionRestore:  "restore",
+		entities.AuditActionRollback: "rollback",
+	}
+
+	AuditActionDBValues = func() map[string]entities.AuditAction {
+		result := make(map[string]entities.AuditAction)
+		for k, v := range AuditActionEnumValues {
+			result[v] = k
+		}
+		return result
+	}()
+)
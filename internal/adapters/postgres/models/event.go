@@ -0,0 +1,46 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type EventColumn string
+
+func (c EventColumn) String() string {
+	return string(c)
+}
+
+func (c EventColumn) WithTable() string {
+	return fmt.Sprintf("%s.%s", EventTableName, c)
+}
+
+const (
+	EventTableName = "realm_events"
+
+	EventColumnSequence    EventColumn = "sequence"
+	EventColumnTransaction EventColumn = "transaction_id"
+	EventColumnRealmID     EventColumn = "realm_id"
+	EventColumnType        EventColumn = "event_type"
+	EventColumnStatus      EventColumn = "status"
+	EventColumnOccurredAt  EventColumn = "occurred_at"
+)
+
+var (
+	RealmEventTypeEnumValues = map[entities.RealmEventType]string{
+		entities.RealmEventTypeCreated:  "created",
+		entities.RealmEventTypeUpdated:  "updated",
+		entities.RealmEventTypeReleased: "released",
+		entities.RealmEventTypeDisabled: "disabled",
+		entities.RealmEventTypeDeleted:  "deleted",
+	}
+
+	RealmEventTypeDBValues = func() map[string]entities.RealmEventType {
+		result := make(map[string]entities.RealmEventType)
+		for k, v := range RealmEventTypeEnumValues {
+			result[v] = k
+		}
+		return result
+	}()
+)
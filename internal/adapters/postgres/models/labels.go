@@ -0,0 +1,37 @@
+package models
+
+import (
+	"encoding/json"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// LabelsToDB encodes realm labels as a JSON object for a JSONB column. A nil map
+// is stored as an empty object so that containment queries never see NULL.
+func LabelsToDB(labels map[string]string) (string, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	encoded, err := json.Marshal(labels)
+	if err != nil {
+		return "", realmmgr_errors.NewInternalError("failed to encode realm labels", err)
+	}
+
+	return string(encoded), nil
+}
+
+// LabelsFromDB decodes a JSONB labels column. Empty objects are returned as a nil
+// map to match realms created without labels.
+func LabelsFromDB(value []byte) (map[string]string, error) {
+	var labels map[string]string
+	if err := json.Unmarshal(value, &labels); err != nil {
+		return nil, realmmgr_errors.NewInternalError("failed to decode realm labels", err)
+	}
+
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	return labels, nil
+}
@@ -23,4 +23,15 @@ const (
 	RealmColumnCreatedAt RealmColumn = "created_at"
 	RealmColumnUpdatedAt RealmColumn = "updated_at"
 	RealmColumnDeletedAt RealmColumn = "deleted_at"
+
+	RealmColumnPreviousStatus RealmColumn = "previous_status"
+	RealmColumnRevision       RealmColumn = "revision"
+	RealmColumnLabels         RealmColumn = "labels"
+	RealmColumnAttributes     RealmColumn = "attributes"
+	RealmColumnParentID       RealmColumn = "parent_id"
+	RealmColumnClonedFrom     RealmColumn = "cloned_from"
+	RealmColumnTemplateID     RealmColumn = "template_id"
+	RealmColumnTemplateVer    RealmColumn = "template_version"
+	RealmColumnSlug           RealmColumn = "slug"
+	RealmColumnSearchVector   RealmColumn = "search_vector"
 )
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type ApprovalColumn string
+
+func (c ApprovalColumn) String() string {
+	return string(c)
+}
+
+func (c ApprovalColumn) WithTable() string {
+	return fmt.Sprintf("%s.%s", ApprovalTableName, c)
+}
+
+const (
+	ApprovalTableName = "realm_release_approvals"
+
+	ApprovalColumnRealmID     ApprovalColumn = "realm_id"
+	ApprovalColumnRevision    ApprovalColumn = "revision"
+	ApprovalColumnName        ApprovalColumn = "name"
+	ApprovalColumnDesc        ApprovalColumn = "description"
+	ApprovalColumnCreatedAt   ApprovalColumn = "created_at"
+	ApprovalColumnUpdatedAt   ApprovalColumn = "updated_at"
+	ApprovalColumnLabels      ApprovalColumn = "labels"
+	ApprovalColumnAttributes  ApprovalColumn = "attributes"
+	ApprovalColumnParentID    ApprovalColumn = "parent_id"
+	ApprovalColumnRequestedBy ApprovalColumn = "requested_by"
+	ApprovalColumnRequestedAt ApprovalColumn = "requested_at"
+)
+
+type DecisionColumn string
+
+func (c DecisionColumn) String() string {
+	return string(c)
+}
+
+func (c DecisionColumn) WithTable() string {
+	return fmt.Sprintf("%s.%s", DecisionTableName, c)
+}
+
+const (
+	DecisionTableName = "realm_release_approval_decisions"
+
+	DecisionColumnKey       DecisionColumn = "key"
+	DecisionColumnRealmID   DecisionColumn = "realm_id"
+	DecisionColumnRevision  DecisionColumn = "revision"
+	DecisionColumnReviewer  DecisionColumn = "reviewer"
+	DecisionColumnDecision  DecisionColumn = "decision"
+	DecisionColumnComment   DecisionColumn = "comment"
+	DecisionColumnDecidedAt DecisionColumn = "decided_at"
+)
+
+var (
+	ApprovalDecisionEnumValues = map[entities.ApprovalDecision]string{
+		entities.ApprovalDecisionApprove: "approve",
+		entities.ApprovalDecisionReject:  "reject",
+	}
+
+	ApprovalDecisionDBValues = func() map[string]entities.ApprovalDecision {
+		result := make(map[string]entities.ApprovalDecision)
+		for k, v := range ApprovalDecisionEnumValues {
+			result[v] = k
+		}
+		return result
+	}()
+)
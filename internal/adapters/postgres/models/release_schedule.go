@@ -0,0 +1,46 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type ReleaseScheduleColumn string
+
+func (c ReleaseScheduleColumn) String() string {
+	return string(c)
+}
+
+func (c ReleaseScheduleColumn) WithTable() string {
+	return fmt.Sprintf("%s.%s", ReleaseScheduleTableName, c)
+}
+
+const (
+	ReleaseScheduleTableName = "realm_release_schedules"
+
+	ReleaseScheduleColumnRealmID       ReleaseScheduleColumn = "realm_id"
+	ReleaseScheduleColumnReleaseAt     ReleaseScheduleColumn = "release_at"
+	ReleaseScheduleColumnScheduledBy   ReleaseScheduleColumn = "scheduled_by"
+	ReleaseScheduleColumnState         ReleaseScheduleColumn = "state"
+	ReleaseScheduleColumnAttempts      ReleaseScheduleColumn = "attempts"
+	ReleaseScheduleColumnNextAttemptAt ReleaseScheduleColumn = "next_attempt_at"
+	ReleaseScheduleColumnLastError     ReleaseScheduleColumn = "last_error"
+	ReleaseScheduleColumnCreatedAt     ReleaseScheduleColumn = "created_at"
+	ReleaseScheduleColumnUpdatedAt     ReleaseScheduleColumn = "updated_at"
+)
+
+var (
+	ReleaseScheduleStateEnumValues = map[entities.ReleaseScheduleState]string{
+		entities.ReleaseScheduleStatePending: "pending",
+		entities.ReleaseScheduleStateFailed:  "failed",
+	}
+
+	ReleaseScheduleStateDBValues = func() map[string]entities.ReleaseScheduleState {
+		result := make(map[string]entities.ReleaseScheduleState)
+		for k, v := range ReleaseScheduleStateEnumValues {
+			result[v] = k
+		}
+		return result
+	}()
+)
@@ -0,0 +1,31 @@
+package models
+
+import "fmt"
+
+type RevisionColumn string
+
+func (c RevisionColumn) String() string {
+	return string(c)
+}
+
+func (c RevisionColumn) WithTable() string {
+	return fmt.Sprintf("%s.%s", RevisionTableName, c)
+}
+
+const (
+	RevisionTableName = "realm_revisions"
+
+	RevisionColumnKey        RevisionColumn = "key"
+	RevisionColumnRealmID    RevisionColumn = "realm_id"
+	RevisionColumnRevision   RevisionColumn = "revision"
+	RevisionColumnName       RevisionColumn = "name"
+	RevisionColumnDesc       RevisionColumn = "description"
+	RevisionColumnStatus     RevisionColumn = "status"
+	RevisionColumnCreatedAt  RevisionColumn = "created_at"
+	RevisionColumnUpdatedAt  RevisionColumn = "updated_at"
+	RevisionColumnLabels     RevisionColumn = "labels"
+	RevisionColumnAttributes RevisionColumn = "attributes"
+	RevisionColumnParentID   RevisionColumn = "parent_id"
+	RevisionColumnReleasedBy RevisionColumn = "released_by"
+	RevisionColumnReleasedAt RevisionColumn = "released_at"
+)
@@ -0,0 +1,22 @@
+package models
+
+import "fmt"
+
+type SlugColumn string
+
+func (c SlugColumn) String() string {
+	return string(c)
+}
+
+func (c SlugColumn) WithTable() string {
+	return fmt.Sprintf("%s.%s", SlugTableName, c)
+}
+
+const (
+	SlugTableName = "realm_slugs"
+
+	SlugColumnSlug      SlugColumn = "slug"
+	SlugColumnRealmID   SlugColumn = "realm_id"
+	SlugColumnCreatedAt SlugColumn = "created_at"
+	SlugColumnExpiresAt SlugColumn = "expires_at"
+)
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+type TemplateColumn string
+
+func (c TemplateColumn) String() string {
+	return string(c)
+}
+
+func (c TemplateColumn) WithTable() string {
+	return fmt.Sprintf("%s.%s", TemplateTableName, c)
+}
+
+const (
+	TemplateTableName = "realm_templates"
+
+	TemplateColumnKey        TemplateColumn = "key"
+	TemplateColumnID         TemplateColumn = "id"
+	TemplateColumnVersion    TemplateColumn = "version"
+	TemplateColumnName       TemplateColumn = "name"
+	TemplateColumnDesc       TemplateColumn = "description"
+	TemplateColumnRealmDesc  TemplateColumn = "realm_description"
+	TemplateColumnLabels     TemplateColumn = "labels"
+	TemplateColumnAttributes TemplateColumn = "attributes"
+	TemplateColumnParameters TemplateColumn = "parameters"
+	TemplateColumnCreatedBy  TemplateColumn = "created_by"
+	TemplateColumnCreatedAt  TemplateColumn = "created_at"
+	TemplateColumnDeletedAt  TemplateColumn = "deleted_at"
+)
+
+// templateParameter is the JSON representation of a template parameter in the
+// parameters column.
+type templateParameter struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+	Required     bool   `json:"required,omitempty"`
+}
+
+// TemplateParametersToDB encodes template parameters as a JSON array for a JSONB column.
+func TemplateParametersToDB(parameters []entities.RealmTemplateParameter) (string, error) {
+	dbParameters := make([]templateParameter, 0, len(parameters))
+	for _, parameter := range parameters {
+		dbParameters = append(dbParameters, templateParameter{
+			Name:         parameter.Name,
+			Description:  parameter.Description,
+			DefaultValue: parameter.DefaultValue,
+			Required:     parameter.Required,
+		})
+	}
+
+	encoded, err := json.Marshal(dbParameters)
+	if err != nil {
+		return "", realmmgr_errors.NewInternalError("failed to encode template parameters", err)
+	}
+
+	return string(encoded), nil
+}
+
+// TemplateParametersFromDB decodes a JSONB parameters column. Empty arrays are returned
+// as a nil slice to match templates created without parameters.
+func TemplateParametersFromDB(value []byte) ([]entities.RealmTemplateParameter, error) {
+	var dbParameters []templateParameter
+	if err := json.Unmarshal(value, &dbParameters); err != nil {
+		return nil, realmmgr_errors.NewInternalError("failed to decode template parameters", err)
+	}
+
+	if len(dbParameters) == 0 {
+		return nil, nil
+	}
+
+	parameters := make([]entities.RealmTemplateParameter, 0, len(dbParameters))
+	for _, parameter := range dbParameters {
+		parameters = append(parameters, entities.RealmTemplateParameter{
+			Name:         parameter.Name,
+			Description:  parameter.Description,
+			DefaultValue: parameter.DefaultValue,
+			Required:     parameter.Required,
+		})
+	}
+
+	return parameters, nil
+}
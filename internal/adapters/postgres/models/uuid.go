@@ -0,0 +1,12 @@
+package models
+
+import "github.com/google/uuid"
+
+// NullUUIDToDB converts an optional reference, e.g. to another realm, into a nullable
+// column value, uuid.Nil is stored as NULL.
+func NullUUIDToDB(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{
+		UUID:  id,
+		Valid: id != uuid.Nil,
+	}
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// RestoreRealm moves all deleted rows of the realm back into the status they had
+// before deletion and returns the number of rows affected.
+func (d *DataStore) RestoreRealm(ctx context.Context, realmID uuid.UUID, restoredAt time.Time) (int64, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Update(models.RealmTableName).
+		SetMap(map[string]interface{}{
+			models.RealmColumnStatus.String():         sq.Expr(models.RealmColumnPreviousStatus.String()),
+			models.RealmColumnPreviousStatus.String(): nil,
+			models.RealmColumnDeletedAt.String():      nil,
+			models.RealmColumnUpdatedAt.String():      restoredAt,
+			models.RealmColumnRevision.String():       sq.Expr(fmt.Sprintf("%s + 1", models.RealmColumnRevision)),
+		}).
+		Where(sq.Eq{
+			models.RealmColumnID.String():     realmID,
+			models.RealmColumnStatus.String(): models.StatusEnumValues[entities.StatusDeleted],
+		}).
+		Where(sq.NotEq{
+			models.RealmColumnPreviousStatus.String(): nil,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm restore failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm restore failed", err)
+	}
+
+	return affected, nil
+}
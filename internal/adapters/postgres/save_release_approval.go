@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var insertApprovalColumns = []string{
+	models.ApprovalColumnRealmID.String(),
+	models.ApprovalColumnRevision.String(),
+	models.ApprovalColumnName.String(),
+	models.ApprovalColumnDesc.String(),
+	models.ApprovalColumnCreatedAt.String(),
+	models.ApprovalColumnUpdatedAt.String(),
+	models.ApprovalColumnLabels.String(),
+	models.ApprovalColumnAttributes.String(),
+	models.ApprovalColumnParentID.String(),
+	models.ApprovalColumnRequestedBy.String(),
+	models.ApprovalColumnRequestedAt.String(),
+}
+
+// SaveReleaseApproval stores the approval request of a realm, replacing any request the
+// realm already has. Decisions are stored separately with SaveReleaseApprovalDecision.
+func (d *DataStore) SaveReleaseApproval(ctx context.Context, approval entities.ReleaseApproval) error {
+	// every column but the realm ID is replaced on conflict
+	updates := make([]string, 0, len(insertApprovalColumns)-1)
+	for _, column := range insertApprovalColumns[1:] {
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+
+	labels, err := models.LabelsToDB(approval.Realm.Labels)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := models.AttributesToDB(approval.Realm.Attributes)
+	if err != nil {
+		return err
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.ApprovalTableName).
+		Columns(insertApprovalColumns...).
+		Values(
+			approval.Realm.ID,
+			approval.Realm.Revision,
+			approval.Realm.Name,
+			approval.Realm.Description,
+			approval.Realm.CreatedAt,
+			approval.Realm.UpdatedAt,
+			labels,
+			attributes,
+			models.NullUUIDToDB(approval.Realm.ParentID),
+			approval.RequestedBy,
+			approval.RequestedAt,
+		).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%s) DO UPDATE SET %s",
+			models.ApprovalColumnRealmID,
+			strings.Join(updates, ", "),
+		))
+
+	if _, err := query.RunWith(d.db).ExecContext(ctx); err != nil {
+		return realmmgr_errors.NewInternalError("release approval insert failed", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var insertDecisionColumns = []string{
+	models.DecisionColumnKey.String(),
+	models.DecisionColumnRealmID.String(),
+	models.DecisionColumnRevision.String(),
+	models.DecisionColumnReviewer.String(),
+	models.DecisionColumnDecision.String(),
+	models.DecisionColumnComment.String(),
+	models.DecisionColumnDecidedAt.String(),
+}
+
+// SaveReleaseApprovalDecision stores the decision of a reviewer on a draft revision, a
+// previous decision of the same reviewer on that revision is replaced.
+func (d *DataStore) SaveReleaseApprovalDecision(ctx context.Context, decision entities.ReleaseApprovalDecision) error {
+	key, err := d.uuidgen.New()
+	if err != nil {
+		return realmmgr_errors.NewInternalError("failed to generate UUID key", err)
+	}
+
+	dbDecision, ok := models.ApprovalDecisionEnumValues[decision.Decision]
+	if !ok {
+		return realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected approval decision type: %d", decision.Decision),
+			nil,
+		)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.DecisionTableName).
+		Columns(insertDecisionColumns...).
+		Values(
+			key,
+			decision.RealmID,
+			decision.Revision,
+			decision.Reviewer,
+			dbDecision,
+			decision.Comment,
+			decision.DecidedAt,
+		).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%s, %s, %s) DO UPDATE SET %s = EXCLUDED.%s, %s = EXCLUDED.%s, %s = EXCLUDED.%s",
+			models.DecisionColumnRealmID,
+			models.DecisionColumnRevision,
+			models.DecisionColumnReviewer,
+			models.DecisionColumnDecision, models.DecisionColumnDecision,
+			models.DecisionColumnComment, models.DecisionColumnComment,
+			models.DecisionColumnDecidedAt, models.DecisionColumnDecidedAt,
+		))
+
+	if _, insertErr := query.RunWith(d.db).ExecContext(ctx); insertErr != nil {
+		return realmmgr_errors.NewInternalError("release approval decision insert failed", insertErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var insertReleaseScheduleColumns = []string{
+	models.ReleaseScheduleColumnRealmID.String(),
+	models.ReleaseScheduleColumnReleaseAt.String(),
+	models.ReleaseScheduleColumnScheduledBy.String(),
+	models.ReleaseScheduleColumnState.String(),
+	models.ReleaseScheduleColumnAttempts.String(),
+	models.ReleaseScheduleColumnNextAttemptAt.String(),
+	models.ReleaseScheduleColumnLastError.String(),
+	models.ReleaseScheduleColumnCreatedAt.String(),
+	models.ReleaseScheduleColumnUpdatedAt.String(),
+}
+
+// SaveReleaseSchedule creates the release schedule of a realm, replacing any schedule
+// the realm already has.
+func (d *DataStore) SaveReleaseSchedule(ctx context.Context, schedule entities.ReleaseSchedule) error {
+	state, ok := models.ReleaseScheduleStateEnumValues[schedule.State]
+	if !ok {
+		return realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected release schedule state: %d", schedule.State),
+			nil,
+		)
+	}
+
+	// every column but the realm ID is replaced on conflict
+	updates := make([]string, 0, len(insertReleaseScheduleColumns)-1)
+	for _, column := range insertReleaseScheduleColumns[1:] {
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.ReleaseScheduleTableName).
+		Columns(insertReleaseScheduleColumns...).
+		Values(
+			schedule.RealmID,
+			schedule.ReleaseAt,
+			schedule.ScheduledBy,
+			state,
+			schedule.Attempts,
+			schedule.NextAttemptAt,
+			schedule.LastError,
+			schedule.CreatedAt,
+			schedule.UpdatedAt,
+		).
+		Suffix(fmt.Sprintf(
+			"ON CONFLICT (%s) DO UPDATE SET %s",
+			models.ReleaseScheduleColumnRealmID,
+			strings.Join(updates, ", "),
+		))
+
+	if _, err := query.RunWith(d.db).ExecContext(ctx); err != nil {
+		return realmmgr_errors.NewInternalError("release schedule insert failed", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+const (
+	// searchConfig is the text search configuration the search_vector column is built with,
+	// queries and highlights have to use the same one to match it
+	searchConfig = "english"
+	// searchQueryAlias names the parsed search query in the select statement
+	searchQueryAlias = "search_query"
+
+	searchHighlightTags = "StartSel=<mark>, StopSel=</mark>"
+	// names are short, so they are highlighted as a whole
+	nameHighlightOptions = "HighlightAll=true, " + searchHighlightTags
+	// descriptions are reduced to the fragments around the matched terms
+	descriptionHighlightOptions = "MaxFragments=2, MaxWords=20, MinWords=5, " + searchHighlightTags
+)
+
+// SearchRealms lists the realms in the given status whose name or description match the
+// query, most relevant first. Matches in names are weighted above matches in descriptions
+// when the search_vector column is built, realms of equal rank are ordered by ID.
+func (d *DataStore) SearchRealms(ctx context.Context, options entities.SearchRealmsOptions) ([]entities.RealmSearchResult, error) {
+	dbStatus, ok := models.StatusEnumValues[options.Status]
+	if !ok {
+		return nil, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected status type: %d", options.Status),
+			nil,
+		)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(selectRealmColumns...).
+		Columns(searchRealmColumns()...).
+		From(models.RealmTableName).
+		JoinClause(
+			fmt.Sprintf("CROSS JOIN websearch_to_tsquery('%s', ?) AS %s", searchConfig, searchQueryAlias),
+			options.Query,
+		).
+		Where(sq.Eq{
+			models.RealmColumnStatus.WithTable(): dbStatus,
+		}).
+		Where(fmt.Sprintf("%s @@ %s", models.RealmColumnSearchVector.WithTable(), searchQueryAlias)).
+		OrderBy(
+			"rank DESC",
+			fmt.Sprintf("%s ASC", models.RealmColumnID.WithTable()),
+		)
+
+	if options.Limit > 0 {
+		query = query.Limit(uint64(options.Limit))
+	}
+	if options.Offset > 0 {
+		query = query.Offset(uint64(options.Offset))
+	}
+
+	rows, err := query.RunWith(d.db).QueryContext(ctx)
+	if err != nil {
+		return nil, realmmgr_errors.NewInternalError("realm search failed", err)
+	}
+	defer rows.Close()
+
+	results := make([]entities.RealmSearchResult, 0)
+	for rows.Next() {
+		var result entities.RealmSearchResult
+
+		realm, scanErr := scanRealm(
+			rows,
+			&result.Rank,
+			&result.NameHighlight,
+			&result.DescriptionHighlight,
+		)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		result.Realm = realm
+
+		results = append(results, result)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, realmmgr_errors.NewInternalError("realm search failed", rowsErr)
+	}
+
+	return results, nil
+}
+
+// searchRealmColumns selects the rank and highlights of a realm, in the order they are
+// scanned by SearchRealms. Names and descriptions are escaped before they are highlighted,
+// so the <mark> tags are the only markup of the highlights.
+func searchRealmColumns() []string {
+	return []string{
+		fmt.Sprintf("ts_rank_cd(%s, %s) AS rank", models.RealmColumnSearchVector.WithTable(), searchQueryAlias),
+		fmt.Sprintf(
+			"ts_headline('%s', %s, %s, '%s')",
+			searchConfig,
+			escapeHTML(models.RealmColumnName.WithTable()),
+			searchQueryAlias,
+			nameHighlightOptions,
+		),
+		fmt.Sprintf(
+			"ts_headline('%s', %s, %s, '%s')",
+			searchConfig,
+			escapeHTML(fmt.Sprintf("coalesce(%s, '')", models.RealmColumnDesc.WithTable())),
+			searchQueryAlias,
+			descriptionHighlightOptions,
+		),
+	}
+}
+
+// escapeHTML escapes the HTML special characters of a text expression. The ampersand is
+// replaced first, so the entities of the other characters are not escaped again.
+func escapeHTML(expr string) string {
+	for _, r := range []struct{ char, entity string }{
+		{"&", "&amp;"},
+		{"<", "&lt;"},
+		{">", "&gt;"},
+		{`"`, "&quot;"},
+		{"''", "&#39;"},
+	} {
+		expr = fmt.Sprintf("replace(%s, '%s', '%s')", expr, r.char, r.entity)
+	}
+	return expr
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// SoftDeleteRealm marks realm rows in any of the given statuses as deleted and
+// returns the number of rows affected. The status each row had is kept in the
+// previous status column so that the realm can be restored later on.
+func (d *DataStore) SoftDeleteRealm(
+	ctx context.Context,
+	realmID uuid.UUID,
+	deletedAt time.Time,
+	statuses ...entities.Status,
+) (int64, error) {
+	var dbStatuses []string
+	for _, status := range statuses {
+		dbStatus, ok := models.StatusEnumValues[status]
+		if !ok {
+			return 0, realmmgr_errors.NewUnknownError(
+				fmt.Sprintf("unexpected status type: %d", status),
+				nil,
+			)
+		}
+		dbStatuses = append(dbStatuses, dbStatus)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Update(models.RealmTableName).
+		SetMap(map[string]interface{}{
+			models.RealmColumnPreviousStatus.String(): sq.Expr(models.RealmColumnStatus.String()),
+			models.RealmColumnStatus.String():         models.StatusEnumValues[entities.StatusDeleted],
+			models.RealmColumnDeletedAt.String():      deletedAt,
+			models.RealmColumnUpdatedAt.String():      deletedAt,
+			models.RealmColumnRevision.String():       sq.Expr(fmt.Sprintf("%s + 1", models.RealmColumnRevision)),
+		}).
+		Where(sq.Eq{
+			models.RealmColumnID.String():     realmID,
+			models.RealmColumnStatus.String(): dbStatuses,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm soft delete failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm soft delete failed", err)
+	}
+
+	return affected, nil
+}
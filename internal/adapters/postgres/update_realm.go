@@ -11,7 +11,14 @@ import (
 	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
 )
 
-func (d *DataStore) UpdateRealm(ctx context.Context, realm entities.Realm, currentStatus entities.Status) error {
+// UpdateRealm overwrites the realm row in currentStatus, provided the row is still at
+// currentRevision. An aborted error is returned when the row was changed in the meantime.
+func (d *DataStore) UpdateRealm(
+	ctx context.Context,
+	realm entities.Realm,
+	currentStatus entities.Status,
+	currentRevision int64,
+) error {
 	dbStatus, ok := models.StatusEnumValues[currentStatus]
 	if !ok {
 		return realmmgr_errors.NewUnknownError(
@@ -28,23 +35,50 @@ func (d *DataStore) UpdateRealm(ctx context.Context, realm entities.Realm, curre
 		)
 	}
 
+	labels, err := models.LabelsToDB(realm.Labels)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := models.AttributesToDB(realm.Attributes)
+	if err != nil {
+		return err
+	}
+
 	query := sq.StatementBuilder.
 		PlaceholderFormat(sq.Dollar).
 		Update(models.RealmTableName).
 		SetMap(map[string]interface{}{
-			models.RealmColumnName.String():      realm.Name,
-			models.RealmColumnDesc.String():      realm.Description,
-			models.RealmColumnUpdatedAt.String(): realm.UpdatedAt,
-			models.RealmColumnStatus.String():    realmStatus,
+			models.RealmColumnName.String():       realm.Name,
+			models.RealmColumnDesc.String():       realm.Description,
+			models.RealmColumnUpdatedAt.String():  realm.UpdatedAt,
+			models.RealmColumnStatus.String():     realmStatus,
+			models.RealmColumnRevision.String():   realm.Revision,
+			models.RealmColumnLabels.String():     labels,
+			models.RealmColumnAttributes.String(): attributes,
+			models.RealmColumnParentID.String():   models.NullUUIDToDB(realm.ParentID),
 		}).
 		Where(sq.Eq{
-			models.RealmColumnID.String():     realm.ID,
-			models.RealmColumnStatus.String(): dbStatus,
+			models.RealmColumnID.String():       realm.ID,
+			models.RealmColumnStatus.String():   dbStatus,
+			models.RealmColumnRevision.String(): currentRevision,
 		})
 
-	if _, err := query.RunWith(d.db).ExecContext(ctx); err != nil {
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return realmmgr_errors.NewInternalError("realm update failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
 		return realmmgr_errors.NewInternalError("realm update failed", err)
 	}
+	if affected == 0 {
+		return realmmgr_errors.NewAbortedError(
+			fmt.Sprintf("realm with ID %s was changed concurrently", realm.ID),
+			nil,
+		)
+	}
 
 	return nil
 }
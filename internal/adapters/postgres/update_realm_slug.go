@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// UpdateRealmSlug sets the slug of all copies of the realm and returns the number of rows
+// affected. The revision is left untouched as slugs are not part of the realm content.
+func (d *DataStore) UpdateRealmSlug(ctx context.Context, realmID uuid.UUID, slug string) (int64, error) {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Update(models.RealmTableName).
+		Set(models.RealmColumnSlug.String(), slug).
+		Where(sq.Eq{
+			models.RealmColumnID.String(): realmID,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm slug update failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("realm slug update failed", err)
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// UpdateReleaseSchedule records the outcome of a release attempt on an existing schedule
+// and returns the number of rows affected.
+func (d *DataStore) UpdateReleaseSchedule(ctx context.Context, schedule entities.ReleaseSchedule) (int64, error) {
+	state, ok := models.ReleaseScheduleStateEnumValues[schedule.State]
+	if !ok {
+		return 0, realmmgr_errors.NewUnknownError(
+			fmt.Sprintf("unexpected release schedule state: %d", schedule.State),
+			nil,
+		)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Update(models.ReleaseScheduleTableName).
+		SetMap(map[string]interface{}{
+			models.ReleaseScheduleColumnState.String():         state,
+			models.ReleaseScheduleColumnAttempts.String():      schedule.Attempts,
+			models.ReleaseScheduleColumnNextAttemptAt.String(): schedule.NextAttemptAt,
+			models.ReleaseScheduleColumnLastError.String():     schedule.LastError,
+			models.ReleaseScheduleColumnUpdatedAt.String():     schedule.UpdatedAt,
+		}).
+		Where(sq.Eq{
+			models.ReleaseScheduleColumnRealmID.String(): schedule.RealmID,
+		})
+
+	result, err := query.RunWith(d.db).ExecContext(ctx)
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("release schedule update failed", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, realmmgr_errors.NewInternalError("release schedule update failed", err)
+	}
+
+	return affected, nil
+}
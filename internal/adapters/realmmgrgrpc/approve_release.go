@@ -0,0 +1,22 @@
+package realmmgrgrpc
+
+import (
+	"context"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) ApproveRelease(
+	ctx context.Context,
+	req *realm_mgr_v1.ApproveReleaseRequest,
+) (*realm_mgr_v1.ApproveReleaseResponse, error) {
+	approval, err := api.reviewRelease(ctx, req.Id, req.Etag, req.Comment, entities.ApprovalDecisionApprove, req.ValidateOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realm_mgr_v1.ApproveReleaseResponse{
+		Approval: approval,
+	}, nil
+}
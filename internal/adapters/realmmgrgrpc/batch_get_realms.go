@@ -0,0 +1,85 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) BatchGetRealms(
+	ctx context.Context,
+	req *realm_mgr_v1.BatchGetRealmsRequest,
+) (*realm_mgr_v1.BatchGetRealmsResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	realmIDs := make([]uuid.UUID, len(req.Ids))
+	for i, id := range req.Ids {
+		realmID, parseErr := uuid.Parse(id)
+		if parseErr != nil {
+			logger.WithError(parseErr).WithField("realm-id", id).Info("invalid realm ID supplied")
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, id))
+		}
+		realmIDs[i] = realmID
+	}
+
+	// if status not provided in the request, default it to always return active realms
+	if req.Status == realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED {
+		req.Status = realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE
+	}
+
+	realmStatus, ok := models.StatusGRPCValues[req.Status]
+	if !ok {
+		logger.WithField("status", req.Status).Info("invalid realm status supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.Status))
+	}
+
+	results, err := api.realmOps.BatchGetRealms(ctx, logger, realmIDs, realmStatus)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcResults := make([]*realm_mgr_v1.BatchGetRealmsResult, len(results))
+	for i, result := range results {
+		grpcResult := &realm_mgr_v1.BatchGetRealmsResult{
+			Id: result.RealmID.String(),
+		}
+
+		if result.Err != nil {
+			grpcResult.Result = &realm_mgr_v1.BatchGetRealmsResult_Error{
+				Error: models.BatchErrorFromDomain(result.Err),
+			}
+		} else {
+			grpcRealm, convErr := models.RealmFromDomain(result.Realm)
+			if convErr != nil {
+				return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+			}
+			grpcResult.Result = &realm_mgr_v1.BatchGetRealmsResult_Realm{
+				Realm: grpcRealm,
+			}
+		}
+
+		grpcResults[i] = grpcResult
+	}
+
+	return &realm_mgr_v1.BatchGetRealmsResponse{
+		Results: grpcResults,
+	}, nil
+}
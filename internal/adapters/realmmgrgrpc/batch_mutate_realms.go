@@ -0,0 +1,72 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) BatchMutateRealms(
+	ctx context.Context,
+	req *realm_mgr_v1.BatchMutateRealmsRequest,
+) (*realm_mgr_v1.BatchMutateRealmsResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	mutations := make([]entities.RealmMutation, len(req.Mutations))
+	for i, grpcMutation := range req.Mutations {
+		mutation, convErr := models.RealmMutationToDomain(grpcMutation)
+		if convErr != nil {
+			logger.WithError(convErr).WithField("mutation-index", i).Info("invalid mutation supplied")
+			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid mutation %d: %s", i, convErr))
+		}
+		mutations[i] = mutation
+	}
+
+	realms, err := api.realmOps.BatchMutateRealms(
+		ctx,
+		logger,
+		mutations,
+		interceptors.ActorFromContext(ctx),
+		req.ValidateOnly,
+	)
+	if err != nil {
+		switch typedErr := err.(type) {
+		case *realmmgr_errors.BatchMutationError:
+			// a failed mutation is reported as part of the response, nothing was applied
+			return &realm_mgr_v1.BatchMutateRealmsResponse{
+				FailedIndex: uint32(typedErr.Index),
+				Error:       models.BatchErrorFromDomain(errors.Unwrap(typedErr)),
+			}, nil
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcRealms := make([]*realm_mgr_v1.Realm, len(realms))
+	for i, realm := range realms {
+		grpcRealm, convErr := models.RealmFromDomain(realm)
+		if convErr != nil {
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		grpcRealms[i] = grpcRealm
+	}
+
+	return &realm_mgr_v1.BatchMutateRealmsResponse{
+		Realms: grpcRealms,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) CancelScheduledRelease(
+	ctx context.Context,
+	req *realm_mgr_v1.CancelScheduledReleaseRequest,
+) (*realm_mgr_v1.CancelScheduledReleaseResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	realmID, err := uuid.Parse(req.Id)
+	if err != nil {
+		logger.WithError(err).WithField("realm-id", req.Id).Info("invalid realm ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, req.Id))
+	}
+
+	if cancelErr := api.realmOps.CancelScheduledRelease(ctx, logger, realmID, req.ValidateOnly); cancelErr != nil {
+		switch cancelErr.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("scheduled release of realm with ID not found: %s", realmID))
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, cancelErr.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	return &realm_mgr_v1.CancelScheduledReleaseResponse{}, nil
+}
@@ -0,0 +1,69 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) CloneRealm(
+	ctx context.Context,
+	req *realm_mgr_v1.CloneRealmRequest,
+) (*realm_mgr_v1.CloneRealmResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	sourceID, err := uuid.Parse(req.SourceId)
+	if err != nil {
+		logger.WithError(err).WithField("source-id", req.SourceId).Info("invalid source realm ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, req.SourceId))
+	}
+
+	// if status not provided in the request, default it to clone the active realm
+	if req.SourceStatus == realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED {
+		req.SourceStatus = realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE
+	}
+
+	sourceStatus, ok := models.StatusGRPCValues[req.SourceStatus]
+	if !ok {
+		logger.WithField("status", req.SourceStatus).Info("invalid realm status supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.SourceStatus))
+	}
+
+	realm, err := api.realmOps.CloneRealm(ctx, logger, sourceID, sourceStatus, req.Name, req.ValidateOnly)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("realm with ID not found: %s", sourceID))
+		case *realmmgr_errors.FailedPreconditionError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		case *realmmgr_errors.AbortedError:
+			return nil, status.Errorf(codes.Aborted, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcRealm, err := models.RealmFromDomain(realm)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	return &realm_mgr_v1.CloneRealmResponse{
+		Realm: grpcRealm,
+	}, nil
+}
@@ -22,11 +22,30 @@ func (api *RealmManagerAPI) CreateRealm(
 		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
 	}
 
-	realm, err := api.realmOps.CreateRealm(ctx, logger, req.Name, req.Description)
+	parentID, err := models.ParentIDToDomain(req.ParentId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	realm, err := api.realmOps.CreateRealm(
+		ctx,
+		logger,
+		req.Name,
+		req.Slug,
+		req.Description,
+		req.Labels,
+		models.AttributesToDomain(req.Attributes),
+		parentID,
+		req.ValidateOnly,
+	)
 	if err != nil {
 		switch err.(type) {
 		case *realmmgr_errors.InvalidArgumentError:
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		case *realmmgr_errors.FailedPreconditionError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		case *realmmgr_errors.AbortedError:
+			return nil, status.Errorf(codes.Aborted, err.Error())
 		default:
 			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
 		}
@@ -0,0 +1,72 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) CreateRealmFromTemplate(
+	ctx context.Context,
+	req *realm_mgr_v1.CreateRealmFromTemplateRequest,
+) (*realm_mgr_v1.CreateRealmFromTemplateResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		logger.WithError(err).WithField("template-id", req.TemplateId).Info("invalid realm template ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmTemplateID, req.TemplateId))
+	}
+
+	parentID, err := models.ParentIDToDomain(req.ParentId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	realm, err := api.templateOps.CreateRealmFromTemplate(
+		ctx,
+		logger,
+		templateID,
+		req.TemplateVersion,
+		req.Name,
+		req.Parameters,
+		parentID,
+		req.ValidateOnly,
+	)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("realm template with ID not found: %s", templateID))
+		case *realmmgr_errors.FailedPreconditionError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		case *realmmgr_errors.AbortedError:
+			return nil, status.Errorf(codes.Aborted, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcRealm, err := models.RealmFromDomain(realm)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	return &realm_mgr_v1.CreateRealmFromTemplateResponse{
+		Realm: grpcRealm,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package realmmgrgrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) CreateRealmTemplate(
+	ctx context.Context,
+	req *realm_mgr_v1.CreateRealmTemplateRequest,
+) (*realm_mgr_v1.CreateRealmTemplateResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	template, err := api.templateOps.CreateRealmTemplate(
+		ctx,
+		logger,
+		entities.RealmTemplate{
+			Name:             req.Name,
+			Description:      req.Description,
+			RealmDescription: req.RealmDescription,
+			Labels:           req.Labels,
+			Attributes:       models.AttributesToDomain(req.Attributes),
+			Parameters:       models.RealmTemplateParametersToDomain(req.Parameters),
+			CreatedBy:        interceptors.ActorFromContext(ctx),
+		},
+		req.ValidateOnly,
+	)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcTemplate, err := models.RealmTemplateFromDomain(template)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	return &realm_mgr_v1.CreateRealmTemplateResponse{
+		Template: grpcTemplate,
+	}, nil
+}
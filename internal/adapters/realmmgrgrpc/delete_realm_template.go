@@ -0,0 +1,47 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) DeleteRealmTemplate(
+	ctx context.Context,
+	req *realm_mgr_v1.DeleteRealmTemplateRequest,
+) (*realm_mgr_v1.DeleteRealmTemplateResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	templateID, err := uuid.Parse(req.Id)
+	if err != nil {
+		logger.WithError(err).WithField("template-id", req.Id).Info("invalid realm template ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmTemplateID, req.Id))
+	}
+
+	deleteErr := api.templateOps.DeleteRealmTemplate(ctx, logger, templateID, req.ValidateOnly)
+	if deleteErr != nil {
+		switch deleteErr.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("realm template with ID not found: %s", templateID))
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, deleteErr.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	return &realm_mgr_v1.DeleteRealmTemplateResponse{}, nil
+}
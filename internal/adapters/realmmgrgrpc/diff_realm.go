@@ -0,0 +1,62 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) DiffRealm(
+	ctx context.Context,
+	req *realm_mgr_v1.DiffRealmRequest,
+) (*realm_mgr_v1.DiffRealmResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	realmID, err := uuid.Parse(req.Id)
+	if err != nil {
+		logger.WithError(err).WithField("realm-id", req.Id).Info("invalid realm ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, req.Id))
+	}
+
+	from, err := models.RealmVersionToDomain(req.From, entities.StatusActive)
+	if err != nil {
+		logger.WithError(err).Info("invalid realm version supplied")
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	to, err := models.RealmVersionToDomain(req.To, entities.StatusDraft)
+	if err != nil {
+		logger.WithError(err).Info("invalid realm version supplied")
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	changes, err := api.realmOps.DiffRealm(ctx, logger, realmID, from, to)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	return &realm_mgr_v1.DiffRealmResponse{
+		Changes: models.RealmFieldChangesFromDomain(changes),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package realmmgrgrpc
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) ExportRealms(
+	req *realm_mgr_v1.ExportRealmsRequest,
+	stream realm_mgr_v1.RealmManagerService_ExportRealmsServer,
+) error {
+	ctx := stream.Context()
+
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	send := func(record entities.RealmExportRecord) error {
+		grpcRecord, convErr := models.RealmExportRecordFromDomain(record)
+		if convErr != nil {
+			logger.WithError(convErr).Error("failed to convert realm export record")
+			return status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		return stream.Send(&realm_mgr_v1.ExportRealmsResponse{
+			Record: grpcRecord,
+		})
+	}
+
+	if err = api.transferOps.ExportRealms(ctx, logger, req.IncludeDrafts, send); err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			if _, ok := status.FromError(err); ok {
+				// errors of sending to the stream already carry a status
+				return err
+			}
+			return status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	return nil
+}
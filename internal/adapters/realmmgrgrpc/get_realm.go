@@ -42,7 +42,17 @@ func (api *RealmManagerAPI) GetRealm(
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.Status))
 	}
 
-	realm, err := api.realmOps.GetRealm(ctx, logger, realmID, realmStatus)
+	if req.View == realm_mgr_v1.EnumRealmView_ENUM_REALM_VIEW_UNSPECIFIED {
+		req.View = realm_mgr_v1.EnumRealmView_ENUM_REALM_VIEW_BASIC
+	}
+
+	realmView, ok := models.RealmViewGRPCValues[req.View]
+	if !ok {
+		logger.WithField("view", req.View).Info("invalid realm view supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm view: %s", req.View))
+	}
+
+	realm, err := api.realmOps.GetRealm(ctx, logger, realmID, realmStatus, realmView)
 	if err != nil {
 		switch err.(type) {
 		case *realmmgr_errors.NotFoundError:
@@ -0,0 +1,70 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) GetRealmAncestors(
+	ctx context.Context,
+	req *realm_mgr_v1.GetRealmAncestorsRequest,
+) (*realm_mgr_v1.GetRealmAncestorsResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	realmID, err := uuid.Parse(req.Id)
+	if err != nil {
+		logger.WithError(err).WithField("realm-id", req.Id).Info("invalid realm ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, req.Id))
+	}
+
+	// if status not provided in the request, default it to look up the active realm
+	if req.Status == realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED {
+		req.Status = realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE
+	}
+
+	realmStatus, ok := models.StatusGRPCValues[req.Status]
+	if !ok {
+		logger.WithField("status", req.Status).Info("invalid realm status supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.Status))
+	}
+
+	ancestors, err := api.realmOps.GetRealmAncestors(ctx, logger, realmID, realmStatus)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("realm with ID not found: %s", realmID))
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcAncestors := make([]*realm_mgr_v1.Realm, 0, len(ancestors))
+	for _, ancestor := range ancestors {
+		grpcAncestor, convErr := models.RealmFromDomain(ancestor)
+		if convErr != nil {
+			logger.WithError(convErr).Error("failed to convert realm")
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		grpcAncestors = append(grpcAncestors, grpcAncestor)
+	}
+
+	return &realm_mgr_v1.GetRealmAncestorsResponse{
+		Ancestors: grpcAncestors,
+	}, nil
+}
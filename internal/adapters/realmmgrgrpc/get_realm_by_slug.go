@@ -0,0 +1,68 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) GetRealmBySlug(
+	ctx context.Context,
+	req *realm_mgr_v1.GetRealmBySlugRequest,
+) (*realm_mgr_v1.GetRealmBySlugResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	// if status not provided in the request, default it to always return active realm
+	if req.Status == realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED {
+		req.Status = realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE
+	}
+
+	realmStatus, ok := models.StatusGRPCValues[req.Status]
+	if !ok {
+		logger.WithField("status", req.Status).Info("invalid realm status supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.Status))
+	}
+
+	if req.View == realm_mgr_v1.EnumRealmView_ENUM_REALM_VIEW_UNSPECIFIED {
+		req.View = realm_mgr_v1.EnumRealmView_ENUM_REALM_VIEW_BASIC
+	}
+
+	realmView, ok := models.RealmViewGRPCValues[req.View]
+	if !ok {
+		logger.WithField("view", req.View).Info("invalid realm view supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm view: %s", req.View))
+	}
+
+	realm, err := api.realmOps.GetRealmBySlug(ctx, logger, req.Slug, realmStatus, realmView)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("realm with slug not found: %s", req.Slug))
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcRealm, err := models.RealmFromDomain(realm)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	return &realm_mgr_v1.GetRealmBySlugResponse{
+		Realm:      grpcRealm,
+		Redirected: realm.Slug != req.Slug,
+	}, nil
+}
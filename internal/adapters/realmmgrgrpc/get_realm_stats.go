@@ -0,0 +1,70 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) GetRealmStats(
+	ctx context.Context,
+	req *realm_mgr_v1.GetRealmStatsRequest,
+) (*realm_mgr_v1.GetRealmStatsResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	// an unset bound leaves the creation range open on that side
+	var createdAfter, createdBefore time.Time
+	if req.CreatedAfter != nil {
+		createdAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		createdBefore = req.CreatedBefore.AsTime()
+	}
+
+	stats, err := api.realmOps.GetRealmStats(
+		ctx,
+		logger,
+		req.LabelSelector,
+		createdAfter,
+		createdBefore,
+		int(req.StaleDraftDays),
+	)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	createdPerDay := make([]*realm_mgr_v1.RealmDailyCount, len(stats.CreatedPerDay))
+	for i, dailyCount := range stats.CreatedPerDay {
+		createdPerDay[i] = &realm_mgr_v1.RealmDailyCount{
+			Day:   timestamppb.New(dailyCount.Day),
+			Count: uint32(dailyCount.Count),
+		}
+	}
+
+	return &realm_mgr_v1.GetRealmStatsResponse{
+		ActiveCount:     uint32(stats.ActiveCount),
+		DraftCount:      uint32(stats.DraftCount),
+		DisabledCount:   uint32(stats.DisabledCount),
+		DeletedCount:    uint32(stats.DeletedCount),
+		StaleDraftCount: uint32(stats.StaleDraftCount),
+		CreatedPerDay:   createdPerDay,
+		GeneratedAt:     timestamppb.New(stats.GeneratedAt),
+	}, nil
+}
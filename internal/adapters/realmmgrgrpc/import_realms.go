@@ -0,0 +1,108 @@
+package realmmgrgrpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) ImportRealms(stream realm_mgr_v1.RealmManagerService_ImportRealmsServer) error {
+	ctx := stream.Context()
+
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	// the conflict policy is taken from the first request, so it is read before the import starts
+	first, firstErr := recvImportRequest(stream)
+	if errors.Is(firstErr, io.EOF) {
+		return stream.SendAndClose(&realm_mgr_v1.ImportRealmsResponse{})
+	}
+	if firstErr != nil && status.Code(firstErr) != codes.InvalidArgument {
+		return firstErr
+	}
+
+	conflictPolicy, ok := models.ImportConflictPolicyGRPCValues[first.ConflictPolicy]
+	if !ok {
+		logger.WithField("conflict-policy", first.ConflictPolicy).Info("invalid conflict policy supplied")
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected conflict policy: %s", first.ConflictPolicy))
+	}
+
+	received := 0
+	recv := func() (entities.RealmExportRecord, error) {
+		req, recvErr := first, firstErr
+		if received > 0 {
+			req, recvErr = recvImportRequest(stream)
+		}
+		if recvErr != nil && status.Code(recvErr) != codes.InvalidArgument {
+			return entities.RealmExportRecord{}, recvErr
+		}
+		received++
+
+		// requests failing validation are rejected like any other record that cannot be read
+		if recvErr != nil {
+			logger.WithError(recvErr).WithField("record-index", received-1).Info("invalid record supplied")
+			return entities.RealmExportRecord{}, realmmgr_errors.NewInvalidArgumentError(
+				"record",
+				fmt.Sprintf("is invalid: %s", status.Convert(recvErr).Message()),
+			)
+		}
+
+		record, convErr := models.RealmExportRecordToDomain(req.Record)
+		if convErr != nil {
+			logger.WithError(convErr).WithField("record-index", received-1).Info("invalid record supplied")
+			if _, isInvalid := convErr.(*realmmgr_errors.InvalidArgumentError); isInvalid {
+				return entities.RealmExportRecord{}, convErr
+			}
+			return entities.RealmExportRecord{}, realmmgr_errors.NewInvalidArgumentError(
+				"record",
+				fmt.Sprintf("is invalid: %s", convErr),
+			)
+		}
+		return record, nil
+	}
+
+	summary, err := api.transferOps.ImportRealms(ctx, logger, conflictPolicy, first.ValidateOnly, recv)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		case *realmmgr_errors.AbortedError:
+			return status.Errorf(codes.Aborted, err.Error())
+		default:
+			if _, ok := status.FromError(err); ok {
+				// errors of receiving from the stream already carry a status
+				return err
+			}
+			return status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	response, err := models.ImportRealmsResponseFromDomain(summary)
+	if err != nil {
+		logger.WithError(err).Error("failed to convert realm import summary")
+		return status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	return stream.SendAndClose(response)
+}
+
+// recvImportRequest receives the next request of the stream. Unlike the generated Recv, the
+// request is returned along with the error when it fails validation, so a single invalid
+// record does not end the import.
+func recvImportRequest(stream realm_mgr_v1.RealmManagerService_ImportRealmsServer) (*realm_mgr_v1.ImportRealmsRequest, error) {
+	req := new(realm_mgr_v1.ImportRealmsRequest)
+	err := stream.RecvMsg(req)
+	return req, err
+}
@@ -0,0 +1,96 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+
+	"github.com/alexZaicev/realm-mgr/internal/drivers/grpcserver"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/headers"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+// ActorHeader carries the identity of the caller on whose behalf a request is made. The
+// header is not authenticated by the service, so it must be set by a trusted gateway that
+// authenticates the caller and drops any value sent by the client. Release approvals rely
+// on it to tell reviewers apart from each other and from the requester.
+const ActorHeader = "x-actor"
+
+// ActorUnaryServerInterceptor reads the identity of the caller from the ActorHeader and
+// adds it to the context such that it can be accessed by the request handler. Requests
+// without the header are handled anonymously, while requests carrying multiple values are
+// rejected with an INVALID_ARGUMENT status code.
+//
+// LoggerUnaryServerInterceptor must be executed before this interceptor.
+func ActorUnaryServerInterceptor(backupLogger logging.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		actorCtx, err := contextWithActorFromMetadata(ctx, backupLogger)
+		if err != nil {
+			return nil, err
+		}
+		return handler(actorCtx, req)
+	}
+}
+
+// ActorStreamServerInterceptor is the streaming counterpart of ActorUnaryServerInterceptor,
+// adding the identity of the caller to the context of the stream.
+//
+// LoggerStreamServerInterceptor must be executed before this interceptor.
+func ActorStreamServerInterceptor(backupLogger logging.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		actorCtx, err := contextWithActorFromMetadata(stream.Context(), backupLogger)
+		if err != nil {
+			return err
+		}
+
+		wrappedStream := grpc_middleware.WrapServerStream(stream)
+		wrappedStream.WrappedContext = actorCtx
+		return handler(srv, wrappedStream)
+	}
+}
+
+// contextWithActorFromMetadata adds the identity of the caller found in the incoming
+// metadata of ctx to ctx. The returned errors carry a gRPC status code.
+func contextWithActorFromMetadata(ctx context.Context, backupLogger logging.Logger) (context.Context, error) {
+	logger, err := LoggerFromContext(ctx)
+	if err != nil {
+		backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Error(codes.Internal, "an unexpected error occurred")
+	}
+
+	carrier, err := grpcserver.NewMetadataCarrierFromIncomingContext(ctx)
+	if err != nil {
+		// no metadata means no actor either
+		return ctx, nil
+	}
+
+	actor, err := carrier.GetSingle(ActorHeader)
+	if err != nil {
+		switch castErr := err.(type) {
+		case *headers.HeaderNotFound:
+			return ctx, nil
+		case *headers.MultipleHeadersFound:
+			logger.WithError(err).Warn("multiple actors supplied")
+			return nil, status.Error(codes.InvalidArgument, castErr.PresentableError())
+		default:
+			logger.WithError(err).Error("failed to read actor header")
+			return nil, status.Error(codes.Internal, "an unexpected error occurred")
+		}
+	}
+
+	return ContextWithActor(ctx, actor), nil
+}
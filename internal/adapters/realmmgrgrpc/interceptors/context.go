@@ -12,6 +12,7 @@ type ctxKey string
 
 const (
 	loggerCtxKey ctxKey = "logger"
+	actorCtxKey  ctxKey = "actor"
 )
 
 func LoggerFromContext(ctx context.Context) (logging.Logger, error) {
@@ -26,3 +27,13 @@ func LoggerFromContext(ctx context.Context) (logging.Logger, error) {
 func ContextWithLogger(ctx context.Context, logger logging.Logger) context.Context {
 	return context.WithValue(ctx, loggerCtxKey, logger)
 }
+
+// ActorFromContext returns the identity of the caller, which is blank for anonymous requests.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorCtxKey).(string)
+	return actor
+}
+
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorCtxKey, actor)
+}
@@ -6,6 +6,8 @@ import (
 
 	"google.golang.org/grpc"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+
 	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
 )
 
@@ -28,15 +30,30 @@ func LoggerUnaryServerInterceptor(logger logging.Logger) grpc.UnaryServerInterce
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		service := path.Dir(info.FullMethod)[1:]
-		method := path.Base(info.FullMethod)
-
-		requestLogger := logger.WithFields(logging.Fields{
-			serviceKey: service,
-			methodKey:  method,
-		})
-
-		ctx = ContextWithLogger(ctx, requestLogger)
+		ctx = ContextWithLogger(ctx, methodLogger(logger, info.FullMethod))
 		return handler(ctx, req)
 	}
 }
+
+// LoggerStreamServerInterceptor is the streaming counterpart of LoggerUnaryServerInterceptor,
+// adding the logger to the context of the stream.
+func LoggerStreamServerInterceptor(logger logging.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wrappedStream := grpc_middleware.WrapServerStream(stream)
+		wrappedStream.WrappedContext = ContextWithLogger(stream.Context(), methodLogger(logger, info.FullMethod))
+		return handler(srv, wrappedStream)
+	}
+}
+
+// methodLogger returns a logger with the service and method of the gRPC API applied.
+func methodLogger(logger logging.Logger, fullMethod string) logging.Logger {
+	return logger.WithFields(logging.Fields{
+		serviceKey: path.Dir(fullMethod)[1:],
+		methodKey:  path.Base(fullMethod),
+	})
+}
@@ -67,6 +67,63 @@ func ValidateUnaryServerInterceptor(backupLogger logging.Logger) grpc.UnaryServe
 	}
 }
 
+// ValidateStreamServerInterceptor is the streaming counterpart of ValidateUnaryServerInterceptor.
+// Every message received from the client is validated as a request and every message sent to
+// the client as a response, failing validation ends the stream with the same status codes as
+// the unary interceptor.
+//
+// LoggerStreamServerInterceptor must be executed before this interceptor.
+func ValidateStreamServerInterceptor(backupLogger logging.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		logger, err := LoggerFromContext(stream.Context())
+		if err != nil {
+			backupLogger.WithError(err).Error("failed to extract logger from context")
+			return status.Error(codes.Internal, "an unexpected error occurred")
+		}
+
+		return handler(srv, &validatingServerStream{
+			ServerStream: stream,
+			logger:       logger,
+		})
+	}
+}
+
+// validatingServerStream validates the messages passing through the wrapped stream.
+type validatingServerStream struct {
+	grpc.ServerStream
+
+	logger logging.Logger
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if validateErr := validate(m); validateErr != nil {
+		s.logger.WithError(validateErr).Warn("request validation failed")
+		return status.Error(codes.InvalidArgument, validateErr.Error())
+	}
+
+	return nil
+}
+
+func (s *validatingServerStream) SendMsg(m interface{}) error {
+	// if we fail to validate the response, then we have a bug
+	// and we shouldn't let clients trip over themselves handling it
+	if validateErr := validate(m); validateErr != nil {
+		s.logger.WithError(validateErr).Error("response validation failed")
+		return status.Error(codes.Internal, "an unexpected error occurred")
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
+
 // validate attempts to validate the given input using one of the available validation interfaces.
 // If the input does not implement either interface, no validation is performed.
 func validate(input interface{}) error {
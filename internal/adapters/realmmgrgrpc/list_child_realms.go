@@ -0,0 +1,71 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) ListChildRealms(
+	ctx context.Context,
+	req *realm_mgr_v1.ListChildRealmsRequest,
+) (*realm_mgr_v1.ListChildRealmsResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	parentID, err := uuid.Parse(req.ParentId)
+	if err != nil {
+		logger.WithError(err).WithField("parent-id", req.ParentId).Info("invalid parent realm ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, req.ParentId))
+	}
+
+	// if status not provided in the request, default it to list active realms
+	if req.Status == realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED {
+		req.Status = realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE
+	}
+
+	realmStatus, ok := models.StatusGRPCValues[req.Status]
+	if !ok {
+		logger.WithField("status", req.Status).Info("invalid realm status supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.Status))
+	}
+
+	page, err := api.realmOps.ListChildRealms(ctx, logger, parentID, realmStatus, int(req.PageSize), req.PageToken)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("realm with ID not found: %s", parentID))
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcRealms := make([]*realm_mgr_v1.Realm, 0, len(page.Realms))
+	for _, realm := range page.Realms {
+		grpcRealm, convErr := models.RealmFromDomain(realm)
+		if convErr != nil {
+			logger.WithError(convErr).Error("failed to convert realm")
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		grpcRealms = append(grpcRealms, grpcRealm)
+	}
+
+	return &realm_mgr_v1.ListChildRealmsResponse{
+		Realms:        grpcRealms,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
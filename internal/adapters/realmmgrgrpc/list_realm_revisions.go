@@ -0,0 +1,58 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) ListRealmRevisions(
+	ctx context.Context,
+	req *realm_mgr_v1.ListRealmRevisionsRequest,
+) (*realm_mgr_v1.ListRealmRevisionsResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	realmID, err := uuid.Parse(req.Id)
+	if err != nil {
+		logger.WithError(err).WithField("realm-id", req.Id).Info("invalid realm ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, req.Id))
+	}
+
+	page, err := api.realmOps.ListRealmRevisions(ctx, logger, realmID, int(req.PageSize), req.PageToken)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcRevisions := make([]*realm_mgr_v1.RealmRevision, 0, len(page.Revisions))
+	for _, revision := range page.Revisions {
+		grpcRevision, convErr := models.RealmRevisionFromDomain(revision)
+		if convErr != nil {
+			logger.WithError(convErr).Error("failed to convert realm revision")
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		grpcRevisions = append(grpcRevisions, grpcRevision)
+	}
+
+	return &realm_mgr_v1.ListRealmRevisionsResponse{
+		Revisions:     grpcRevisions,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package realmmgrgrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) ListRealmTemplates(
+	ctx context.Context,
+	req *realm_mgr_v1.ListRealmTemplatesRequest,
+) (*realm_mgr_v1.ListRealmTemplatesResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	page, err := api.templateOps.ListRealmTemplates(ctx, logger, int(req.PageSize), req.PageToken)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcTemplates := make([]*realm_mgr_v1.RealmTemplate, 0, len(page.Templates))
+	for _, template := range page.Templates {
+		grpcTemplate, convErr := models.RealmTemplateFromDomain(template)
+		if convErr != nil {
+			logger.WithError(convErr).Error("failed to convert realm template")
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		grpcTemplates = append(grpcTemplates, grpcTemplate)
+	}
+
+	return &realm_mgr_v1.ListRealmTemplatesResponse{
+		Templates:     grpcTemplates,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
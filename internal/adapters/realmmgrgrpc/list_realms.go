@@ -0,0 +1,91 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) ListRealms(
+	ctx context.Context,
+	req *realm_mgr_v1.ListRealmsRequest,
+) (*realm_mgr_v1.ListRealmsResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	// if status not provided in the request, default it to list active realms
+	if req.Status == realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED {
+		req.Status = realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE
+	}
+	if req.SortField == realm_mgr_v1.EnumRealmSortField_ENUM_REALM_SORT_FIELD_UNSPECIFIED {
+		req.SortField = realm_mgr_v1.EnumRealmSortField_ENUM_REALM_SORT_FIELD_NAME
+	}
+	if req.SortDirection == realm_mgr_v1.EnumSortDirection_ENUM_SORT_DIRECTION_UNSPECIFIED {
+		req.SortDirection = realm_mgr_v1.EnumSortDirection_ENUM_SORT_DIRECTION_ASC
+	}
+
+	realmStatus, ok := models.StatusGRPCValues[req.Status]
+	if !ok {
+		logger.WithField("status", req.Status).Info("invalid realm status supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.Status))
+	}
+
+	sortField, ok := models.RealmSortFieldGRPCValues[req.SortField]
+	if !ok {
+		logger.WithField("sort-field", req.SortField).Info("invalid sort field supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected sort field: %s", req.SortField))
+	}
+
+	sortDirection, ok := models.SortDirectionGRPCValues[req.SortDirection]
+	if !ok {
+		logger.WithField("sort-direction", req.SortDirection).Info("invalid sort direction supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected sort direction: %s", req.SortDirection))
+	}
+
+	page, err := api.realmOps.ListRealms(
+		ctx,
+		logger,
+		realmStatus,
+		entities.RealmSorting{
+			Field:     sortField,
+			Direction: sortDirection,
+		},
+		int(req.PageSize),
+		req.PageToken,
+		req.LabelSelector,
+	)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcRealms := make([]*realm_mgr_v1.Realm, 0, len(page.Realms))
+	for _, realm := range page.Realms {
+		grpcRealm, convErr := models.RealmFromDomain(realm)
+		if convErr != nil {
+			logger.WithError(convErr).Error("failed to convert realm")
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		grpcRealms = append(grpcRealms, grpcRealm)
+	}
+
+	return &realm_mgr_v1.ListRealmsResponse{
+		Realms:        grpcRealms,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// AttributesFromDomain converts realm attributes into a protobuf Struct, realms without
+// attributes are returned without a Struct.
+func AttributesFromDomain(attributes map[string]interface{}) (*structpb.Struct, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	return structpb.NewStruct(attributes)
+}
+
+// AttributesToDomain converts a protobuf Struct into realm attributes, an empty Struct
+// is converted into nil attributes.
+func AttributesToDomain(attributes *structpb.Struct) map[string]interface{} {
+	if len(attributes.GetFields()) == 0 {
+		return nil
+	}
+	return attributes.AsMap()
+}
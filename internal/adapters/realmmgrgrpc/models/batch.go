@@ -0,0 +1,34 @@
+package models
+
+import (
+	"google.golang.org/grpc/codes"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+// BatchErrorFromDomain converts the error of a single batch item into its gRPC
+// representation, using the same status codes as the single item RPCs.
+func BatchErrorFromDomain(err error) *realm_mgr_v1.BatchError {
+	code := codes.Internal
+	switch err.(type) {
+	case *realmmgr_errors.NotFoundError:
+		code = codes.NotFound
+	case *realmmgr_errors.InvalidArgumentError:
+		code = codes.InvalidArgument
+	case *realmmgr_errors.FailedPreconditionError:
+		code = codes.FailedPrecondition
+	case *realmmgr_errors.AbortedError:
+		code = codes.Aborted
+	default:
+		return &realm_mgr_v1.BatchError{
+			Code:    uint32(code),
+			Message: InternalErrMsg,
+		}
+	}
+
+	return &realm_mgr_v1.BatchError{
+		Code:    uint32(code),
+		Message: err.Error(),
+	}
+}
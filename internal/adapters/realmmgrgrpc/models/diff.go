@@ -0,0 +1,42 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+// RealmVersionToDomain converts the realm version, a missing version selects the realm
+// in defaultStatus.
+func RealmVersionToDomain(version *realm_mgr_v1.RealmVersion, defaultStatus entities.Status) (entities.RealmVersion, error) {
+	if version.GetVersion() == nil {
+		return entities.RealmVersion{Status: defaultStatus}, nil
+	}
+
+	if revision := version.GetRevision(); revision > 0 {
+		return entities.RealmVersion{Revision: revision}, nil
+	}
+
+	realmStatus, ok := StatusGRPCValues[version.GetStatus()]
+	if !ok {
+		return entities.RealmVersion{}, realmmgr_errors.NewInvalidArgumentError(
+			"status",
+			fmt.Sprintf("has unexpected value %s", version.GetStatus()),
+		)
+	}
+	return entities.RealmVersion{Status: realmStatus}, nil
+}
+
+func RealmFieldChangesFromDomain(changes []entities.RealmFieldChange) []*realm_mgr_v1.RealmFieldChange {
+	result := make([]*realm_mgr_v1.RealmFieldChange, len(changes))
+	for i, change := range changes {
+		result[i] = &realm_mgr_v1.RealmFieldChange{
+			Path:     string(change.Field),
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		}
+	}
+	return result
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"strconv"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// ETagFromRevision renders the realm revision as an opaque etag.
+func ETagFromRevision(revision int64) string {
+	return strconv.FormatInt(revision, 10)
+}
+
+// RevisionFromETag parses an etag produced by ETagFromRevision. A blank etag yields
+// zero, which disables the revision check.
+func RevisionFromETag(etag string) (int64, error) {
+	if etag == "" {
+		return 0, nil
+	}
+
+	revision, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || revision < 1 {
+		return 0, realmmgr_errors.NewInvalidArgumentError("etag", "is malformed")
+	}
+	return revision, nil
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+var RealmEventTypeEnumValues = map[entities.RealmEventType]realm_mgr_v1.EnumRealmEventType{
+	entities.RealmEventTypeCreated:  realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_CREATED,
+	entities.RealmEventTypeUpdated:  realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_UPDATED,
+	entities.RealmEventTypeReleased: realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_RELEASED,
+	entities.RealmEventTypeDisabled: realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_DISABLED,
+	entities.RealmEventTypeDeleted:  realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_DELETED,
+}
+
+// WatchRealmsResponseFromDomain converts a watched realm event, the event is left empty for
+// the event carrying nothing but the sequence token a watch starts from.
+func WatchRealmsResponseFromDomain(event entities.WatchedRealmEvent) (*realm_mgr_v1.WatchRealmsResponse, error) {
+	response := &realm_mgr_v1.WatchRealmsResponse{
+		SequenceToken: event.SequenceToken,
+	}
+	if event.Type == 0 {
+		return response, nil
+	}
+
+	eventType, ok := RealmEventTypeEnumValues[event.Type]
+	if !ok {
+		return nil, realmmgr_errors.NewUnknownError(fmt.Sprintf("unexpected realm event type: %d", event.Type), nil)
+	}
+
+	// deleted realms have no status in the API
+	var status realm_mgr_v1.EnumStatus
+	if event.Status != entities.StatusDeleted {
+		status, ok = StatusEnumValues[event.Status]
+		if !ok {
+			return nil, realmmgr_errors.NewUnknownError(fmt.Sprintf("unexpected status type: %d", event.Status), nil)
+		}
+	}
+
+	response.Event = &realm_mgr_v1.RealmEvent{
+		RealmId:    event.RealmID.String(),
+		Type:       eventType,
+		Status:     status,
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+
+	return response, nil
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+// RealmFieldMaskToDomain converts the update mask paths into realm fields. Realm
+// message fields are named after their domain counterparts, so the paths are
+// validated by the domain layer.
+func RealmFieldMaskToDomain(mask *fieldmaskpb.FieldMask) []entities.RealmField {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		return nil
+	}
+
+	fields := make([]entities.RealmField, 0, len(paths))
+	for _, path := range paths {
+		fields = append(fields, entities.RealmField(path))
+	}
+	return fields
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func RealmMutationToDomain(mutation *realm_mgr_v1.RealmMutation) (entities.RealmMutation, error) {
+	switch m := mutation.GetMutation().(type) {
+	case *realm_mgr_v1.RealmMutation_Create:
+		parentID, err := ParentIDToDomain(m.Create.ParentId)
+		if err != nil {
+			return entities.RealmMutation{}, err
+		}
+		return entities.RealmMutation{
+			Type: entities.RealmMutationCreate,
+			Realm: entities.Realm{
+				Name:        m.Create.Name,
+				Slug:        m.Create.Slug,
+				Description: m.Create.Description,
+				Labels:      m.Create.Labels,
+				Attributes:  AttributesToDomain(m.Create.Attributes),
+				ParentID:    parentID,
+			},
+		}, nil
+	case *realm_mgr_v1.RealmMutation_Update:
+		realm, err := RealmToDomain(m.Update.Realm)
+		if err != nil {
+			return entities.RealmMutation{}, realmmgr_errors.NewInvalidArgumentError("realm", "is invalid")
+		}
+		expectedRevision, err := RevisionFromETag(m.Update.Etag)
+		if err != nil {
+			return entities.RealmMutation{}, err
+		}
+		return entities.RealmMutation{
+			Type:             entities.RealmMutationUpdate,
+			Realm:            realm,
+			UpdateMask:       RealmFieldMaskToDomain(m.Update.UpdateMask),
+			ExpectedRevision: expectedRevision,
+		}, nil
+	case *realm_mgr_v1.RealmMutation_Release:
+		realmID, err := uuid.Parse(m.Release.Id)
+		if err != nil {
+			return entities.RealmMutation{}, realmmgr_errors.NewInvalidArgumentError("id", "is not a valid UUID")
+		}
+		expectedRevision, err := RevisionFromETag(m.Release.Etag)
+		if err != nil {
+			return entities.RealmMutation{}, err
+		}
+		return entities.RealmMutation{
+			Type:             entities.RealmMutationRelease,
+			Realm:            entities.Realm{ID: realmID},
+			ExpectedRevision: expectedRevision,
+		}, nil
+	case *realm_mgr_v1.RealmMutation_Disable:
+		realmID, err := uuid.Parse(m.Disable.Id)
+		if err != nil {
+			return entities.RealmMutation{}, realmmgr_errors.NewInvalidArgumentError("id", "is not a valid UUID")
+		}
+		return entities.RealmMutation{
+			Type:    entities.RealmMutationDisable,
+			Realm:   entities.Realm{ID: realmID},
+			Reason:  m.Disable.Reason,
+			Cascade: m.Disable.Cascade,
+		}, nil
+	default:
+		return entities.RealmMutation{}, realmmgr_errors.NewInvalidArgumentError(
+			"mutation",
+			fmt.Sprintf("has unexpected type %T", m),
+		)
+	}
+}
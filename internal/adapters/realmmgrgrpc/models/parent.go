@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+// OptionalIDFromDomain converts an optional reference to another realm, such as its parent,
+// uuid.Nil is converted to an empty ID.
+func OptionalIDFromDomain(parentID uuid.UUID) string {
+	if parentID == uuid.Nil {
+		return ""
+	}
+	return parentID.String()
+}
+
+// ParentIDToDomain parses the parent of a realm, an empty parent ID makes a root realm.
+func ParentIDToDomain(parentID string) (uuid.UUID, error) {
+	if parentID == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(parentID)
+	if err != nil {
+		return uuid.Nil, realmmgr_errors.NewInvalidArgumentError("parentId", "is not a valid UUID")
+	}
+	return id, nil
+}
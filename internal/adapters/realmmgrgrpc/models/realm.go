@@ -20,13 +20,36 @@ func RealmFromDomain(realm entities.Realm) (*realm_mgr_v1.Realm, error) {
 		return nil, realmmgr_errors.NewUnknownError(fmt.Sprintf("unexpected status type: %d", realm.Status), nil)
 	}
 
+	var releaseSchedule *realm_mgr_v1.ReleaseSchedule
+	if realm.ReleaseSchedule != nil {
+		var err error
+		releaseSchedule, err = ReleaseScheduleFromDomain(*realm.ReleaseSchedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	attributes, err := AttributesFromDomain(realm.Attributes)
+	if err != nil {
+		return nil, realmmgr_errors.NewUnknownError("failed to convert realm attributes", err)
+	}
+
 	return &realm_mgr_v1.Realm{
-		Id:          realm.ID.String(),
-		Name:        realm.Name,
-		Description: realm.Description,
-		Status:      realmStatus,
-		CreatedAt:   timestamppb.New(realm.CreatedAt),
-		UpdatedAt:   timestamppb.New(realm.UpdatedAt),
+		Id:              realm.ID.String(),
+		Name:            realm.Name,
+		Description:     realm.Description,
+		Status:          realmStatus,
+		CreatedAt:       timestamppb.New(realm.CreatedAt),
+		UpdatedAt:       timestamppb.New(realm.UpdatedAt),
+		Etag:            ETagFromRevision(realm.Revision),
+		ReleaseSchedule: releaseSchedule,
+		Labels:          realm.Labels,
+		Attributes:      attributes,
+		ParentId:        OptionalIDFromDomain(realm.ParentID),
+		ClonedFrom:      OptionalIDFromDomain(realm.ClonedFrom),
+		TemplateId:      OptionalIDFromDomain(realm.Template.ID),
+		TemplateVersion: realm.Template.Version,
+		Slug:            realm.Slug,
 	}, nil
 }
 
@@ -40,10 +63,18 @@ func RealmToDomain(pbRealm *realm_mgr_v1.Realm) (entities.Realm, error) {
 		return entities.Realm{}, status.Errorf(codes.InvalidArgument, fmt.Sprintf("realm ID was not a valid UUID: %s", pbRealm.Id))
 	}
 
+	parentID, err := ParentIDToDomain(pbRealm.ParentId)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
 	return entities.Realm{
 		ID:          realmID,
 		Name:        pbRealm.Name,
 		Description: pbRealm.Description,
 		Status:      entities.StatusDraft,
+		Labels:      pbRealm.Labels,
+		Attributes:  AttributesToDomain(pbRealm.Attributes),
+		ParentID:    parentID,
 	}, nil
 }
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+var ApprovalDecisionEnumValues = map[entities.ApprovalDecision]realm_mgr_v1.EnumApprovalDecision{
+	entities.ApprovalDecisionApprove: realm_mgr_v1.EnumApprovalDecision_ENUM_APPROVAL_DECISION_APPROVE,
+	entities.ApprovalDecisionReject:  realm_mgr_v1.EnumApprovalDecision_ENUM_APPROVAL_DECISION_REJECT,
+}
+
+func ReleaseApprovalFromDomain(approval entities.ReleaseApproval) (*realm_mgr_v1.ReleaseApproval, error) {
+	realm, err := RealmFromDomain(approval.Realm)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]*realm_mgr_v1.ReleaseApprovalDecision, len(approval.Decisions))
+	for i, decision := range approval.Decisions {
+		grpcDecision, ok := ApprovalDecisionEnumValues[decision.Decision]
+		if !ok {
+			return nil, realmmgr_errors.NewUnknownError(fmt.Sprintf("unexpected approval decision: %d", decision.Decision), nil)
+		}
+		decisions[i] = &realm_mgr_v1.ReleaseApprovalDecision{
+			Reviewer:  decision.Reviewer,
+			Decision:  grpcDecision,
+			Comment:   decision.Comment,
+			DecidedAt: timestamppb.New(decision.DecidedAt),
+		}
+	}
+
+	return &realm_mgr_v1.ReleaseApproval{
+		Realm:       realm,
+		RequestedBy: approval.RequestedBy,
+		RequestedAt: timestamppb.New(approval.RequestedAt),
+		Decisions:   decisions,
+	}, nil
+}
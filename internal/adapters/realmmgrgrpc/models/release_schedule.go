@@ -0,0 +1,32 @@
+package models
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+var ReleaseScheduleStateEnumValues = map[entities.ReleaseScheduleState]realm_mgr_v1.EnumReleaseScheduleState{
+	entities.ReleaseScheduleStatePending: realm_mgr_v1.EnumReleaseScheduleState_ENUM_RELEASE_SCHEDULE_STATE_PENDING,
+	entities.ReleaseScheduleStateFailed:  realm_mgr_v1.EnumReleaseScheduleState_ENUM_RELEASE_SCHEDULE_STATE_FAILED,
+}
+
+func ReleaseScheduleFromDomain(schedule entities.ReleaseSchedule) (*realm_mgr_v1.ReleaseSchedule, error) {
+	state, ok := ReleaseScheduleStateEnumValues[schedule.State]
+	if !ok {
+		return nil, realmmgr_errors.NewUnknownError(fmt.Sprintf("unexpected release schedule state: %d", schedule.State), nil)
+	}
+
+	return &realm_mgr_v1.ReleaseSchedule{
+		ReleaseAt:     timestamppb.New(schedule.ReleaseAt),
+		ScheduledBy:   schedule.ScheduledBy,
+		State:         state,
+		Attempts:      uint32(schedule.Attempts),
+		NextAttemptAt: timestamppb.New(schedule.NextAttemptAt),
+		LastError:     schedule.LastError,
+	}, nil
+}
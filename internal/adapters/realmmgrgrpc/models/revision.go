@@ -0,0 +1,21 @@
+package models
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func RealmRevisionFromDomain(revision entities.RealmRevision) (*realm_mgr_v1.RealmRevision, error) {
+	realm, err := RealmFromDomain(revision.Realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realm_mgr_v1.RealmRevision{
+		Realm:      realm,
+		ReleasedBy: revision.ReleasedBy,
+		ReleasedAt: timestamppb.New(revision.ReleasedAt),
+	}, nil
+}
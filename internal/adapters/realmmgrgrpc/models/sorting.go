@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+var (
+	RealmSortFieldGRPCValues = map[realm_mgr_v1.EnumRealmSortField]entities.SortField{
+		realm_mgr_v1.EnumRealmSortField_ENUM_REALM_SORT_FIELD_NAME:       entities.SortFieldName,
+		realm_mgr_v1.EnumRealmSortField_ENUM_REALM_SORT_FIELD_CREATED_AT: entities.SortFieldCreatedAt,
+		realm_mgr_v1.EnumRealmSortField_ENUM_REALM_SORT_FIELD_UPDATED_AT: entities.SortFieldUpdatedAt,
+	}
+
+	SortDirectionGRPCValues = map[realm_mgr_v1.EnumSortDirection]entities.SortDirection{
+		realm_mgr_v1.EnumSortDirection_ENUM_SORT_DIRECTION_ASC:  entities.SortDirectionAsc,
+		realm_mgr_v1.EnumSortDirection_ENUM_SORT_DIRECTION_DESC: entities.SortDirectionDesc,
+	}
+)
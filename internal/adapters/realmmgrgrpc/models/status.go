@@ -6,8 +6,9 @@ import (
 )
 
 const (
-	InternalErrMsg = "an internal error occurred"
-	InvalidRealmID = "realm ID was not a valid UUID: %s"
+	InternalErrMsg         = "an internal error occurred"
+	InvalidRealmID         = "realm ID was not a valid UUID: %s"
+	InvalidRealmTemplateID = "realm template ID was not a valid UUID: %s"
 )
 
 var (
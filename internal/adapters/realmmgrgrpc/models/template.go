@@ -0,0 +1,58 @@
+package models
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func RealmTemplateFromDomain(template entities.RealmTemplate) (*realm_mgr_v1.RealmTemplate, error) {
+	attributes, err := AttributesFromDomain(template.Attributes)
+	if err != nil {
+		return nil, realmmgr_errors.NewUnknownError("failed to convert realm template attributes", err)
+	}
+
+	parameters := make([]*realm_mgr_v1.RealmTemplateParameter, 0, len(template.Parameters))
+	for _, parameter := range template.Parameters {
+		parameters = append(parameters, &realm_mgr_v1.RealmTemplateParameter{
+			Name:         parameter.Name,
+			Description:  parameter.Description,
+			DefaultValue: parameter.DefaultValue,
+			Required:     parameter.Required,
+		})
+	}
+
+	return &realm_mgr_v1.RealmTemplate{
+		Id:               template.ID.String(),
+		Version:          template.Version,
+		Name:             template.Name,
+		Description:      template.Description,
+		RealmDescription: template.RealmDescription,
+		Labels:           template.Labels,
+		Attributes:       attributes,
+		Parameters:       parameters,
+		CreatedBy:        template.CreatedBy,
+		CreatedAt:        timestamppb.New(template.CreatedAt),
+	}, nil
+}
+
+// RealmTemplateParametersToDomain converts the parameters declared by a template, templates
+// without parameters are converted into nil parameters.
+func RealmTemplateParametersToDomain(pbParameters []*realm_mgr_v1.RealmTemplateParameter) []entities.RealmTemplateParameter {
+	if len(pbParameters) == 0 {
+		return nil
+	}
+
+	parameters := make([]entities.RealmTemplateParameter, 0, len(pbParameters))
+	for _, parameter := range pbParameters {
+		parameters = append(parameters, entities.RealmTemplateParameter{
+			Name:         parameter.GetName(),
+			Description:  parameter.GetDescription(),
+			DefaultValue: parameter.GetDefaultValue(),
+			Required:     parameter.GetRequired(),
+		})
+	}
+	return parameters
+}
@@ -0,0 +1,179 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+var (
+	ImportConflictPolicyGRPCValues = map[realm_mgr_v1.EnumImportConflictPolicy]entities.ImportConflictPolicy{
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_UNSPECIFIED: entities.ImportConflictPolicyFail,
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_FAIL:        entities.ImportConflictPolicyFail,
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_SKIP:        entities.ImportConflictPolicySkip,
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_OVERWRITE:   entities.ImportConflictPolicyOverwrite,
+	}
+
+	RealmImportOutcomeEnumValues = map[entities.RealmImportOutcome]realm_mgr_v1.EnumRealmImportOutcome{
+		entities.RealmImportOutcomeCreated:  realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_CREATED,
+		entities.RealmImportOutcomeUpdated:  realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_UPDATED,
+		entities.RealmImportOutcomeSkipped:  realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_SKIPPED,
+		entities.RealmImportOutcomeRejected: realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_REJECTED,
+	}
+)
+
+func RealmExportRecordFromDomain(record entities.RealmExportRecord) (*realm_mgr_v1.RealmExportRecord, error) {
+	grpcRecord := &realm_mgr_v1.RealmExportRecord{
+		FormatVersion: uint32(record.FormatVersion),
+	}
+	if record.Released != nil {
+		released, err := RealmFromDomain(*record.Released)
+		if err != nil {
+			return nil, err
+		}
+		grpcRecord.Released = released
+	}
+	if record.Draft != nil {
+		draft, err := RealmFromDomain(*record.Draft)
+		if err != nil {
+			return nil, err
+		}
+		grpcRecord.Draft = draft
+	}
+	return grpcRecord, nil
+}
+
+// RealmExportRecordToDomain converts a record received for import. Unlike realms received for
+// any other RPC, the imported realms keep their status, timestamps, revision and references.
+func RealmExportRecordToDomain(pbRecord *realm_mgr_v1.RealmExportRecord) (entities.RealmExportRecord, error) {
+	if pbRecord == nil {
+		return entities.RealmExportRecord{}, realmmgr_errors.NewInvalidArgumentError("record", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+
+	record := entities.RealmExportRecord{
+		FormatVersion: int(pbRecord.FormatVersion),
+	}
+	if pbRecord.Released != nil {
+		released, err := importedRealmToDomain(pbRecord.Released)
+		if err != nil {
+			return entities.RealmExportRecord{}, err
+		}
+		record.Released = &released
+	}
+	if pbRecord.Draft != nil {
+		draft, err := importedRealmToDomain(pbRecord.Draft)
+		if err != nil {
+			return entities.RealmExportRecord{}, err
+		}
+		record.Draft = &draft
+	}
+	return record, nil
+}
+
+// importedRealmToDomain converts an exported realm. Unexpected statuses are left unset to be
+// rejected by the import along with any other invalid record.
+func importedRealmToDomain(pbRealm *realm_mgr_v1.Realm) (entities.Realm, error) {
+	realmID, err := uuid.Parse(pbRealm.Id)
+	if err != nil {
+		return entities.Realm{}, realmmgr_errors.NewInvalidArgumentError("id", "is not a valid UUID")
+	}
+
+	parentID, err := ParentIDToDomain(pbRealm.ParentId)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	clonedFrom, err := optionalIDToDomain("clonedFrom", pbRealm.ClonedFrom)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	templateID, err := optionalIDToDomain("templateId", pbRealm.TemplateId)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	revision, err := RevisionFromETag(pbRealm.Etag)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	return entities.Realm{
+		ID:          realmID,
+		Name:        pbRealm.Name,
+		Description: pbRealm.Description,
+		Status:      StatusGRPCValues[pbRealm.Status],
+		Slug:        pbRealm.Slug,
+		CreatedAt:   optionalTimeToDomain(pbRealm.CreatedAt),
+		UpdatedAt:   optionalTimeToDomain(pbRealm.UpdatedAt),
+		Revision:    revision,
+		Labels:      pbRealm.Labels,
+		Attributes:  AttributesToDomain(pbRealm.Attributes),
+		ParentID:    parentID,
+		ClonedFrom:  clonedFrom,
+		Template: entities.RealmTemplateRef{
+			ID:      templateID,
+			Version: pbRealm.TemplateVersion,
+		},
+	}, nil
+}
+
+func ImportRealmsResponseFromDomain(summary entities.RealmImportSummary) (*realm_mgr_v1.ImportRealmsResponse, error) {
+	response := &realm_mgr_v1.ImportRealmsResponse{
+		Results: make([]*realm_mgr_v1.RealmImportResult, len(summary.Results)),
+		Aborted: summary.Aborted,
+	}
+
+	for i, result := range summary.Results {
+		outcome, ok := RealmImportOutcomeEnumValues[result.Outcome]
+		if !ok {
+			return nil, realmmgr_errors.NewUnknownError(fmt.Sprintf("unexpected realm import outcome: %d", result.Outcome), nil)
+		}
+
+		grpcResult := &realm_mgr_v1.RealmImportResult{
+			RealmId: OptionalIDFromDomain(result.RealmID),
+			Outcome: outcome,
+		}
+
+		switch result.Outcome {
+		case entities.RealmImportOutcomeCreated:
+			response.CreatedCount++
+		case entities.RealmImportOutcomeUpdated:
+			response.UpdatedCount++
+		case entities.RealmImportOutcomeSkipped:
+			response.SkippedCount++
+		case entities.RealmImportOutcomeRejected:
+			response.RejectedCount++
+			grpcResult.Error = BatchErrorFromDomain(result.Err)
+		}
+
+		response.Results[i] = grpcResult
+	}
+
+	return response, nil
+}
+
+func optionalIDToDomain(field, id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, realmmgr_errors.NewInvalidArgumentError(field, "is not a valid UUID")
+	}
+	return parsed, nil
+}
+
+func optionalTimeToDomain(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
@@ -0,0 +1,11 @@
+package models
+
+import (
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+var RealmViewGRPCValues = map[realm_mgr_v1.EnumRealmView]entities.RealmView{
+	realm_mgr_v1.EnumRealmView_ENUM_REALM_VIEW_BASIC:     entities.RealmViewBasic,
+	realm_mgr_v1.EnumRealmView_ENUM_REALM_VIEW_EFFECTIVE: entities.RealmViewEffective,
+}
@@ -31,11 +31,28 @@ func (api *RealmManagerAPI) ReleaseRealm(
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, req.Id))
 	}
 
-	realm, err := api.realmOps.ReleaseRealm(ctx, logger, realmID)
+	expectedRevision, err := models.RevisionFromETag(req.Etag)
+	if err != nil {
+		logger.WithError(err).WithField("etag", req.Etag).Info("invalid etag supplied")
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	realm, err := api.realmOps.ReleaseRealm(
+		ctx,
+		logger,
+		realmID,
+		expectedRevision,
+		interceptors.ActorFromContext(ctx),
+		req.ValidateOnly,
+	)
 	if err != nil {
 		switch err.(type) {
 		case *realmmgr_errors.NotFoundError:
 			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("no releasable realm with ID found: %s", realmID))
+		case *realmmgr_errors.FailedPreconditionError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		case *realmmgr_errors.AbortedError:
+			return nil, status.Errorf(codes.Aborted, err.Error())
 		case *realmmgr_errors.InvalidArgumentError:
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		default:
@@ -0,0 +1,76 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+// reviewRelease records the decision of the calling actor on the release of the realm
+// draft, shared by ApproveRelease and RejectRelease.
+func (api *RealmManagerAPI) reviewRelease(
+	ctx context.Context,
+	id, etag, comment string,
+	decision entities.ApprovalDecision,
+	validateOnly bool,
+) (*realm_mgr_v1.ReleaseApproval, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	realmID, err := uuid.Parse(id)
+	if err != nil {
+		logger.WithError(err).WithField("realm-id", id).Info("invalid realm ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, id))
+	}
+
+	expectedRevision, err := models.RevisionFromETag(etag)
+	if err != nil {
+		logger.WithError(err).WithField("etag", etag).Info("invalid etag supplied")
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	approval, err := api.realmOps.ReviewRelease(
+		ctx,
+		logger,
+		realmID,
+		interceptors.ActorFromContext(ctx),
+		decision,
+		expectedRevision,
+		comment,
+		validateOnly,
+	)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("release approval of realm with ID not found: %s", realmID))
+		case *realmmgr_errors.FailedPreconditionError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		case *realmmgr_errors.AbortedError:
+			return nil, status.Errorf(codes.Aborted, err.Error())
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcApproval, err := models.ReleaseApprovalFromDomain(approval)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	return grpcApproval, nil
+}
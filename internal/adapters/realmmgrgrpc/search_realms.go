@@ -0,0 +1,66 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) SearchRealms(
+	ctx context.Context,
+	req *realm_mgr_v1.SearchRealmsRequest,
+) (*realm_mgr_v1.SearchRealmsResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	// if status not provided in the request, default it to search active realms
+	if req.Status == realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED {
+		req.Status = realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE
+	}
+
+	realmStatus, ok := models.StatusGRPCValues[req.Status]
+	if !ok {
+		logger.WithField("status", req.Status).Info("invalid realm status supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.Status))
+	}
+
+	page, err := api.realmOps.SearchRealms(ctx, logger, req.Query, realmStatus, int(req.PageSize), req.PageToken)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcResults := make([]*realm_mgr_v1.RealmSearchResult, 0, len(page.Results))
+	for _, result := range page.Results {
+		grpcRealm, convErr := models.RealmFromDomain(result.Realm)
+		if convErr != nil {
+			logger.WithError(convErr).Error("failed to convert realm")
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		grpcResults = append(grpcResults, &realm_mgr_v1.RealmSearchResult{
+			Realm:                grpcRealm,
+			Rank:                 float32(result.Rank),
+			NameHighlight:        result.NameHighlight,
+			DescriptionHighlight: result.DescriptionHighlight,
+		})
+	}
+
+	return &realm_mgr_v1.SearchRealmsResponse{
+		Results:       grpcResults,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -2,6 +2,7 @@ package realmmgrgrpc
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -14,10 +15,264 @@ import (
 )
 
 type RealmOps interface {
-	GetRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, status entities.Status) (entities.Realm, error)
-	CreateRealm(ctx context.Context, logger logging.Logger, name, description string) (entities.Realm, error)
-	ReleaseRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID) (entities.Realm, error)
-	UpdateRealm(ctx context.Context, logger logging.Logger, realm entities.Realm) (entities.Realm, error)
+	GetRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		status entities.Status,
+		view entities.RealmView,
+	) (entities.Realm, error)
+	GetRealmBySlug(
+		ctx context.Context,
+		logger logging.Logger,
+		slug string,
+		status entities.Status,
+		view entities.RealmView,
+	) (entities.Realm, error)
+	GetRealmAncestors(ctx context.Context, logger logging.Logger, realmID uuid.UUID, status entities.Status) ([]entities.Realm, error)
+	BatchGetRealms(
+		ctx context.Context,
+		logger logging.Logger,
+		realmIDs []uuid.UUID,
+		status entities.Status,
+	) ([]entities.RealmBatchResult, error)
+	ListRealms(
+		ctx context.Context,
+		logger logging.Logger,
+		status entities.Status,
+		sorting entities.RealmSorting,
+		pageSize int,
+		pageToken string,
+		labelSelector string,
+	) (entities.RealmPage, error)
+	SearchRealms(
+		ctx context.Context,
+		logger logging.Logger,
+		query string,
+		status entities.Status,
+		pageSize int,
+		pageToken string,
+	) (entities.RealmSearchPage, error)
+	GetRealmStats(
+		ctx context.Context,
+		logger logging.Logger,
+		labelSelector string,
+		createdAfter, createdBefore time.Time,
+		staleDraftDays int,
+	) (entities.RealmStats, error)
+	ListChildRealms(
+		ctx context.Context,
+		logger logging.Logger,
+		parentID uuid.UUID,
+		status entities.Status,
+		pageSize int,
+		pageToken string,
+	) (entities.RealmPage, error)
+	CreateRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		name, slug, description string,
+		labels map[string]string,
+		attributes map[string]interface{},
+		parentID uuid.UUID,
+		validateOnly bool,
+	) (entities.Realm, error)
+	CloneRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		sourceID uuid.UUID,
+		sourceStatus entities.Status,
+		name string,
+		validateOnly bool,
+	) (entities.Realm, error)
+	RenameRealmSlug(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		slug string,
+		validateOnly bool,
+	) (entities.Realm, error)
+	ReleaseRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		expectedRevision int64,
+		releasedBy string,
+		validateOnly bool,
+	) (entities.Realm, error)
+	ScheduleRelease(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		releaseAt time.Time,
+		scheduledBy string,
+		validateOnly bool,
+	) (entities.ReleaseSchedule, error)
+	CancelScheduledRelease(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		validateOnly bool,
+	) error
+	RequestReleaseApproval(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		requestedBy string,
+		validateOnly bool,
+	) (entities.ReleaseApproval, error)
+	ReviewRelease(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		reviewer string,
+		decision entities.ApprovalDecision,
+		expectedRevision int64,
+		comment string,
+		validateOnly bool,
+	) (entities.ReleaseApproval, error)
+	UpdateRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realm entities.Realm,
+		updateMask []entities.RealmField,
+		expectedRevision int64,
+		validateOnly bool,
+	) (entities.Realm, error)
+	BatchMutateRealms(
+		ctx context.Context,
+		logger logging.Logger,
+		mutations []entities.RealmMutation,
+		actor string,
+		validateOnly bool,
+	) ([]entities.Realm, error)
+	DisableRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		reason string,
+		cascade bool,
+		validateOnly bool,
+	) (entities.Realm, error)
+	EnableRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		reason string,
+		validateOnly bool,
+	) (entities.Realm, error)
+	DeleteRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		force bool,
+		cascade bool,
+		reason string,
+		validateOnly bool,
+	) error
+	RestoreRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		reason string,
+		validateOnly bool,
+	) (entities.Realm, error)
+	DiscardDraft(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		deleteRealm bool,
+		validateOnly bool,
+	) error
+	DiffRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		from, to entities.RealmVersion,
+	) ([]entities.RealmFieldChange, error)
+	RollbackRealm(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		revision int64,
+		reason string,
+		validateOnly bool,
+	) (entities.Realm, error)
+	GetRealmRevision(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		revision int64,
+		asOf time.Time,
+	) (entities.RealmRevision, error)
+	ListRealmRevisions(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		pageSize int,
+		pageToken string,
+	) (entities.RealmRevisionPage, error)
+}
+
+type RealmTemplateOps interface {
+	CreateRealmTemplate(
+		ctx context.Context,
+		logger logging.Logger,
+		template entities.RealmTemplate,
+		validateOnly bool,
+	) (entities.RealmTemplate, error)
+	GetRealmTemplate(ctx context.Context, logger logging.Logger, templateID uuid.UUID, version int64) (entities.RealmTemplate, error)
+	ListRealmTemplates(
+		ctx context.Context,
+		logger logging.Logger,
+		pageSize int,
+		pageToken string,
+	) (entities.RealmTemplatePage, error)
+	UpdateRealmTemplate(
+		ctx context.Context,
+		logger logging.Logger,
+		template entities.RealmTemplate,
+		expectedVersion int64,
+		validateOnly bool,
+	) (entities.RealmTemplate, error)
+	DeleteRealmTemplate(ctx context.Context, logger logging.Logger, templateID uuid.UUID, validateOnly bool) error
+	CreateRealmFromTemplate(
+		ctx context.Context,
+		logger logging.Logger,
+		templateID uuid.UUID,
+		templateVersion int64,
+		name string,
+		parameters map[string]string,
+		parentID uuid.UUID,
+		validateOnly bool,
+	) (entities.Realm, error)
+}
+
+type RealmWatchOps interface {
+	WatchRealms(
+		ctx context.Context,
+		logger logging.Logger,
+		realmID uuid.UUID,
+		status entities.Status,
+		sequenceToken string,
+		send func(event entities.WatchedRealmEvent) error,
+	) error
+}
+
+type RealmTransferOps interface {
+	ExportRealms(
+		ctx context.Context,
+		logger logging.Logger,
+		includeDrafts bool,
+		send func(record entities.RealmExportRecord) error,
+	) error
+	ImportRealms(
+		ctx context.Context,
+		logger logging.Logger,
+		conflictPolicy entities.ImportConflictPolicy,
+		validateOnly bool,
+		recv func() (entities.RealmExportRecord, error),
+	) (entities.RealmImportSummary, error)
 }
 
 type RealmManagerAPI struct {
@@ -25,12 +280,18 @@ type RealmManagerAPI struct {
 
 	backupLogger logging.Logger
 
-	realmOps RealmOps
+	realmOps    RealmOps
+	templateOps RealmTemplateOps
+	watchOps    RealmWatchOps
+	transferOps RealmTransferOps
 }
 
 func NewRealmManagerAPI(
 	backupLogger logging.Logger,
 	realmOps RealmOps,
+	templateOps RealmTemplateOps,
+	watchOps RealmWatchOps,
+	transferOps RealmTransferOps,
 ) (*RealmManagerAPI, error) {
 	if backupLogger == nil {
 		return nil, realmmgr_errors.NewInvalidArgumentError("backupLogger", realmmgr_errors.ErrMsgCannotBeNil)
@@ -38,9 +299,21 @@ func NewRealmManagerAPI(
 	if realmOps == nil {
 		return nil, realmmgr_errors.NewInvalidArgumentError("realmOps", realmmgr_errors.ErrMsgCannotBeNil)
 	}
+	if templateOps == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("templateOps", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if watchOps == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("watchOps", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if transferOps == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("transferOps", realmmgr_errors.ErrMsgCannotBeNil)
+	}
 	return &RealmManagerAPI{
 		backupLogger: backupLogger,
 		realmOps:     realmOps,
+		templateOps:  templateOps,
+		watchOps:     watchOps,
+		transferOps:  transferOps,
 	}, nil
 }
 
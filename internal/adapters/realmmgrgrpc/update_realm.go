@@ -29,11 +29,28 @@ func (api *RealmManagerAPI) UpdateRealm(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid realm data supplied")
 	}
 
-	realm, err := api.realmOps.UpdateRealm(ctx, logger, realmInput)
+	expectedRevision, err := models.RevisionFromETag(req.Etag)
+	if err != nil {
+		logger.WithError(err).WithField("etag", req.Etag).Info("invalid etag supplied")
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	realm, err := api.realmOps.UpdateRealm(
+		ctx,
+		logger,
+		realmInput,
+		models.RealmFieldMaskToDomain(req.UpdateMask),
+		expectedRevision,
+		req.ValidateOnly,
+	)
 	if err != nil {
 		switch err.(type) {
 		case *realmmgr_errors.NotFoundError:
 			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("realm with ID not found: %s", realmInput.ID))
+		case *realmmgr_errors.AbortedError:
+			return nil, status.Errorf(codes.Aborted, err.Error())
+		case *realmmgr_errors.FailedPreconditionError:
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
 		case *realmmgr_errors.InvalidArgumentError:
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		default:
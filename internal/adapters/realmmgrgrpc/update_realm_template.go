@@ -0,0 +1,72 @@
+package realmmgrgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) UpdateRealmTemplate(
+	ctx context.Context,
+	req *realm_mgr_v1.UpdateRealmTemplateRequest,
+) (*realm_mgr_v1.UpdateRealmTemplateResponse, error) {
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	templateID, err := uuid.Parse(req.Id)
+	if err != nil {
+		logger.WithError(err).WithField("template-id", req.Id).Info("invalid realm template ID supplied")
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmTemplateID, req.Id))
+	}
+
+	template, err := api.templateOps.UpdateRealmTemplate(
+		ctx,
+		logger,
+		entities.RealmTemplate{
+			ID:               templateID,
+			Name:             req.Name,
+			Description:      req.Description,
+			RealmDescription: req.RealmDescription,
+			Labels:           req.Labels,
+			Attributes:       models.AttributesToDomain(req.Attributes),
+			Parameters:       models.RealmTemplateParametersToDomain(req.Parameters),
+			CreatedBy:        interceptors.ActorFromContext(ctx),
+		},
+		req.Version,
+		req.ValidateOnly,
+	)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, status.Errorf(codes.NotFound, fmt.Sprintf("realm template with ID not found: %s", templateID))
+		case *realmmgr_errors.AbortedError:
+			return nil, status.Errorf(codes.Aborted, err.Error())
+		case *realmmgr_errors.InvalidArgumentError:
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	grpcTemplate, err := models.RealmTemplateFromDomain(template)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	return &realm_mgr_v1.UpdateRealmTemplateResponse{
+		Template: grpcTemplate,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package realmmgrgrpc
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+func (api *RealmManagerAPI) WatchRealms(
+	req *realm_mgr_v1.WatchRealmsRequest,
+	stream realm_mgr_v1.RealmManagerService_WatchRealmsServer,
+) error {
+	ctx := stream.Context()
+
+	logger, err := interceptors.LoggerFromContext(ctx)
+	if err != nil {
+		api.backupLogger.WithError(err).Error("failed to extract logger from context")
+		return status.Errorf(codes.Internal, models.InternalErrMsg)
+	}
+
+	var realmID uuid.UUID
+	if req.Id != "" {
+		realmID, err = uuid.Parse(req.Id)
+		if err != nil {
+			logger.WithError(err).WithField("realm-id", req.Id).Info("invalid realm ID supplied")
+			return status.Errorf(codes.InvalidArgument, fmt.Sprintf(models.InvalidRealmID, req.Id))
+		}
+	}
+
+	// realms in any status are watched when the status is not provided in the request
+	var realmStatus entities.Status
+	if req.Status != realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED {
+		var ok bool
+		realmStatus, ok = models.StatusGRPCValues[req.Status]
+		if !ok {
+			logger.WithField("status", req.Status).Info("invalid realm status supplied")
+			return status.Errorf(codes.InvalidArgument, fmt.Sprintf("unexpected realm status: %s", req.Status))
+		}
+	}
+
+	send := func(event entities.WatchedRealmEvent) error {
+		response, convErr := models.WatchRealmsResponseFromDomain(event)
+		if convErr != nil {
+			logger.WithError(convErr).Error("failed to convert realm event")
+			return status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+		return stream.Send(response)
+	}
+
+	err = api.watchOps.WatchRealms(ctx, logger, realmID, realmStatus, req.SequenceToken, send)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.InvalidArgumentError:
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		default:
+			if _, ok := status.FromError(err); ok {
+				// errors of sending to the stream already carry a status
+				return err
+			}
+			return status.Errorf(codes.Internal, models.InternalErrMsg)
+		}
+	}
+
+	// watches only end while the client is still there when the server shuts down, clients
+	// are expected to resume watching from the last sequence token they received
+	if ctx.Err() == nil {
+		return status.Errorf(codes.Unavailable, "server is shutting down")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package entities
+
+import "encoding/json"
+
+// CopyAttributes returns a deep copy of attributes, nil and empty maps are both copied
+// as nil. Attributes hold decoded JSON values, i.e. nil, bool, float64, string,
+// []interface{} and map[string]interface{}.
+func CopyAttributes(attributes map[string]interface{}) map[string]interface{} {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	return copyAttributeValue(attributes).(map[string]interface{})
+}
+
+func copyAttributeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = copyAttributeValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = copyAttributeValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// FormatAttributes renders attributes as JSON with object keys in sorted order, so that
+// equal attributes always produce the same string.
+func FormatAttributes(attributes map[string]interface{}) string {
+	if len(attributes) == 0 {
+		return ""
+	}
+
+	// attributes only hold decoded JSON values, so encoding them cannot fail
+	encoded, _ := json.Marshal(attributes)
+	return string(encoded)
+}
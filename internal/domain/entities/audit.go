@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction int
+
+const (
+	AuditActionDisable AuditAction = iota + 1
+	AuditActionEnable
+	AuditActionDelete
+	AuditActionPurge
+	AuditActionRestore
+	AuditActionRollback
+)
+
+// AuditRecord captures a single change made to a realm together with the
+// reason supplied by the caller.
+type AuditRecord struct {
+	RealmID   uuid.UUID
+	Action    AuditAction
+	Reason    string
+	CreatedAt time.Time
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+)
+
+// RealmBatchResult is the outcome of a batch operation for a single realm. Either the realm
+// or the error describing why the operation failed for it is set.
+type RealmBatchResult struct {
+	RealmID uuid.UUID
+	Realm   Realm
+	Err     error
+}
+
+type RealmMutationType int
+
+const (
+	RealmMutationCreate RealmMutationType = iota + 1
+	RealmMutationUpdate
+	RealmMutationRelease
+	RealmMutationDisable
+)
+
+// RealmMutation is a single operation of a batch mutation. Realm holds the name and
+// description of a created realm and the new state of an updated realm, other mutations
+// only use its ID.
+type RealmMutation struct {
+	Type  RealmMutationType
+	Realm Realm
+	// UpdateMask lists the fields to be updated, all mutable fields are updated when empty
+	UpdateMask []RealmField
+	// ExpectedRevision of the updated or released realm, not checked when zero
+	ExpectedRevision int64
+	// Reason for disabling the realm
+	Reason string
+	// Cascade disables the active descendants of a disabled realm as well
+	Cascade bool
+}
@@ -0,0 +1,44 @@
+package entities
+
+// RealmView selects how much of the realm hierarchy is reflected in a returned realm.
+type RealmView int
+
+const (
+	// RealmViewBasic returns the realm as stored
+	RealmViewBasic RealmView = iota + 1
+	// RealmViewEffective returns the realm with the labels and attributes of its
+	// ancestors applied
+	RealmViewEffective
+)
+
+// Effective applies the labels and attributes inherited from ancestors, ordered from the
+// direct parent up to the root. Values closer to the realm win: labels are overridden by
+// key and attributes by their top-level key.
+func (r Realm) Effective(ancestors []Realm) Realm {
+	realm := r.DeepCopyRealm()
+
+	var labels map[string]string
+	var attributes map[string]interface{}
+	for i := len(ancestors) - 1; i >= -1; i-- {
+		source := r
+		if i >= 0 {
+			source = ancestors[i]
+		}
+		for key, value := range source.Labels {
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+			labels[key] = value
+		}
+		for key, value := range CopyAttributes(source.Attributes) {
+			if attributes == nil {
+				attributes = make(map[string]interface{})
+			}
+			attributes[key] = value
+		}
+	}
+
+	realm.Labels = labels
+	realm.Attributes = attributes
+	return realm
+}
@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+)
+
+type LabelOperator int
+
+const (
+	LabelOperatorEquals LabelOperator = iota + 1
+	LabelOperatorNotEquals
+	LabelOperatorIn
+	LabelOperatorNotIn
+	LabelOperatorExists
+	LabelOperatorDoesNotExist
+)
+
+// LabelRequirement is a single term of a label selector, e.g. env in (prod, staging).
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+// CopyLabels returns a copy of labels, nil and empty maps are both copied as nil.
+func CopyLabels(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(labels))
+	for key, value := range labels {
+		result[key] = value
+	}
+	return result
+}
+
+// FormatLabels renders labels as comma separated key=value pairs ordered by key, so
+// that equal label sets always produce the same string.
+func FormatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+labels[key])
+	}
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SortField int
+
+const (
+	SortFieldName SortField = iota + 1
+	SortFieldCreatedAt
+	SortFieldUpdatedAt
+)
+
+type SortDirection int
+
+const (
+	SortDirectionAsc SortDirection = iota + 1
+	SortDirectionDesc
+)
+
+type RealmSorting struct {
+	Field     SortField
+	Direction SortDirection
+}
+
+// RealmCursor points at the last realm of a page, the following page
+// starts right after it in the requested sort order.
+type RealmCursor struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func CursorFromRealm(realm Realm) RealmCursor {
+	return RealmCursor{
+		ID:        realm.ID,
+		Name:      realm.Name,
+		CreatedAt: realm.CreatedAt,
+		UpdatedAt: realm.UpdatedAt,
+	}
+}
+
+type ListRealmsOptions struct {
+	Status  Status
+	Sorting RealmSorting
+	Limit   int
+	After   *RealmCursor
+	// LabelSelector lists the label requirements a realm must all satisfy to be listed
+	LabelSelector []LabelRequirement
+	// ParentID limits the listing to the direct children of a realm when set
+	ParentID uuid.UUID
+}
+
+type RealmPage struct {
+	Realms        []Realm
+	NextPageToken string
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,28 +12,99 @@ type Realm struct {
 	Name        string
 	Description string
 	Status      Status
+	// Slug is the unique human-readable identifier of the realm, it is shared by all
+	// copies of the realm and only changed by renaming it
+	Slug string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt time.Time
+
+	// PreviousStatus is the status the realm had before it was deleted
+	PreviousStatus Status
+	// Revision is increased on every change of the realm and used to detect concurrent changes
+	Revision int64
+	// ReleaseSchedule of a draft realm, nil when the draft is not scheduled for release
+	ReleaseSchedule *ReleaseSchedule
+	// Labels are free-form key/value pairs used to group and select realms
+	Labels map[string]string
+	// Attributes hold structured metadata, validated against the schemas of the deployment
+	Attributes map[string]interface{}
+	// ParentID of the realm the labels and attributes are inherited from, uuid.Nil for
+	// root realms
+	ParentID uuid.UUID
+	// ClonedFrom is the ID of the realm this realm was cloned from, uuid.Nil for realms
+	// that were created from scratch
+	ClonedFrom uuid.UUID
+	// Template is the template version the realm was created from, zero for realms that
+	// were not created from a template
+	Template RealmTemplateRef
 }
 
 func (r Realm) Merge(realm Realm) Realm {
-	r.Name = realm.Name
-	r.Description = realm.Description
+	return r.MergeFields(realm, nil)
+}
+
+// MergeFields copies the fields listed in mask from realm. An empty mask merges all
+// mutable fields, fields that are not mutable are never merged.
+func (r Realm) MergeFields(realm Realm, mask []RealmField) Realm {
+	if len(mask) == 0 {
+		for _, accessor := range mutableRealmFields {
+			accessor.merge(&r, realm)
+		}
+	}
+	for _, field := range mask {
+		if accessor, ok := mutableRealmFields[field]; ok {
+			accessor.merge(&r, realm)
+		}
+	}
 	r.UpdatedAt = realm.UpdatedAt
 
 	return r
 }
 
+// Diff lists the mutable fields whose values differ between r and realm, ordered by
+// field path. Old values are taken from r and new values from realm.
+func (r Realm) Diff(realm Realm) []RealmFieldChange {
+	changes := make([]RealmFieldChange, 0)
+	for field, accessor := range mutableRealmFields {
+		oldValue, newValue := accessor.value(r), accessor.value(realm)
+		if oldValue == newValue {
+			continue
+		}
+		changes = append(changes, RealmFieldChange{
+			Field:    field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
 func (r Realm) DeepCopyRealm() Realm {
-	return Realm{
-		ID:          r.ID,
-		Name:        r.Name,
-		Description: r.Description,
-		Status:      r.Status,
-		CreatedAt:   r.CreatedAt,
-		UpdatedAt:   r.UpdatedAt,
-		DeletedAt:   r.DeletedAt,
+	realm := Realm{
+		ID:             r.ID,
+		Name:           r.Name,
+		Description:    r.Description,
+		Status:         r.Status,
+		PreviousStatus: r.PreviousStatus,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+		DeletedAt:      r.DeletedAt,
+		Revision:       r.Revision,
+		Labels:         CopyLabels(r.Labels),
+		Attributes:     CopyAttributes(r.Attributes),
+		ParentID:       r.ParentID,
+		ClonedFrom:     r.ClonedFrom,
+		Template:       r.Template,
+		Slug:           r.Slug,
+	}
+	if r.ReleaseSchedule != nil {
+		schedule := *r.ReleaseSchedule
+		realm.ReleaseSchedule = &schedule
 	}
+	return realm
 }
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RealmEventType int
+
+const (
+	RealmEventTypeCreated RealmEventType = iota + 1
+	RealmEventTypeUpdated
+	RealmEventTypeReleased
+	RealmEventTypeDisabled
+	RealmEventTypeDeleted
+)
+
+// RealmEvent records a single change made to a realm. Events are ordered by the transaction
+// that recorded them and then by sequence, so events of a transaction that commits late are
+// never ordered before events that were already handed out.
+type RealmEvent struct {
+	// Transaction is the ID of the transaction that recorded the event
+	Transaction int64
+	// Sequence orders the events recorded by a transaction, it grows with every event recorded
+	Sequence int64
+	RealmID  uuid.UUID
+	Type     RealmEventType
+	// Status is the status of the realm row the change was made to
+	Status     Status
+	OccurredAt time.Time
+}
+
+// RealmEventCursor points at an event in the realm event log, the events following it are
+// ordered right after it.
+type RealmEventCursor struct {
+	Transaction int64
+	Sequence    int64
+}
+
+func CursorFromRealmEvent(event RealmEvent) RealmEventCursor {
+	return RealmEventCursor{
+		Transaction: event.Transaction,
+		Sequence:    event.Sequence,
+	}
+}
+
+type ListRealmEventsOptions struct {
+	// After lists only events ordered after the given cursor
+	After RealmEventCursor
+	// RealmID lists only events of the given realm, events of all realms are listed when nil
+	RealmID uuid.UUID
+	// Status lists only events of realm rows in the given status, events in any status are
+	// listed when zero. Deletes of whole realms are listed for any status, as the realm is
+	// gone in every status.
+	Status Status
+	Limit  int
+}
+
+// WatchedRealmEvent is a realm event handed out to watchers together with the token to
+// resume watching after it.
+type WatchedRealmEvent struct {
+	RealmEvent
+	SequenceToken string
+}
+
+// RealmEventPage is a batch of realm events together with the token to list the events
+// following it.
+type RealmEventPage struct {
+	Events    []WatchedRealmEvent
+	NextToken string
+}
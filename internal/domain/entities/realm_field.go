@@ -0,0 +1,143 @@
+package entities
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// RealmField names a realm field by its API path, e.g. in an update mask.
+type RealmField string
+
+const (
+	RealmFieldID              RealmField = "id"
+	RealmFieldName            RealmField = "name"
+	RealmFieldDescription     RealmField = "description"
+	RealmFieldLabels          RealmField = "labels"
+	RealmFieldAttributes      RealmField = "attributes"
+	RealmFieldParentID        RealmField = "parent_id"
+	RealmFieldStatus          RealmField = "status"
+	RealmFieldCreatedAt       RealmField = "created_at"
+	RealmFieldUpdatedAt       RealmField = "updated_at"
+	RealmFieldClonedFrom      RealmField = "cloned_from"
+	RealmFieldTemplateID      RealmField = "template_id"
+	RealmFieldTemplateVersion RealmField = "template_version"
+	RealmFieldSlug            RealmField = "slug"
+)
+
+// RealmFieldChange describes how the value of a single realm field differs between two
+// versions of a realm.
+type RealmFieldChange struct {
+	Field    RealmField
+	OldValue string
+	NewValue string
+}
+
+// realmFieldAccessor reads and copies the value of a single mutable realm field.
+type realmFieldAccessor struct {
+	value func(realm Realm) string
+	merge func(dst *Realm, src Realm)
+	// isSet reports whether the field is set in an update without a mask, such updates
+	// always change the field when nil
+	isSet func(realm Realm) bool
+}
+
+// mutableRealmFields holds the fields that can be changed by an update, together with
+// their accessors. New realm fields that can be updated must be registered here to be
+// picked up by MergeFields and Diff.
+var mutableRealmFields = map[RealmField]realmFieldAccessor{
+	RealmFieldName: {
+		value: func(realm Realm) string {
+			return realm.Name
+		},
+		merge: func(dst *Realm, src Realm) {
+			dst.Name = src.Name
+		},
+	},
+	RealmFieldDescription: {
+		value: func(realm Realm) string {
+			return realm.Description
+		},
+		merge: func(dst *Realm, src Realm) {
+			dst.Description = src.Description
+		},
+	},
+	RealmFieldLabels: {
+		value: func(realm Realm) string {
+			return FormatLabels(realm.Labels)
+		},
+		merge: func(dst *Realm, src Realm) {
+			dst.Labels = CopyLabels(src.Labels)
+		},
+		isSet: func(realm Realm) bool {
+			return len(realm.Labels) > 0
+		},
+	},
+	RealmFieldAttributes: {
+		value: func(realm Realm) string {
+			return FormatAttributes(realm.Attributes)
+		},
+		merge: func(dst *Realm, src Realm) {
+			dst.Attributes = CopyAttributes(src.Attributes)
+		},
+		isSet: func(realm Realm) bool {
+			return len(realm.Attributes) > 0
+		},
+	},
+	RealmFieldParentID: {
+		value: func(realm Realm) string {
+			if realm.ParentID == uuid.Nil {
+				return ""
+			}
+			return realm.ParentID.String()
+		},
+		merge: func(dst *Realm, src Realm) {
+			dst.ParentID = src.ParentID
+		},
+		isSet: func(realm Realm) bool {
+			return realm.ParentID != uuid.Nil
+		},
+	},
+}
+
+// UnmaskedUpdateFields lists the mutable fields changed by an update of realm without a
+// mask, ordered by field path. Name and description predate update masks and are always
+// changed, while fields added later are only changed when set in realm, so clients that do
+// not know them cannot clear them by accident.
+func UnmaskedUpdateFields(realm Realm) []RealmField {
+	fields := make([]RealmField, 0, len(mutableRealmFields))
+	for field, accessor := range mutableRealmFields {
+		if accessor.isSet == nil || accessor.isSet(realm) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i] < fields[j]
+	})
+	return fields
+}
+
+// immutableRealmFields holds the fields that are known but managed by the service.
+var immutableRealmFields = map[RealmField]struct{}{
+	RealmFieldID:              {},
+	RealmFieldStatus:          {},
+	RealmFieldCreatedAt:       {},
+	RealmFieldUpdatedAt:       {},
+	RealmFieldClonedFrom:      {},
+	RealmFieldTemplateID:      {},
+	RealmFieldTemplateVersion: {},
+	RealmFieldSlug:            {},
+}
+
+func (f RealmField) IsKnown() bool {
+	if f.IsMutable() {
+		return true
+	}
+	_, ok := immutableRealmFields[f]
+	return ok
+}
+
+func (f RealmField) IsMutable() bool {
+	_, ok := mutableRealmFields[f]
+	return ok
+}
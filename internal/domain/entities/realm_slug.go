@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RealmSlug maps a slug onto the realm it identifies. Every realm has a single current
+// slug, slugs replaced by renaming the realm are kept as redirects until they expire.
+type RealmSlug struct {
+	Slug      string
+	RealmID   uuid.UUID
+	CreatedAt time.Time
+	// ExpiresAt is set on redirects only, zero for the current slug of a realm
+	ExpiresAt time.Time
+}
+
+// IsRedirect reports whether the slug is a former slug of the realm.
+func (s RealmSlug) IsRedirect() bool {
+	return !s.ExpiresAt.IsZero()
+}
+
+// IsExpired reports whether the slug is a redirect that stopped resolving at now.
+func (s RealmSlug) IsExpired(now time.Time) bool {
+	return s.IsRedirect() && !now.Before(s.ExpiresAt)
+}
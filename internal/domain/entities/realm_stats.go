@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"time"
+)
+
+type RealmStatsOptions struct {
+	// LabelSelector lists the label requirements a realm must all satisfy to be counted
+	LabelSelector []LabelRequirement
+	// CreatedAfter and CreatedBefore limit the stats to realms created within the range,
+	// either of them is zero for a range that is open on that side
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// StaleDraftsBefore is the point in time drafts last updated before are stale at
+	StaleDraftsBefore time.Time
+	// DailyFrom and DailyTo limit the realms counted per day of creation
+	DailyFrom time.Time
+	DailyTo   time.Time
+}
+
+// RealmStats are aggregate counts of realms. Realms are counted once per status they have a
+// copy in, so a released realm with pending changes counts as both active and draft.
+type RealmStats struct {
+	ActiveCount   int64
+	DraftCount    int64
+	DisabledCount int64
+	DeletedCount  int64
+	// StaleDraftCount is the number of drafts that were not updated for the requested
+	// number of days
+	StaleDraftCount int64
+	// CreatedPerDay lists the number of realms created on every day with at least one
+	// realm created, ordered by day
+	CreatedPerDay []RealmDailyCount
+	// GeneratedAt is the point in time the stats were computed at
+	GeneratedAt time.Time
+}
+
+type RealmDailyCount struct {
+	// Day is midnight UTC of the day
+	Day   time.Time
+	Count int64
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RealmTemplateParameter declares a placeholder that can be used in the realm fields of a
+// template and is substituted when a realm is created from the template.
+type RealmTemplateParameter struct {
+	Name        string
+	Description string
+	// DefaultValue is used when the parameter is not supplied and not required
+	DefaultValue string
+	// Required parameters have to be supplied when a realm is created from the template
+	Required bool
+}
+
+// RealmTemplate is a published starting point for new realms. Every change of a template
+// is stored as a new version, earlier versions are kept so realms created from them can be
+// compared against the template later.
+type RealmTemplate struct {
+	ID      uuid.UUID
+	Version int64
+	Name    string
+	// Description of the template itself
+	Description string
+
+	// RealmDescription, Labels and Attributes are copied into realms created from the
+	// template, with placeholders substituted by the parameter values
+	RealmDescription string
+	Labels           map[string]string
+	Attributes       map[string]interface{}
+	Parameters       []RealmTemplateParameter
+
+	CreatedBy string
+	CreatedAt time.Time
+	// DeletedAt is set on all versions of a deleted template
+	DeletedAt time.Time
+}
+
+// RealmTemplateRef points at the template version a realm was created from.
+type RealmTemplateRef struct {
+	ID      uuid.UUID
+	Version int64
+}
+
+type ListRealmTemplatesOptions struct {
+	Limit int
+	// After lists only templates with an ID greater than the given one, all templates are
+	// listed when uuid.Nil
+	After uuid.UUID
+}
+
+type RealmTemplatePage struct {
+	Templates     []RealmTemplate
+	NextPageToken string
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+)
+
+// RealmExportFormatVersion is the version of the export records written by exports, imports
+// reject records of any other version.
+const RealmExportFormatVersion = 1
+
+// RealmExportRecord holds the copies of a single realm moved between deployments by exports
+// and imports. At least one of the copies is set.
+type RealmExportRecord struct {
+	FormatVersion int
+	// Released copy of the realm, either active or disabled, nil for realms that were never
+	// released
+	Released *Realm
+	// Draft copy of the realm, nil when the realm has no draft or drafts were not exported
+	Draft *Realm
+}
+
+// RealmID returns the ID of the realm the record was exported from.
+func (r RealmExportRecord) RealmID() uuid.UUID {
+	if r.Released != nil {
+		return r.Released.ID
+	}
+	if r.Draft != nil {
+		return r.Draft.ID
+	}
+	return uuid.Nil
+}
+
+type RealmExportPage struct {
+	Records       []RealmExportRecord
+	NextPageToken string
+}
+
+// ImportConflictPolicy decides how realms that already exist are imported.
+type ImportConflictPolicy int
+
+const (
+	// ImportConflictPolicyFail stops the import at the first realm that already exists
+	ImportConflictPolicyFail ImportConflictPolicy = iota + 1
+	// ImportConflictPolicySkip leaves realms that already exist as they are
+	ImportConflictPolicySkip
+	// ImportConflictPolicyOverwrite replaces all copies of realms that already exist
+	ImportConflictPolicyOverwrite
+)
+
+type RealmImportOutcome int
+
+const (
+	RealmImportOutcomeCreated RealmImportOutcome = iota + 1
+	RealmImportOutcomeUpdated
+	RealmImportOutcomeSkipped
+	RealmImportOutcomeRejected
+)
+
+// RealmImportResult is the outcome of importing a single export record, Err is only set for
+// rejected records.
+type RealmImportResult struct {
+	RealmID uuid.UUID
+	Outcome RealmImportOutcome
+	Err     error
+}
+
+// RealmImportSummary lists the results of an import in the order the records were imported.
+type RealmImportSummary struct {
+	Results []RealmImportResult
+	// Aborted is set when the import stopped at a realm that already exists, the records
+	// following it were not imported
+	Aborted bool
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ApprovalDecision int
+
+const (
+	ApprovalDecisionApprove ApprovalDecision = iota + 1
+	ApprovalDecisionReject
+)
+
+// ReleaseApprovalDecision is the verdict of a single reviewer on a draft revision.
+type ReleaseApprovalDecision struct {
+	RealmID   uuid.UUID
+	Revision  int64
+	Reviewer  string
+	Decision  ApprovalDecision
+	Comment   string
+	DecidedAt time.Time
+}
+
+// ReleaseApproval holds the snapshot of a draft that was submitted for approval, together
+// with the decisions reviewers made on that exact revision of the draft.
+type ReleaseApproval struct {
+	Realm Realm
+
+	RequestedBy string
+	RequestedAt time.Time
+
+	Decisions []ReleaseApprovalDecision
+}
+
+// Approvers returns the number of distinct reviewers who approved the release.
+func (a ReleaseApproval) Approvers() int {
+	approvers := make(map[string]struct{})
+	for _, decision := range a.Decisions {
+		if decision.Decision == ApprovalDecisionApprove {
+			approvers[decision.Reviewer] = struct{}{}
+		}
+	}
+	return len(approvers)
+}
+
+// Rejection returns the first decision rejecting the release, if any.
+func (a ReleaseApproval) Rejection() (ReleaseApprovalDecision, bool) {
+	for _, decision := range a.Decisions {
+		if decision.Decision == ApprovalDecisionReject {
+			return decision, true
+		}
+	}
+	return ReleaseApprovalDecision{}, false
+}
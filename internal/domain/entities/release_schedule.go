@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReleaseScheduleState int
+
+const (
+	// ReleaseScheduleStatePending schedules are released once they are due
+	ReleaseScheduleStatePending ReleaseScheduleState = iota + 1
+	// ReleaseScheduleStateFailed schedules ran out of release attempts and are not retried
+	ReleaseScheduleStateFailed
+)
+
+// ReleaseSchedule holds the point in time the draft of a realm is to be released at,
+// together with the outcome of previous release attempts.
+type ReleaseSchedule struct {
+	RealmID     uuid.UUID
+	ReleaseAt   time.Time
+	ScheduledBy string
+	State       ReleaseScheduleState
+
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RealmRevision is a snapshot of a realm taken when it was released. It is numbered by the
+// revision of the released realm, which advances with every change of the draft as well, so
+// the numbers of consecutive releases are not sequential.
+type RealmRevision struct {
+	Realm Realm
+
+	ReleasedBy string
+	ReleasedAt time.Time
+}
+
+type ListRealmRevisionsOptions struct {
+	RealmID uuid.UUID
+	Limit   int
+	// Before lists only revisions older than the given one, all revisions are listed when zero
+	Before int64
+}
+
+type RealmRevisionPage struct {
+	Revisions     []RealmRevision
+	NextPageToken string
+}
+
+// RealmVersion selects a version of a realm, either the realm in the given status or,
+// when Revision is set, a released revision.
+type RealmVersion struct {
+	Status   Status
+	Revision int64
+}
@@ -0,0 +1,26 @@
+package entities
+
+type SearchRealmsOptions struct {
+	// Query in web search syntax, e.g. `payments -legacy` or `"order processing"`
+	Query  string
+	Status Status
+	Limit  int
+	// Offset skips the given number of results, ranks are not stable enough between
+	// changes of the realms to continue after the last result of a page
+	Offset int
+}
+
+// RealmSearchResult is a realm matching a search query. Highlights hold the matching
+// parts of the realm with the matched terms wrapped in <mark> tags.
+type RealmSearchResult struct {
+	Realm Realm
+	// Rank is the relevance of the realm to the query, higher ranks are more relevant
+	Rank                 float64
+	NameHighlight        string
+	DescriptionHighlight string
+}
+
+type RealmSearchPage struct {
+	Results       []RealmSearchResult
+	NextPageToken string
+}
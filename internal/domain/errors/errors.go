@@ -10,10 +10,13 @@ const (
 )
 
 var (
-	InternalErrorType        = &InternalError{}
-	InvalidArgumentErrorType = &InvalidArgumentError{}
-	UnknownErrorType         = &UnknownError{}
-	NotFoundErrorType        = &NotFoundError{}
+	InternalErrorType           = &InternalError{}
+	InvalidArgumentErrorType    = &InvalidArgumentError{}
+	UnknownErrorType            = &UnknownError{}
+	NotFoundErrorType           = &NotFoundError{}
+	FailedPreconditionErrorType = &FailedPreconditionError{}
+	AbortedErrorType            = &AbortedError{}
+	BatchMutationErrorType      = &BatchMutationError{}
 )
 
 type InternalError struct {
@@ -67,3 +70,46 @@ func NewNotFoundError(msg string, err error) *NotFoundError {
 		),
 	}
 }
+
+type FailedPreconditionError struct {
+	baseError
+}
+
+func NewFailedPreconditionError(msg string, err error) *FailedPreconditionError {
+	return &FailedPreconditionError{
+		baseError: newBaseError(
+			fmt.Sprintf("failed precondition error occurred: %s", msg),
+			err,
+		),
+	}
+}
+
+type AbortedError struct {
+	baseError
+}
+
+func NewAbortedError(msg string, err error) *AbortedError {
+	return &AbortedError{
+		baseError: newBaseError(
+			fmt.Sprintf("aborted error occurred: %s", msg),
+			err,
+		),
+	}
+}
+
+// BatchMutationError reports the first mutation of a batch that failed, the error of the
+// mutation itself is available through Unwrap.
+type BatchMutationError struct {
+	baseError
+	Index int
+}
+
+func NewBatchMutationError(index int, err error) *BatchMutationError {
+	return &BatchMutationError{
+		baseError: newBaseError(
+			fmt.Sprintf("batch mutation %d failed: %s", index, err),
+			err,
+		),
+		Index: index,
+	}
+}
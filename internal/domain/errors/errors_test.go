@@ -36,3 +36,25 @@ func Test_NewNotFoundError_Success(t *testing.T) {
 	assert.IsType(t, realmmgr_errors.NotFoundErrorType, err)
 	assert.EqualError(t, errors.Unwrap(err), "mock error")
 }
+
+func Test_NewFailedPreconditionError_Success(t *testing.T) {
+	err := realmmgr_errors.NewFailedPreconditionError("hello world", errors.New("mock error"))
+	assert.EqualError(t, err, "failed precondition error occurred: hello world")
+	assert.IsType(t, realmmgr_errors.FailedPreconditionErrorType, err)
+	assert.EqualError(t, errors.Unwrap(err), "mock error")
+}
+
+func Test_NewAbortedError_Success(t *testing.T) {
+	err := realmmgr_errors.NewAbortedError("hello world", errors.New("mock error"))
+	assert.EqualError(t, err, "aborted error occurred: hello world")
+	assert.IsType(t, realmmgr_errors.AbortedErrorType, err)
+	assert.EqualError(t, errors.Unwrap(err), "mock error")
+}
+
+func Test_NewBatchMutationError_Success(t *testing.T) {
+	err := realmmgr_errors.NewBatchMutationError(2, errors.New("mock error"))
+	assert.EqualError(t, err, "batch mutation 2 failed: mock error")
+	assert.IsType(t, realmmgr_errors.BatchMutationErrorType, err)
+	assert.Equal(t, 2, err.Index)
+	assert.EqualError(t, errors.Unwrap(err), "mock error")
+}
@@ -1,4 +1,11 @@
 package repositories
 
+import (
+	"context"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
 type RealmManagerAuditRepository interface {
+	CreateAuditRecord(ctx context.Context, record entities.AuditRecord) error
 }
@@ -2,4 +2,11 @@ package repositories
 
 type RealmManagerRepository interface {
 	RealmRepository
+	RealmRevisionRepository
+	ReleaseScheduleRepository
+	ReleaseApprovalRepository
+	RealmTemplateRepository
+	RealmSlugRepository
+	RealmEventRepository
+	RealmStatsRepository
 }
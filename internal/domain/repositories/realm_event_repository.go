@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type RealmEventRepository interface {
+	CreateRealmEvent(ctx context.Context, event entities.RealmEvent) error
+	ListRealmEvents(ctx context.Context, options entities.ListRealmEventsOptions) ([]entities.RealmEvent, error)
+	GetLatestRealmEventCursor(ctx context.Context) (entities.RealmEventCursor, error)
+}
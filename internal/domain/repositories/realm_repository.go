@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -10,7 +11,16 @@ import (
 
 type RealmRepository interface {
 	GetRealm(ctx context.Context, realmID uuid.UUID, status entities.Status) (entities.Realm, error)
+	GetRealms(ctx context.Context, realmIDs []uuid.UUID, status entities.Status) ([]entities.Realm, error)
+	ListRealmChildIDs(ctx context.Context, parentIDs []uuid.UUID) ([]uuid.UUID, error)
+	ListExistingRealmIDs(ctx context.Context, realmIDs []uuid.UUID) ([]uuid.UUID, error)
+	ListRealms(ctx context.Context, options entities.ListRealmsOptions) ([]entities.Realm, error)
+	SearchRealms(ctx context.Context, options entities.SearchRealmsOptions) ([]entities.RealmSearchResult, error)
 	CreateRealm(ctx context.Context, realm entities.Realm) error
-	UpdateRealm(ctx context.Context, realm entities.Realm, currentStatus entities.Status) error
+	UpdateRealm(ctx context.Context, realm entities.Realm, currentStatus entities.Status, currentRevision int64) error
 	DeleteRealm(ctx context.Context, realmID uuid.UUID, statuses ...entities.Status) error
+	DeleteRealmAtRevision(ctx context.Context, realmID uuid.UUID, status entities.Status, revision int64) error
+	SoftDeleteRealm(ctx context.Context, realmID uuid.UUID, deletedAt time.Time, statuses ...entities.Status) (int64, error)
+	RestoreRealm(ctx context.Context, realmID uuid.UUID, restoredAt time.Time) (int64, error)
+	UpdateRealmSlug(ctx context.Context, realmID uuid.UUID, slug string) (int64, error)
 }
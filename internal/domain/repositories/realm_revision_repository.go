@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type RealmRevisionRepository interface {
+	CreateRealmRevision(ctx context.Context, revision entities.RealmRevision) error
+	GetRealmRevision(ctx context.Context, realmID uuid.UUID, revision int64) (entities.RealmRevision, error)
+	GetRealmRevisionAt(ctx context.Context, realmID uuid.UUID, at time.Time) (entities.RealmRevision, error)
+	ListRealmRevisions(ctx context.Context, options entities.ListRealmRevisionsOptions) ([]entities.RealmRevision, error)
+}
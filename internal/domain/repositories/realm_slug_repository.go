@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type RealmSlugRepository interface {
+	CreateRealmSlug(ctx context.Context, slug entities.RealmSlug) error
+	GetRealmSlug(ctx context.Context, slug string) (entities.RealmSlug, error)
+	ExpireRealmSlug(ctx context.Context, slug string, expiresAt time.Time) (int64, error)
+	DeleteRealmSlug(ctx context.Context, slug string) (int64, error)
+	DeleteRealmSlugs(ctx context.Context, realmID uuid.UUID) error
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type RealmStatsRepository interface {
+	GetRealmStats(ctx context.Context, options entities.RealmStatsOptions) (entities.RealmStats, error)
+}
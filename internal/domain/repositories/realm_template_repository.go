@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type RealmTemplateRepository interface {
+	CreateRealmTemplate(ctx context.Context, template entities.RealmTemplate) error
+	GetRealmTemplate(ctx context.Context, templateID uuid.UUID, version int64) (entities.RealmTemplate, error)
+	ListRealmTemplates(ctx context.Context, options entities.ListRealmTemplatesOptions) ([]entities.RealmTemplate, error)
+	DeleteRealmTemplate(ctx context.Context, templateID uuid.UUID, deletedAt time.Time) (int64, error)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type ReleaseApprovalRepository interface {
+	SaveReleaseApproval(ctx context.Context, approval entities.ReleaseApproval) error
+	GetReleaseApproval(ctx context.Context, realmID uuid.UUID) (entities.ReleaseApproval, error)
+	SaveReleaseApprovalDecision(ctx context.Context, decision entities.ReleaseApprovalDecision) error
+	DeleteReleaseApproval(ctx context.Context, realmID uuid.UUID) error
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+type ReleaseScheduleRepository interface {
+	SaveReleaseSchedule(ctx context.Context, schedule entities.ReleaseSchedule) error
+	UpdateReleaseSchedule(ctx context.Context, schedule entities.ReleaseSchedule) (int64, error)
+	GetReleaseSchedule(ctx context.Context, realmID uuid.UUID) (entities.ReleaseSchedule, error)
+	ListDueReleaseSchedules(ctx context.Context, dueAt time.Time, limit int) ([]entities.ReleaseSchedule, error)
+	ClaimDueReleaseSchedule(ctx context.Context, realmID uuid.UUID, dueAt time.Time) (entities.ReleaseSchedule, error)
+	DeleteReleaseSchedule(ctx context.Context, realmID uuid.UUID) (int64, error)
+}
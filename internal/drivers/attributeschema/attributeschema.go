@@ -0,0 +1,151 @@
+package attributeschema
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Validator defines an interface for checking realm attributes against the schemas
+// registered for the deployment.
+type Validator interface {
+	Validate(attributes map[string]interface{}) error
+}
+
+// JSONSchemaValidator provides a real implementation of the Validator interface. Every
+// top-level attribute is validated against the JSON schema registered under its key,
+// attributes without a registered schema are rejected.
+type JSONSchemaValidator struct {
+	schemas map[string]*jsonschema.Schema
+}
+
+// NewJSONSchemaValidator compiles the provided JSON schema documents keyed by the
+// attribute they apply to. Schemas are compiled as draft 2020-12 unless they declare
+// otherwise, references to external documents are not resolved.
+func NewJSONSchemaValidator(documents map[string]string) (*JSONSchemaValidator, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	compiler.LoadURL = func(location string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("loading external schema %s is not supported", location)
+	}
+
+	schemas := make(map[string]*jsonschema.Schema, len(documents))
+	for key, document := range documents {
+		location := fmt.Sprintf("mem://attributes/%s.json", url.PathEscape(key))
+		if err := compiler.AddResource(location, strings.NewReader(document)); err != nil {
+			return nil, realmmgr_errors.NewInvalidArgumentError(
+				"schemas",
+				fmt.Sprintf("contains invalid schema for attribute %s: %s", key, err),
+			)
+		}
+
+		schema, err := compiler.Compile(location)
+		if err != nil {
+			return nil, realmmgr_errors.NewInvalidArgumentError(
+				"schemas",
+				fmt.Sprintf("contains invalid schema for attribute %s: %s", key, err),
+			)
+		}
+		schemas[key] = schema
+	}
+
+	return &JSONSchemaValidator{
+		schemas: schemas,
+	}, nil
+}
+
+// Validate checks the attributes and returns an invalid argument error naming the JSON
+// path of the first value that failed validation, e.g. $.ownership.contacts[0].name.
+func (v *JSONSchemaValidator) Validate(attributes map[string]interface{}) error {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		path := "$" + pathSegment(key)
+
+		schema, ok := v.schemas[key]
+		if !ok {
+			return newValidationError(path, "no schema is registered for this attribute")
+		}
+
+		err := schema.Validate(attributes[key])
+		if err == nil {
+			continue
+		}
+
+		validationErr, ok := err.(*jsonschema.ValidationError)
+		if !ok {
+			return newValidationError(path, err.Error())
+		}
+
+		// the first leaf cause is the most specific reason the value was rejected
+		for len(validationErr.Causes) > 0 {
+			validationErr = validationErr.Causes[0]
+		}
+
+		return newValidationError(
+			path+instancePath(attributes[key], validationErr.InstanceLocation),
+			validationErr.Message,
+		)
+	}
+
+	return nil
+}
+
+func newValidationError(path, message string) error {
+	return realmmgr_errors.NewInvalidArgumentError(
+		"attributes",
+		fmt.Sprintf("failed validation at %s: %s", path, message),
+	)
+}
+
+// instancePath converts a JSON pointer into the value to a JSON path, using the value
+// to tell array indices from object keys.
+func instancePath(value interface{}, pointer string) string {
+	if pointer == "" {
+		return ""
+	}
+
+	var path strings.Builder
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		switch current := value.(type) {
+		case []interface{}:
+			path.WriteString("[" + token + "]")
+			if index, err := strconv.Atoi(token); err == nil && index >= 0 && index < len(current) {
+				value = current[index]
+			} else {
+				value = nil
+			}
+		case map[string]interface{}:
+			path.WriteString(pathSegment(token))
+			value = current[token]
+		default:
+			path.WriteString(pathSegment(token))
+			value = nil
+		}
+	}
+
+	return path.String()
+}
+
+func pathSegment(key string) string {
+	if identifierRegexp.MatchString(key) {
+		return "." + key
+	}
+	return "['" + strings.ReplaceAll(key, "'", `\'`) + "']"
+}
@@ -0,0 +1,113 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type BatchGetRealmsInput struct {
+	RealmIDs []uuid.UUID
+	Status   entities.Status
+}
+
+func (i *BatchGetRealmsInput) Validate() error {
+	if len(i.RealmIDs) == 0 {
+		return realmmgr_errors.NewInvalidArgumentError("realmIDs", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	for _, realmID := range i.RealmIDs {
+		if realmID == uuid.Nil {
+			return realmmgr_errors.NewInvalidArgumentError("realmIDs", "cannot contain blank IDs")
+		}
+	}
+	return nil
+}
+
+type BatchGetRealmsRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *BatchGetRealmsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type BatchGetRealms struct {
+	maxBatchSize int
+}
+
+// NewBatchGetRealms creates the use case fetching up to maxBatchSize realms at once.
+func NewBatchGetRealms(maxBatchSize int) (*BatchGetRealms, error) {
+	if maxBatchSize < 1 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("maxBatchSize", "must be positive")
+	}
+	return &BatchGetRealms{
+		maxBatchSize: maxBatchSize,
+	}, nil
+}
+
+// BatchGetRealms fetches all requested realms with a single repository call. The result holds
+// one entry per requested ID in request order, realms that cannot be found are reported with
+// a NotFoundError instead of failing the whole batch.
+func (r *BatchGetRealms) BatchGetRealms(
+	ctx context.Context,
+	repos BatchGetRealmsRepos,
+	input BatchGetRealmsInput,
+) ([]entities.RealmBatchResult, error) {
+	if err := repos.Validate(); err != nil {
+		return nil, err
+	}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	if len(input.RealmIDs) > r.maxBatchSize {
+		return nil, realmmgr_errors.NewInvalidArgumentError(
+			"realmIDs",
+			fmt.Sprintf("cannot contain more than %d IDs", r.maxBatchSize),
+		)
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":    "batch-get-realms",
+		"realm-count": len(input.RealmIDs),
+	})
+
+	realms, err := repos.Repository.GetRealms(ctx, input.RealmIDs, input.Status)
+	if err != nil {
+		logger.WithError(err).Error("failed to get realms from repository")
+		return nil, realmmgr_errors.NewInternalError("failed to get realms from repository", nil)
+	}
+
+	realmsByID := make(map[uuid.UUID]entities.Realm, len(realms))
+	for _, realm := range realms {
+		realmsByID[realm.ID] = realm
+	}
+
+	results := make([]entities.RealmBatchResult, len(input.RealmIDs))
+	for i, realmID := range input.RealmIDs {
+		results[i].RealmID = realmID
+
+		// deleted realms are reported as not found, same as by GetRealm
+		realm, ok := realmsByID[realmID]
+		if !ok || realm.Status == entities.StatusDeleted {
+			results[i].Err = realmmgr_errors.NewNotFoundError(fmt.Sprintf("realm with ID %s not found", realmID), nil)
+			continue
+		}
+		results[i].Realm = realm
+	}
+
+	return results, nil
+}
@@ -0,0 +1,78 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type CancelScheduledReleaseInput struct {
+	RealmID uuid.UUID
+}
+
+func (i *CancelScheduledReleaseInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type CancelScheduledReleaseRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *CancelScheduledReleaseRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type CancelScheduledRelease struct {
+}
+
+func NewCancelScheduledRelease() *CancelScheduledRelease {
+	return &CancelScheduledRelease{}
+}
+
+func (r *CancelScheduledRelease) CancelScheduledRelease(
+	ctx context.Context,
+	repos CancelScheduledReleaseRepos,
+	input CancelScheduledReleaseInput,
+) error {
+	if err := repos.Validate(); err != nil {
+		return err
+	}
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "cancel-scheduled-release",
+		"realm-id": input.RealmID,
+	})
+
+	deleted, err := repos.Repository.DeleteReleaseSchedule(ctx, input.RealmID)
+	if err != nil {
+		logger.WithError(err).Error("failed to delete release schedule from repository")
+		return realmmgr_errors.NewInternalError("failed to delete release schedule from repository", nil)
+	}
+	if deleted == 0 {
+		return realmmgr_errors.NewNotFoundError(
+			fmt.Sprintf("scheduled release of realm with ID %s not found", input.RealmID),
+			nil,
+		)
+	}
+
+	return nil
+}
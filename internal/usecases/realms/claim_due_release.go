@@ -0,0 +1,91 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type ClaimDueReleaseInput struct {
+	RealmID uuid.UUID
+}
+
+func (i *ClaimDueReleaseInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type ClaimDueReleaseRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ClaimDueReleaseRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ClaimDueRelease struct {
+}
+
+func NewClaimDueRelease() *ClaimDueRelease {
+	return &ClaimDueRelease{}
+}
+
+// ClaimDueRelease claims the due release schedule of the realm for the transaction of the
+// repository, so no other worker attempts the release until the transaction ends. A not found
+// error is returned when another worker holds the claim, or the schedule was completed,
+// cancelled or postponed in the meantime.
+func (r *ClaimDueRelease) ClaimDueRelease(
+	ctx context.Context,
+	repos ClaimDueReleaseRepos,
+	input ClaimDueReleaseInput,
+) (entities.ReleaseSchedule, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.ReleaseSchedule{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.ReleaseSchedule{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "claim-due-release",
+		"realm-id": input.RealmID,
+	})
+
+	schedule, err := repos.Repository.ClaimDueReleaseSchedule(ctx, input.RealmID, repos.Clock.Now())
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.ReleaseSchedule{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("due release of realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to claim release schedule in repository")
+			return entities.ReleaseSchedule{}, realmmgr_errors.NewInternalError("failed to claim release schedule in repository", nil)
+		}
+	}
+
+	return schedule, nil
+}
@@ -0,0 +1,166 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/attributeschema"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/uuidgenerator"
+)
+
+type CloneRealmInput struct {
+	SourceID uuid.UUID
+	// SourceStatus selects the active or the draft state of the source realm to be cloned
+	SourceStatus entities.Status
+	// Name of the cloned realm
+	Name string
+}
+
+func (i *CloneRealmInput) Validate() error {
+	if i.SourceID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("sourceID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.SourceStatus != entities.StatusActive && i.SourceStatus != entities.StatusDraft {
+		return realmmgr_errors.NewInvalidArgumentError("sourceStatus", "must be active or draft")
+	}
+	if i.Name == "" {
+		return realmmgr_errors.NewInvalidArgumentError("name", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type CloneRealmRepos struct {
+	Logger logging.Logger
+
+	UUIDGen uuidgenerator.Generator
+	Clock   clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *CloneRealmRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.UUIDGen == nil {
+		return realmmgr_errors.NewInvalidArgumentError("uuidGen", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type CloneRealm struct {
+	attributeValidator attributeschema.Validator
+	hierarchy          *RealmHierarchy
+}
+
+// NewCloneRealm creates the use case cloning realms. Cloned realms are checked the same way
+// as created ones, as the schemas and hierarchy may have changed since the source realm was
+// last updated.
+func NewCloneRealm(attributeValidator attributeschema.Validator, hierarchy *RealmHierarchy) (*CloneRealm, error) {
+	if attributeValidator == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("attributeValidator", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if hierarchy == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("hierarchy", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &CloneRealm{
+		attributeValidator: attributeValidator,
+		hierarchy:          hierarchy,
+	}, nil
+}
+
+// CloneRealm creates a new draft realm holding a copy of the mutable fields of the source
+// realm under the given name. Release schedules, approvals and revisions describe the
+// lifecycle of the source realm and are not carried over.
+func (r *CloneRealm) CloneRealm(ctx context.Context, repos CloneRealmRepos, input CloneRealmInput) (entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":  "clone-realm",
+		"source-id": input.SourceID,
+	})
+
+	source, err := repos.Repository.GetRealm(ctx, input.SourceID, input.SourceStatus)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.Realm{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("realm with ID %s not found", input.SourceID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get source realm from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get source realm from repository", nil)
+		}
+	}
+
+	if validateErr := r.attributeValidator.Validate(source.Attributes); validateErr != nil {
+		return entities.Realm{}, validateErr
+	}
+
+	if parentErr := r.hierarchy.checkParent(ctx, logger, repos.Repository, uuid.Nil, source.ParentID); parentErr != nil {
+		return entities.Realm{}, parentErr
+	}
+
+	realmID, err := repos.UUIDGen.New()
+	if err != nil {
+		logger.WithError(err).Error("failed to generate UUID id")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to generate UUID id", nil)
+	}
+
+	now := repos.Clock.Now()
+
+	clone := entities.Realm{
+		ID:         realmID,
+		Status:     entities.StatusDraft,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Revision:   1,
+		ClonedFrom: source.ID,
+	}.Merge(source)
+	clone.Name = input.Name
+	clone.UpdatedAt = now
+
+	// slugs are unique, so the clone gets its own slug derived from its name
+	clone.Slug, err = assignRealmSlug(ctx, logger, repos.Repository, realmID, "", input.Name, now)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if createErr := repos.Repository.CreateRealm(ctx, clone); createErr != nil {
+		logger.WithError(createErr).Error("failed to create cloned realm in repository")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to create cloned realm in repository", nil)
+	}
+
+	if eventErr := recordRealmEvent(
+		ctx,
+		logger,
+		repos.Repository,
+		realmID,
+		entities.RealmEventTypeCreated,
+		clone.Status,
+		now,
+	); eventErr != nil {
+		return entities.Realm{}, eventErr
+	}
+
+	return clone, nil
+}
@@ -3,22 +3,35 @@ package realms
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
 	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
 	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/attributeschema"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/uuidgenerator"
 )
 
 type CreateRealmInput struct {
-	Name        string
+	Name string
+	// Slug of the realm, derived from the name when empty
+	Slug        string
 	Description string
+	Labels      map[string]string
+	Attributes  map[string]interface{}
+	// ParentID of the realm to create the realm under, uuid.Nil for root realms
+	ParentID uuid.UUID
 }
 
 func (i *CreateRealmInput) Validate() error {
-	// TODO: add validation
-	return nil
+	if i.Slug != "" {
+		if err := validateRealmSlug(i.Slug); err != nil {
+			return err
+		}
+	}
+	return validateLabels(i.Labels)
 }
 
 type CreateRealmRepos struct {
@@ -36,21 +49,54 @@ func (r *CreateRealmRepos) Validate() error {
 }
 
 type CreateRealm struct {
+	attributeValidator attributeschema.Validator
+	hierarchy          *RealmHierarchy
 }
 
-func NewCreateRealm() *CreateRealm {
-	return &CreateRealm{}
+// NewCreateRealm creates the use case creating realms, with realm attributes checked by
+// attributeValidator and the parent of the realm checked against hierarchy.
+func NewCreateRealm(attributeValidator attributeschema.Validator, hierarchy *RealmHierarchy) (*CreateRealm, error) {
+	if attributeValidator == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("attributeValidator", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if hierarchy == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("hierarchy", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &CreateRealm{
+		attributeValidator: attributeValidator,
+		hierarchy:          hierarchy,
+	}, nil
 }
 
 func (r *CreateRealm) CreateRealm(ctx context.Context, repos CreateRealmRepos, input CreateRealmInput) (entities.Realm, error) {
 	if err := repos.Validate(); err != nil {
 		return entities.Realm{}, nil
 	}
+
+	logger := repos.Logger.WithField("use-case", "create-realm")
+
+	return r.createRealm(ctx, logger, repos, input, entities.RealmTemplateRef{})
+}
+
+// createRealm validates the input and creates a new draft realm from it, keeping a
+// reference to the template the realm was created from, if any.
+func (r *CreateRealm) createRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	repos CreateRealmRepos,
+	input CreateRealmInput,
+	template entities.RealmTemplateRef,
+) (entities.Realm, error) {
 	if err := input.Validate(); err != nil {
-		return entities.Realm{}, nil
+		return entities.Realm{}, err
+	}
+	if err := r.attributeValidator.Validate(input.Attributes); err != nil {
+		return entities.Realm{}, err
 	}
 
-	logger := repos.Logger.WithField("use-case", "create-realm")
+	if parentErr := r.hierarchy.checkParent(ctx, logger, repos.Repository, uuid.Nil, input.ParentID); parentErr != nil {
+		return entities.Realm{}, parentErr
+	}
 
 	realmID, err := repos.UUIDGen.New()
 	if err != nil {
@@ -60,19 +106,42 @@ func (r *CreateRealm) CreateRealm(ctx context.Context, repos CreateRealmRepos, i
 
 	now := repos.Clock.Now()
 
+	slug, err := assignRealmSlug(ctx, logger, repos.Repository, realmID, input.Slug, input.Name, now)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
 	realmToCreate := entities.Realm{
 		ID:          realmID,
 		Status:      entities.StatusDraft,
 		Name:        input.Name,
+		Slug:        slug,
 		Description: input.Description,
+		Labels:      entities.CopyLabels(input.Labels),
+		Attributes:  entities.CopyAttributes(input.Attributes),
+		ParentID:    input.ParentID,
+		Template:    template,
 		CreatedAt:   now,
 		UpdatedAt:   now,
+		Revision:    1,
 	}
 
 	if createErr := repos.Repository.CreateRealm(ctx, realmToCreate); createErr != nil {
-		logger.WithError(err).Error("failed to create realm in repository")
+		logger.WithError(createErr).Error("failed to create realm in repository")
 		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to create realm in repository", nil)
 	}
 
+	if eventErr := recordRealmEvent(
+		ctx,
+		logger,
+		repos.Repository,
+		realmID,
+		entities.RealmEventTypeCreated,
+		realmToCreate.Status,
+		now,
+	); eventErr != nil {
+		return entities.Realm{}, eventErr
+	}
+
 	return realmToCreate, nil
 }
@@ -0,0 +1,107 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+type CreateRealmFromTemplateInput struct {
+	TemplateID uuid.UUID
+	// TemplateVersion to create the realm from, the latest version is used when zero
+	TemplateVersion int64
+	// Name of the realm to create, it is also the value of the built-in name parameter
+	Name string
+	// Parameters hold the values substituted for the placeholders of the template
+	Parameters map[string]string
+	// ParentID of the realm to create the realm under, uuid.Nil for root realms
+	ParentID uuid.UUID
+}
+
+func (i *CreateRealmFromTemplateInput) Validate() error {
+	if i.TemplateID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("templateID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.TemplateVersion < 0 {
+		return realmmgr_errors.NewInvalidArgumentError("templateVersion", "cannot be negative")
+	}
+	if i.Name == "" {
+		return realmmgr_errors.NewInvalidArgumentError("name", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type CreateRealmFromTemplate struct {
+	creator *CreateRealm
+}
+
+// NewCreateRealmFromTemplate creates the use case instantiating templates, the realms are
+// created and checked by creator the same way as realms created from scratch.
+func NewCreateRealmFromTemplate(creator *CreateRealm) (*CreateRealmFromTemplate, error) {
+	if creator == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("creator", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &CreateRealmFromTemplate{
+		creator: creator,
+	}, nil
+}
+
+// CreateRealmFromTemplate creates a new draft realm from a template version, with the
+// placeholders in its description, label values and attribute values substituted by the
+// parameter values. The realm keeps a reference to the template version.
+func (r *CreateRealmFromTemplate) CreateRealmFromTemplate(
+	ctx context.Context,
+	repos CreateRealmRepos,
+	input CreateRealmFromTemplateInput,
+) (entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":    "create-realm-from-template",
+		"template-id": input.TemplateID,
+	})
+
+	template, err := getLiveRealmTemplate(ctx, logger, repos.Repository, input.TemplateID, input.TemplateVersion)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	values, err := resolveTemplateParameters(template, input.Name, input.Parameters)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	var labels map[string]string
+	if len(template.Labels) > 0 {
+		labels = make(map[string]string, len(template.Labels))
+		for key, value := range template.Labels {
+			labels[key] = substitutePlaceholders(value, values)
+		}
+	}
+
+	var attributes map[string]interface{}
+	if len(template.Attributes) > 0 {
+		attributes = substituteAttributePlaceholders(template.Attributes, values).(map[string]interface{})
+	}
+
+	createInput := CreateRealmInput{
+		Name:        input.Name,
+		Description: substitutePlaceholders(template.RealmDescription, values),
+		Labels:      labels,
+		Attributes:  attributes,
+		ParentID:    input.ParentID,
+	}
+
+	return r.creator.createRealm(ctx, logger, repos, createInput, entities.RealmTemplateRef{
+		ID:      template.ID,
+		Version: template.Version,
+	})
+}
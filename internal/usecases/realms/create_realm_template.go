@@ -0,0 +1,103 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/uuidgenerator"
+)
+
+type CreateRealmTemplateInput struct {
+	Name        string
+	Description string
+	// RealmDescription, Labels and Attributes of the realms created from the template, they
+	// may hold placeholders of the template parameters
+	RealmDescription string
+	Labels           map[string]string
+	Attributes       map[string]interface{}
+	Parameters       []entities.RealmTemplateParameter
+	// CreatedBy is the identity of the caller creating the template
+	CreatedBy string
+}
+
+func (i *CreateRealmTemplateInput) Validate() error {
+	return validateRealmTemplate(i.Name, i.RealmDescription, i.Labels, i.Attributes, i.Parameters)
+}
+
+type CreateRealmTemplateRepos struct {
+	Logger logging.Logger
+
+	UUIDGen uuidgenerator.Generator
+	Clock   clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *CreateRealmTemplateRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.UUIDGen == nil {
+		return realmmgr_errors.NewInvalidArgumentError("uuidGen", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type CreateRealmTemplate struct {
+}
+
+func NewCreateRealmTemplate() *CreateRealmTemplate {
+	return &CreateRealmTemplate{}
+}
+
+// CreateRealmTemplate creates the first version of a new realm template.
+func (r *CreateRealmTemplate) CreateRealmTemplate(
+	ctx context.Context,
+	repos CreateRealmTemplateRepos,
+	input CreateRealmTemplateInput,
+) (entities.RealmTemplate, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmTemplate{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmTemplate{}, err
+	}
+
+	logger := repos.Logger.WithField("use-case", "create-realm-template")
+
+	templateID, err := repos.UUIDGen.New()
+	if err != nil {
+		logger.WithError(err).Error("failed to generate UUID id")
+		return entities.RealmTemplate{}, realmmgr_errors.NewInternalError("failed to generate UUID id", nil)
+	}
+
+	template := entities.RealmTemplate{
+		ID:               templateID,
+		Version:          1,
+		Name:             input.Name,
+		Description:      input.Description,
+		RealmDescription: input.RealmDescription,
+		Labels:           entities.CopyLabels(input.Labels),
+		Attributes:       entities.CopyAttributes(input.Attributes),
+		Parameters:       input.Parameters,
+		CreatedBy:        input.CreatedBy,
+		CreatedAt:        repos.Clock.Now(),
+	}
+
+	if createErr := repos.Repository.CreateRealmTemplate(ctx, template); createErr != nil {
+		logger.WithError(createErr).Error("failed to create realm template in repository")
+		return entities.RealmTemplate{}, realmmgr_errors.NewInternalError("failed to create realm template in repository", nil)
+	}
+
+	return template, nil
+}
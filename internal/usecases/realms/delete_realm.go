@@ -0,0 +1,196 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type DeleteRealmInput struct {
+	RealmID uuid.UUID
+	// Force purges the realm rows instead of marking them as deleted
+	Force  bool
+	Reason string
+	// Cascade deletes the descendants of the realm as well, realms with child realms
+	// cannot be deleted otherwise
+	Cascade bool
+}
+
+func (i *DeleteRealmInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type DeleteRealmRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository      repositories.RealmManagerRepository
+	AuditRepository repositories.RealmManagerAuditRepository
+}
+
+func (r *DeleteRealmRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.AuditRepository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("auditRepository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type DeleteRealm struct {
+	hierarchy *RealmHierarchy
+}
+
+// NewDeleteRealm creates the use case deleting realms, with the descendants of a realm
+// looked up in hierarchy.
+func NewDeleteRealm(hierarchy *RealmHierarchy) (*DeleteRealm, error) {
+	if hierarchy == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("hierarchy", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &DeleteRealm{
+		hierarchy: hierarchy,
+	}, nil
+}
+
+// DeleteRealm marks active, draft and disabled rows of the realm as deleted. When
+// forced, the rows are purged afterwards together with any rows deleted earlier.
+// Cascaded deletes remove the descendants bottom-up before the realm itself.
+func (r *DeleteRealm) DeleteRealm(ctx context.Context, repos DeleteRealmRepos, input DeleteRealmInput) error {
+	if err := repos.Validate(); err != nil {
+		return err
+	}
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "delete-realm",
+		"realm-id": input.RealmID,
+		"force":    input.Force,
+	})
+
+	if !input.Cascade {
+		if childErr := checkNoChildRealms(
+			ctx,
+			logger,
+			repos.Repository,
+			input.RealmID,
+			"deleting",
+			entities.StatusActive,
+			entities.StatusDraft,
+			entities.StatusDisabled,
+		); childErr != nil {
+			return childErr
+		}
+
+		return r.deleteRealm(ctx, logger, repos, input.RealmID, input)
+	}
+
+	levels, err := r.hierarchy.descendants(ctx, logger, repos.Repository, input.RealmID)
+	if err != nil {
+		return err
+	}
+
+	for i := len(levels) - 1; i >= 0; i-- {
+		for _, descendantID := range levels[i] {
+			descendantLogger := logger.WithField("descendant-id", descendantID)
+			if deleteErr := r.deleteRealm(ctx, descendantLogger, repos, descendantID, input); deleteErr != nil {
+				return deleteErr
+			}
+		}
+	}
+
+	return r.deleteRealm(ctx, logger, repos, input.RealmID, input)
+}
+
+func (r *DeleteRealm) deleteRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	repos DeleteRealmRepos,
+	realmID uuid.UUID,
+	input DeleteRealmInput,
+) error {
+	now := repos.Clock.Now()
+
+	deleted, err := repos.Repository.SoftDeleteRealm(
+		ctx,
+		realmID,
+		now,
+		entities.StatusActive,
+		entities.StatusDraft,
+		entities.StatusDisabled,
+	)
+	if err != nil {
+		logger.WithError(err).Error("failed to soft delete realm in repository")
+		return realmmgr_errors.NewInternalError("failed to soft delete realm in repository", nil)
+	}
+
+	action := entities.AuditActionDelete
+
+	if input.Force {
+		if _, getErr := repos.Repository.GetRealm(ctx, realmID, entities.StatusDeleted); getErr != nil {
+			switch getErr.(type) {
+			case *realmmgr_errors.NotFoundError:
+				return realmmgr_errors.NewNotFoundError(fmt.Sprintf("realm with ID %s not found", realmID), nil)
+			default:
+				logger.WithError(getErr).Error("failed to get deleted realm from repository")
+				return realmmgr_errors.NewInternalError("failed to get deleted realm from repository", nil)
+			}
+		}
+
+		if deleteErr := repos.Repository.DeleteRealm(ctx, realmID, entities.StatusDeleted); deleteErr != nil {
+			logger.WithError(deleteErr).Error("failed to purge realm from repository")
+			return realmmgr_errors.NewInternalError("failed to purge realm from repository", nil)
+		}
+
+		if slugErr := deleteRealmSlugs(ctx, logger, repos.Repository, realmID); slugErr != nil {
+			return slugErr
+		}
+
+		action = entities.AuditActionPurge
+	} else if deleted == 0 {
+		// realms that are already deleted are not visible anymore
+		return realmmgr_errors.NewNotFoundError(fmt.Sprintf("realm with ID %s not found", realmID), nil)
+	}
+
+	// the draft of a deleted realm cannot be released anymore, nor may a restored realm be
+	// released by a schedule or approvals left over from before it was deleted
+	if scheduleErr := deleteReleaseSchedule(ctx, logger, repos.Repository, realmID); scheduleErr != nil {
+		return scheduleErr
+	}
+
+	if approvalErr := deleteReleaseApproval(ctx, logger, repos.Repository, realmID); approvalErr != nil {
+		return approvalErr
+	}
+
+	auditRecord := entities.AuditRecord{
+		RealmID:   realmID,
+		Action:    action,
+		Reason:    input.Reason,
+		CreatedAt: now,
+	}
+	if auditErr := repos.AuditRepository.CreateAuditRecord(ctx, auditRecord); auditErr != nil {
+		logger.WithError(auditErr).Error("failed to create audit record in repository")
+		return realmmgr_errors.NewInternalError("failed to create audit record in repository", nil)
+	}
+
+	return recordRealmEvent(ctx, logger, repos.Repository, realmID, entities.RealmEventTypeDeleted, entities.StatusDeleted, now)
+}
@@ -0,0 +1,86 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type DeleteRealmTemplateInput struct {
+	TemplateID uuid.UUID
+}
+
+func (i *DeleteRealmTemplateInput) Validate() error {
+	if i.TemplateID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("templateID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type DeleteRealmTemplateRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *DeleteRealmTemplateRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type DeleteRealmTemplate struct {
+}
+
+func NewDeleteRealmTemplate() *DeleteRealmTemplate {
+	return &DeleteRealmTemplate{}
+}
+
+// DeleteRealmTemplate deletes all versions of the template, so no more realms can be created
+// from it. The versions are kept for the realms that were already created from them.
+func (r *DeleteRealmTemplate) DeleteRealmTemplate(
+	ctx context.Context,
+	repos DeleteRealmTemplateRepos,
+	input DeleteRealmTemplateInput,
+) error {
+	if err := repos.Validate(); err != nil {
+		return err
+	}
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":    "delete-realm-template",
+		"template-id": input.TemplateID,
+	})
+
+	affected, err := repos.Repository.DeleteRealmTemplate(ctx, input.TemplateID, repos.Clock.Now())
+	if err != nil {
+		logger.WithError(err).Error("failed to delete realm template in repository")
+		return realmmgr_errors.NewInternalError("failed to delete realm template in repository", nil)
+	}
+	if affected == 0 {
+		return realmmgr_errors.NewNotFoundError(
+			fmt.Sprintf("realm template with ID %s not found", input.TemplateID),
+			nil,
+		)
+	}
+
+	return nil
+}
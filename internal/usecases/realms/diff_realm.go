@@ -0,0 +1,132 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+func validateRealmVersion(name string, version entities.RealmVersion) error {
+	if version.Revision < 0 {
+		return realmmgr_errors.NewInvalidArgumentError(name, "revision must be positive")
+	}
+	if version.Revision == 0 && version.Status != entities.StatusDraft && version.Status != entities.StatusActive {
+		return realmmgr_errors.NewInvalidArgumentError(name, "status must be draft or active")
+	}
+	return nil
+}
+
+func describeRealmVersion(version entities.RealmVersion) string {
+	if version.Revision > 0 {
+		return fmt.Sprintf("revision %d", version.Revision)
+	}
+	if version.Status == entities.StatusDraft {
+		return "draft"
+	}
+	return "active"
+}
+
+type DiffRealmInput struct {
+	RealmID uuid.UUID
+	From    entities.RealmVersion
+	To      entities.RealmVersion
+}
+
+func (i *DiffRealmInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if err := validateRealmVersion("from", i.From); err != nil {
+		return err
+	}
+	return validateRealmVersion("to", i.To)
+}
+
+type DiffRealmRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *DiffRealmRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type DiffRealm struct {
+}
+
+func NewDiffRealm() *DiffRealm {
+	return &DiffRealm{}
+}
+
+// DiffRealm lists the changes of the realm fields going from one version of the realm
+// to another.
+func (r *DiffRealm) DiffRealm(ctx context.Context, repos DiffRealmRepos, input DiffRealmInput) ([]entities.RealmFieldChange, error) {
+	if err := repos.Validate(); err != nil {
+		return nil, err
+	}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "diff-realm",
+		"realm-id": input.RealmID,
+	})
+
+	fromRealm, err := r.getRealmVersion(ctx, logger, repos, input.RealmID, input.From)
+	if err != nil {
+		return nil, err
+	}
+
+	toRealm, err := r.getRealmVersion(ctx, logger, repos, input.RealmID, input.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromRealm.Diff(toRealm), nil
+}
+
+func (r *DiffRealm) getRealmVersion(
+	ctx context.Context,
+	logger logging.Logger,
+	repos DiffRealmRepos,
+	realmID uuid.UUID,
+	version entities.RealmVersion,
+) (entities.Realm, error) {
+	var realm entities.Realm
+	var err error
+	if version.Revision > 0 {
+		var revision entities.RealmRevision
+		revision, err = repos.Repository.GetRealmRevision(ctx, realmID, version.Revision)
+		realm = revision.Realm
+	} else {
+		realm, err = repos.Repository.GetRealm(ctx, realmID, version.Status)
+	}
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.Realm{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("%s of realm with ID %s not found", describeRealmVersion(version), realmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get realm from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get realm from repository", nil)
+		}
+	}
+
+	return realm, nil
+}
@@ -0,0 +1,163 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type DisableRealmInput struct {
+	RealmID uuid.UUID
+	Reason  string
+	// Cascade disables the active descendants of the realm as well, realms with active
+	// child realms cannot be disabled otherwise
+	Cascade bool
+}
+
+func (i *DisableRealmInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.Reason == "" {
+		return realmmgr_errors.NewInvalidArgumentError("reason", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type DisableRealmRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository      repositories.RealmManagerRepository
+	AuditRepository repositories.RealmManagerAuditRepository
+}
+
+func (r *DisableRealmRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.AuditRepository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("auditRepository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type DisableRealm struct {
+	hierarchy *RealmHierarchy
+}
+
+// NewDisableRealm creates the use case disabling realms, with the descendants of a realm
+// looked up in hierarchy.
+func NewDisableRealm(hierarchy *RealmHierarchy) (*DisableRealm, error) {
+	if hierarchy == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("hierarchy", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &DisableRealm{
+		hierarchy: hierarchy,
+	}, nil
+}
+
+func (r *DisableRealm) DisableRealm(ctx context.Context, repos DisableRealmRepos, input DisableRealmInput) (entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "disable-realm",
+		"realm-id": input.RealmID,
+	})
+
+	transitionRepos := statusTransitionRepos{
+		Clock:           repos.Clock,
+		Repository:      repos.Repository,
+		AuditRepository: repos.AuditRepository,
+	}
+
+	if !input.Cascade {
+		if childErr := checkNoChildRealms(
+			ctx,
+			logger,
+			repos.Repository,
+			input.RealmID,
+			"disabling",
+			entities.StatusActive,
+		); childErr != nil {
+			return entities.Realm{}, childErr
+		}
+	}
+
+	realm, err := transitionRealmStatus(ctx, logger, transitionRepos, input.RealmID, input.Reason, disableTransition)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	if input.Cascade {
+		if cascadeErr := r.disableDescendants(ctx, logger, repos, transitionRepos, input); cascadeErr != nil {
+			return entities.Realm{}, cascadeErr
+		}
+	}
+
+	return realm, nil
+}
+
+// disableDescendants disables the active descendants of the realm top-down, descendants
+// that are not active are left as they are.
+func (r *DisableRealm) disableDescendants(
+	ctx context.Context,
+	logger logging.Logger,
+	repos DisableRealmRepos,
+	transitionRepos statusTransitionRepos,
+	input DisableRealmInput,
+) error {
+	levels, err := r.hierarchy.descendants(ctx, logger, repos.Repository, input.RealmID)
+	if err != nil {
+		return err
+	}
+
+	for _, level := range levels {
+		descendants, getErr := repos.Repository.GetRealms(ctx, level, entities.StatusActive)
+		if getErr != nil {
+			logger.WithError(getErr).Error("failed to get descendant realms from repository")
+			return realmmgr_errors.NewInternalError("failed to get descendant realms from repository", nil)
+		}
+
+		for _, descendant := range descendants {
+			descendantLogger := logger.WithField("descendant-id", descendant.ID)
+			if _, transitionErr := transitionRealmStatus(
+				ctx,
+				descendantLogger,
+				transitionRepos,
+				descendant.ID,
+				input.Reason,
+				disableTransition,
+			); transitionErr != nil {
+				return transitionErr
+			}
+		}
+	}
+
+	return nil
+}
+
+var disableTransition = statusTransition{
+	from:   entities.StatusActive,
+	to:     entities.StatusDisabled,
+	action: entities.AuditActionDisable,
+	event:  entities.RealmEventTypeDisabled,
+}
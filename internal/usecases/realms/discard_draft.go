@@ -0,0 +1,142 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type DiscardDraftInput struct {
+	RealmID uuid.UUID
+	// DeleteRealm allows discarding the draft of a realm that was never released,
+	// which deletes the realm altogether
+	DeleteRealm bool
+}
+
+func (i *DiscardDraftInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type DiscardDraftRepos struct {
+	Logger logging.Logger
+	Clock  clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *DiscardDraftRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type DiscardDraft struct {
+}
+
+func NewDiscardDraft() *DiscardDraft {
+	return &DiscardDraft{}
+}
+
+// DiscardDraft removes the pending draft of the realm, leaving its released copy untouched.
+func (r *DiscardDraft) DiscardDraft(ctx context.Context, repos DiscardDraftRepos, input DiscardDraftInput) error {
+	if err := repos.Validate(); err != nil {
+		return err
+	}
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":     "discard-draft",
+		"realm-id":     input.RealmID,
+		"delete-realm": input.DeleteRealm,
+	})
+
+	if _, err := repos.Repository.GetRealm(ctx, input.RealmID, entities.StatusDraft); err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("draft of realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get draft realm from repository")
+			return realmmgr_errors.NewInternalError("failed to get draft realm from repository", nil)
+		}
+	}
+
+	released, err := isRealmReleased(ctx, repos.Repository, input.RealmID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get released realm from repository")
+		return realmmgr_errors.NewInternalError("failed to get released realm from repository", nil)
+	}
+	if !released && !input.DeleteRealm {
+		return realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("realm with ID %s was never released, discarding its draft would delete the realm", input.RealmID),
+			nil,
+		)
+	}
+
+	if err := repos.Repository.DeleteRealm(ctx, input.RealmID, entities.StatusDraft); err != nil {
+		logger.WithError(err).Error("failed to delete draft realm from repository")
+		return realmmgr_errors.NewInternalError("failed to delete draft realm from repository", nil)
+	}
+
+	// the realm is gone when it was never released, so its slug can be taken by other realms
+	if !released {
+		if err := deleteRealmSlugs(ctx, logger, repos.Repository, input.RealmID); err != nil {
+			return err
+		}
+	}
+
+	if err := recordRealmEvent(
+		ctx,
+		logger,
+		repos.Repository,
+		input.RealmID,
+		entities.RealmEventTypeDeleted,
+		entities.StatusDraft,
+		repos.Clock.Now(),
+	); err != nil {
+		return err
+	}
+
+	if err := deleteReleaseSchedule(ctx, logger, repos.Repository, input.RealmID); err != nil {
+		return err
+	}
+
+	return deleteReleaseApproval(ctx, logger, repos.Repository, input.RealmID)
+}
+
+// isRealmReleased reports whether the realm has an active or disabled copy.
+func isRealmReleased(ctx context.Context, repository repositories.RealmManagerRepository, realmID uuid.UUID) (bool, error) {
+	for _, status := range []entities.Status{entities.StatusActive, entities.StatusDisabled} {
+		_, err := repository.GetRealm(ctx, realmID, status)
+		switch err.(type) {
+		case nil:
+			return true, nil
+		case *realmmgr_errors.NotFoundError:
+			continue
+		default:
+			return false, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,87 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type EnableRealmInput struct {
+	RealmID uuid.UUID
+	Reason  string
+}
+
+func (i *EnableRealmInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.Reason == "" {
+		return realmmgr_errors.NewInvalidArgumentError("reason", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type EnableRealmRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository      repositories.RealmManagerRepository
+	AuditRepository repositories.RealmManagerAuditRepository
+}
+
+func (r *EnableRealmRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.AuditRepository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("auditRepository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type EnableRealm struct {
+}
+
+func NewEnableRealm() *EnableRealm {
+	return &EnableRealm{}
+}
+
+func (r *EnableRealm) EnableRealm(ctx context.Context, repos EnableRealmRepos, input EnableRealmInput) (entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "enable-realm",
+		"realm-id": input.RealmID,
+	})
+
+	transitionRepos := statusTransitionRepos{
+		Clock:           repos.Clock,
+		Repository:      repos.Repository,
+		AuditRepository: repos.AuditRepository,
+	}
+
+	return transitionRealmStatus(ctx, logger, transitionRepos, input.RealmID, input.Reason, statusTransition{
+		from:   entities.StatusDisabled,
+		to:     entities.StatusActive,
+		action: entities.AuditActionEnable,
+		event:  entities.RealmEventTypeUpdated,
+	})
+}
@@ -0,0 +1,262 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	DefaultExportRealmsPageSize = 100
+	MaxExportRealmsPageSize     = 1000
+)
+
+// exportStatuses are the statuses realms are exported by, in order. Drafts come last and are
+// only exported on their own for realms that were never released, any other draft is exported
+// together with the released copy of its realm.
+var exportStatuses = []entities.Status{
+	entities.StatusActive,
+	entities.StatusDisabled,
+	entities.StatusDraft,
+}
+
+type ExportRealmsInput struct {
+	// IncludeDrafts exports the drafts of realms as well, including realms that were never
+	// released
+	IncludeDrafts bool
+	PageSize      int
+	PageToken     string
+}
+
+func (i *ExportRealmsInput) Validate() error {
+	if i.PageSize < 0 || i.PageSize > MaxExportRealmsPageSize {
+		return realmmgr_errors.NewInvalidArgumentError("pageSize", "must be between 0 and 1000")
+	}
+	return nil
+}
+
+type ExportRealmsRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ExportRealmsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ExportRealms struct {
+}
+
+func NewExportRealms() *ExportRealms {
+	return &ExportRealms{}
+}
+
+// ExportRealms returns a page of export records, one for every realm that is not deleted.
+// Realms are exported by status and in the order they were created, so a realm created while
+// the export is running may or may not be part of it. Pages may be empty while the next page
+// token is set, the export is complete once no next page token is returned.
+func (r *ExportRealms) ExportRealms(
+	ctx context.Context,
+	repos ExportRealmsRepos,
+	input ExportRealmsInput,
+) (entities.RealmExportPage, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmExportPage{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmExportPage{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":       "export-realms",
+		"include-drafts": input.IncludeDrafts,
+	})
+
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultExportRealmsPageSize
+	}
+
+	token := exportPageToken{
+		Status:        exportStatuses[0],
+		IncludeDrafts: input.IncludeDrafts,
+	}
+	if input.PageToken != "" {
+		decoded, err := decodeExportPageToken(input.PageToken)
+		if err != nil || exportStatusIndex(decoded.Status, decoded.IncludeDrafts) < 0 {
+			logger.WithError(err).Info("failed to decode page token")
+			return entities.RealmExportPage{}, realmmgr_errors.NewInvalidArgumentError("pageToken", "is malformed")
+		}
+		if decoded.IncludeDrafts != input.IncludeDrafts {
+			return entities.RealmExportPage{}, realmmgr_errors.NewInvalidArgumentError(
+				"pageToken",
+				"does not match the drafts option of the request",
+			)
+		}
+		token = decoded
+	}
+
+	realms, err := repos.Repository.ListRealms(ctx, entities.ListRealmsOptions{
+		Status: token.Status,
+		Sorting: entities.RealmSorting{
+			Field:     entities.SortFieldCreatedAt,
+			Direction: entities.SortDirectionAsc,
+		},
+		After: token.Cursor,
+		// fetch one extra realm to find out whether another page of the status follows
+		Limit: pageSize + 1,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to list realms from repository")
+		return entities.RealmExportPage{}, realmmgr_errors.NewInternalError("failed to list realms from repository", nil)
+	}
+
+	hasMore := len(realms) > pageSize
+	if hasMore {
+		realms = realms[:pageSize]
+	}
+
+	var records []entities.RealmExportRecord
+	if token.Status == entities.StatusDraft {
+		records, err = r.draftRecords(ctx, logger, repos.Repository, realms)
+	} else {
+		records, err = r.releasedRecords(ctx, logger, repos.Repository, realms, input.IncludeDrafts)
+	}
+	if err != nil {
+		return entities.RealmExportPage{}, err
+	}
+
+	if hasMore {
+		cursor := entities.CursorFromRealm(realms[len(realms)-1])
+		token.Cursor = &cursor
+	} else {
+		next := exportStatusIndex(token.Status, input.IncludeDrafts) + 1
+		if next >= exportStatusCount(input.IncludeDrafts) {
+			return entities.RealmExportPage{Records: records}, nil
+		}
+		token = exportPageToken{
+			Status:        exportStatuses[next],
+			IncludeDrafts: input.IncludeDrafts,
+		}
+	}
+
+	nextPageToken, err := encodeExportPageToken(token)
+	if err != nil {
+		logger.WithError(err).Error("failed to encode next page token")
+		return entities.RealmExportPage{}, realmmgr_errors.NewInternalError("failed to encode next page token", nil)
+	}
+
+	return entities.RealmExportPage{
+		Records:       records,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// releasedRecords makes a record of every released realm, with the draft of the realm added
+// when drafts are exported.
+func (r *ExportRealms) releasedRecords(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realms []entities.Realm,
+	includeDrafts bool,
+) ([]entities.RealmExportRecord, error) {
+	drafts := make(map[uuid.UUID]entities.Realm)
+	if includeDrafts && len(realms) > 0 {
+		draftRealms, err := repository.GetRealms(ctx, realmIDs(realms), entities.StatusDraft)
+		if err != nil {
+			logger.WithError(err).Error("failed to get realm drafts from repository")
+			return nil, realmmgr_errors.NewInternalError("failed to get realm drafts from repository", nil)
+		}
+		for _, draft := range draftRealms {
+			drafts[draft.ID] = draft
+		}
+	}
+
+	records := make([]entities.RealmExportRecord, 0, len(realms))
+	for i := range realms {
+		record := entities.RealmExportRecord{
+			FormatVersion: entities.RealmExportFormatVersion,
+			Released:      &realms[i],
+		}
+		if draft, ok := drafts[realms[i].ID]; ok {
+			record.Draft = &draft
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// draftRecords makes a record of every draft whose realm was never released, drafts of
+// released realms are part of the records of their released copies.
+func (r *ExportRealms) draftRecords(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	drafts []entities.Realm,
+) ([]entities.RealmExportRecord, error) {
+	released := make(map[uuid.UUID]struct{})
+	if len(drafts) > 0 {
+		for _, status := range []entities.Status{entities.StatusActive, entities.StatusDisabled} {
+			realms, err := repository.GetRealms(ctx, realmIDs(drafts), status)
+			if err != nil {
+				logger.WithError(err).Error("failed to get released realms from repository")
+				return nil, realmmgr_errors.NewInternalError("failed to get released realms from repository", nil)
+			}
+			for _, realm := range realms {
+				released[realm.ID] = struct{}{}
+			}
+		}
+	}
+
+	records := make([]entities.RealmExportRecord, 0, len(drafts))
+	for i := range drafts {
+		if _, ok := released[drafts[i].ID]; ok {
+			continue
+		}
+		records = append(records, entities.RealmExportRecord{
+			FormatVersion: entities.RealmExportFormatVersion,
+			Draft:         &drafts[i],
+		})
+	}
+	return records, nil
+}
+
+// exportStatusCount returns the number of statuses realms are exported by.
+func exportStatusCount(includeDrafts bool) int {
+	if includeDrafts {
+		return len(exportStatuses)
+	}
+	return len(exportStatuses) - 1
+}
+
+// exportStatusIndex returns the position of status in the order realms are exported by, or
+// -1 when realms are not exported by status.
+func exportStatusIndex(status entities.Status, includeDrafts bool) int {
+	for i := 0; i < exportStatusCount(includeDrafts); i++ {
+		if exportStatuses[i] == status {
+			return i
+		}
+	}
+	return -1
+}
+
+func realmIDs(realms []entities.Realm) []uuid.UUID {
+	ids := make([]uuid.UUID, len(realms))
+	for i, realm := range realms {
+		ids[i] = realm.ID
+	}
+	return ids
+}
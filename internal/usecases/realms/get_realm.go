@@ -15,6 +15,9 @@ import (
 type GetRealmInput struct {
 	RealmID uuid.UUID
 	Status  entities.Status
+	// View selects whether labels and attributes inherited from ancestors are applied,
+	// the realm is returned as stored when zero
+	View entities.RealmView
 }
 
 func (i *GetRealmInput) Validate() error {
@@ -34,10 +37,18 @@ func (r *GetRealmRepos) Validate() error {
 }
 
 type GetRealm struct {
+	hierarchy *RealmHierarchy
 }
 
-func NewGetRealm() *GetRealm {
-	return &GetRealm{}
+// NewGetRealm creates the use case getting realms, with the effective view of a realm
+// built from its ancestors in hierarchy.
+func NewGetRealm(hierarchy *RealmHierarchy) (*GetRealm, error) {
+	if hierarchy == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("hierarchy", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &GetRealm{
+		hierarchy: hierarchy,
+	}, nil
 }
 
 func (r *GetRealm) GetRealm(ctx context.Context, repos GetRealmRepos, input GetRealmInput) (entities.Realm, error) {
@@ -75,5 +86,26 @@ func (r *GetRealm) GetRealm(ctx context.Context, repos GetRealmRepos, input GetR
 		)
 	}
 
+	// a draft may be scheduled for release, which is reported as part of the realm
+	if realm.Status == entities.StatusDraft {
+		schedule, scheduleErr := repos.Repository.GetReleaseSchedule(ctx, input.RealmID)
+		switch scheduleErr.(type) {
+		case nil:
+			realm.ReleaseSchedule = &schedule
+		case *realmmgr_errors.NotFoundError:
+		default:
+			logger.WithError(scheduleErr).Error("failed to get release schedule from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get release schedule from repository", nil)
+		}
+	}
+
+	if input.View == entities.RealmViewEffective {
+		ancestors, ancestorsErr := r.hierarchy.ancestors(ctx, logger, repos.Repository, realm)
+		if ancestorsErr != nil {
+			return entities.Realm{}, ancestorsErr
+		}
+		realm = realm.Effective(ancestors)
+	}
+
 	return realm, nil
 }
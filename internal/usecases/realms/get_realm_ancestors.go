@@ -0,0 +1,94 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type GetRealmAncestorsInput struct {
+	RealmID uuid.UUID
+	Status  entities.Status
+}
+
+func (i *GetRealmAncestorsInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.Status == entities.StatusDeleted {
+		return realmmgr_errors.NewInvalidArgumentError("status", "cannot be deleted")
+	}
+	return nil
+}
+
+type GetRealmAncestorsRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *GetRealmAncestorsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type GetRealmAncestors struct {
+	hierarchy *RealmHierarchy
+}
+
+// NewGetRealmAncestors creates the use case listing the ancestors of realms in hierarchy.
+func NewGetRealmAncestors(hierarchy *RealmHierarchy) (*GetRealmAncestors, error) {
+	if hierarchy == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("hierarchy", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &GetRealmAncestors{
+		hierarchy: hierarchy,
+	}, nil
+}
+
+// GetRealmAncestors lists the ancestors of the realm in the given status, ordered from its
+// direct parent up to the root. Root realms have no ancestors.
+func (r *GetRealmAncestors) GetRealmAncestors(
+	ctx context.Context,
+	repos GetRealmAncestorsRepos,
+	input GetRealmAncestorsInput,
+) ([]entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return nil, err
+	}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "get-realm-ancestors",
+		"realm-id": input.RealmID,
+	})
+
+	realm, err := repos.Repository.GetRealm(ctx, input.RealmID, input.Status)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return nil, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get realm from repository")
+			return nil, realmmgr_errors.NewInternalError("failed to get realm from repository", nil)
+		}
+	}
+
+	return r.hierarchy.ancestors(ctx, logger, repos.Repository, realm)
+}
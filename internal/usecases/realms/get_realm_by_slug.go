@@ -0,0 +1,124 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type GetRealmBySlugInput struct {
+	// Slug is either the current slug of the realm or a redirect that has not expired yet
+	Slug   string
+	Status entities.Status
+	View   entities.RealmView
+}
+
+func (i *GetRealmBySlugInput) Validate() error {
+	if i.Slug == "" {
+		return realmmgr_errors.NewInvalidArgumentError("slug", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type GetRealmBySlugRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *GetRealmBySlugRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type GetRealmBySlug struct {
+	getter *GetRealm
+}
+
+// NewGetRealmBySlug creates the use case getting realms by slug, the realm a slug resolves to
+// is returned the same way as by getter.
+func NewGetRealmBySlug(getter *GetRealm) (*GetRealmBySlug, error) {
+	if getter == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("getter", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &GetRealmBySlug{
+		getter: getter,
+	}, nil
+}
+
+// GetRealmBySlug resolves the slug to a realm. Callers can tell that a redirect was followed
+// by the slug of the returned realm differing from the requested one.
+func (r *GetRealmBySlug) GetRealmBySlug(
+	ctx context.Context,
+	repos GetRealmBySlugRepos,
+	input GetRealmBySlugInput,
+) (entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "get-realm-by-slug",
+		"slug":     input.Slug,
+	})
+
+	realmSlug, err := repos.Repository.GetRealmSlug(ctx, input.Slug)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.Realm{}, realmSlugNotFoundError(input.Slug)
+		default:
+			logger.WithError(err).Error("failed to get realm slug from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get realm slug from repository", nil)
+		}
+	}
+
+	if realmSlug.IsExpired(repos.Clock.Now()) {
+		return entities.Realm{}, realmSlugNotFoundError(input.Slug)
+	}
+
+	realm, err := r.getter.GetRealm(
+		ctx,
+		GetRealmRepos{
+			Logger:     logger,
+			Repository: repos.Repository,
+		},
+		GetRealmInput{
+			RealmID: realmSlug.RealmID,
+			Status:  input.Status,
+			View:    input.View,
+		},
+	)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.Realm{}, realmSlugNotFoundError(input.Slug)
+		default:
+			return entities.Realm{}, err
+		}
+	}
+
+	return realm, nil
+}
+
+func realmSlugNotFoundError(slug string) error {
+	return realmmgr_errors.NewNotFoundError(fmt.Sprintf("realm with slug %s not found", slug), nil)
+}
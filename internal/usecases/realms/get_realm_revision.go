@@ -0,0 +1,98 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type GetRealmRevisionInput struct {
+	RealmID uuid.UUID
+	// Revision selects a revision by its number
+	Revision int64
+	// AsOf selects the revision that was released at the given point in time
+	AsOf time.Time
+}
+
+func (i *GetRealmRevisionInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.Revision < 0 {
+		return realmmgr_errors.NewInvalidArgumentError("revision", "must be positive")
+	}
+	if (i.Revision == 0) == i.AsOf.IsZero() {
+		return realmmgr_errors.NewInvalidArgumentError("revision", "or asOf must be provided exclusively")
+	}
+	return nil
+}
+
+type GetRealmRevisionRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *GetRealmRevisionRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type GetRealmRevision struct {
+}
+
+func NewGetRealmRevision() *GetRealmRevision {
+	return &GetRealmRevision{}
+}
+
+func (r *GetRealmRevision) GetRealmRevision(
+	ctx context.Context,
+	repos GetRealmRevisionRepos,
+	input GetRealmRevisionInput,
+) (entities.RealmRevision, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmRevision{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmRevision{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "get-realm-revision",
+		"realm-id": input.RealmID,
+	})
+
+	var revision entities.RealmRevision
+	var err error
+	if input.Revision > 0 {
+		revision, err = repos.Repository.GetRealmRevision(ctx, input.RealmID, input.Revision)
+	} else {
+		revision, err = repos.Repository.GetRealmRevisionAt(ctx, input.RealmID, input.AsOf)
+	}
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.RealmRevision{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("revision of realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get realm revision from repository")
+			return entities.RealmRevision{}, realmmgr_errors.NewInternalError("failed to get realm revision from repository", nil)
+		}
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,229 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	DefaultStaleDraftDays = 30
+	MaxStaleDraftDays     = 3650
+
+	// DefaultRealmStatsDays is the number of days realms created per day are counted for,
+	// unless the stats are limited to realms created after a point in time
+	DefaultRealmStatsDays = 30
+	// MaxRealmStatsDays is the number of days realms created per day can be counted for
+	MaxRealmStatsDays = 366
+
+	// maxCachedRealmStats is the number of distinct filters stats are cached for
+	maxCachedRealmStats = 100
+
+	day = 24 * time.Hour
+)
+
+type GetRealmStatsInput struct {
+	// LabelSelector limits the stats to realms matching it, e.g. "env=prod,region in (eu,us)"
+	LabelSelector string
+	// CreatedAfter and CreatedBefore limit the stats to realms created within the range,
+	// either of them is zero for a range that is open on that side
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// StaleDraftDays is the number of days without updates after which drafts are stale,
+	// DefaultStaleDraftDays when zero
+	StaleDraftDays int
+}
+
+func (i *GetRealmStatsInput) Validate() error {
+	if i.StaleDraftDays < 0 || i.StaleDraftDays > MaxStaleDraftDays {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"staleDraftDays",
+			fmt.Sprintf("must be between 0 and %d", MaxStaleDraftDays),
+		)
+	}
+	if !i.CreatedAfter.IsZero() && !i.CreatedBefore.IsZero() && !i.CreatedAfter.Before(i.CreatedBefore) {
+		return realmmgr_errors.NewInvalidArgumentError("createdBefore", "must be after createdAfter")
+	}
+	return nil
+}
+
+type GetRealmStatsRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *GetRealmStatsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+// realmStatsKey identifies the filters stats were computed for in the cache.
+type realmStatsKey struct {
+	labelSelector  string
+	createdAfter   int64
+	createdBefore  int64
+	staleDraftDays int
+}
+
+type cachedRealmStats struct {
+	stats     entities.RealmStats
+	expiresAt time.Time
+}
+
+type GetRealmStats struct {
+	cacheTTL time.Duration
+
+	mutex sync.Mutex
+	cache map[realmStatsKey]cachedRealmStats
+}
+
+// NewGetRealmStats creates the use case aggregating realm stats. Stats are cached in process
+// for cacheTTL per filter, so dashboards polling them do not query the database on every
+// call. A zero cacheTTL disables the cache.
+func NewGetRealmStats(cacheTTL time.Duration) (*GetRealmStats, error) {
+	if cacheTTL < 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("cacheTTL", "cannot be negative")
+	}
+	return &GetRealmStats{
+		cacheTTL: cacheTTL,
+		cache:    make(map[realmStatsKey]cachedRealmStats),
+	}, nil
+}
+
+// GetRealmStats counts the realms matching the input by status, the drafts among them that
+// are stale and the realms created per day. Realms created per day are counted within the
+// creation range of the input, or for the last DefaultRealmStatsDays days when the range is
+// open at its start, which cannot span more than MaxRealmStatsDays days.
+func (r *GetRealmStats) GetRealmStats(
+	ctx context.Context,
+	repos GetRealmStatsRepos,
+	input GetRealmStatsInput,
+) (entities.RealmStats, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmStats{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmStats{}, err
+	}
+
+	logger := repos.Logger.WithField("use-case", "get-realm-stats")
+
+	labelSelector, err := parseLabelSelector(input.LabelSelector)
+	if err != nil {
+		return entities.RealmStats{}, err
+	}
+
+	staleDraftDays := input.StaleDraftDays
+	if staleDraftDays == 0 {
+		staleDraftDays = DefaultStaleDraftDays
+	}
+
+	now := repos.Clock.Now()
+
+	dailyTo := input.CreatedBefore
+	if dailyTo.IsZero() {
+		dailyTo = now
+	}
+	dailyFrom := input.CreatedAfter
+	if dailyFrom.IsZero() {
+		dailyFrom = dailyTo.Add(-DefaultRealmStatsDays * day).Truncate(day)
+	}
+	if dailyTo.Sub(dailyFrom) > MaxRealmStatsDays*day {
+		return entities.RealmStats{}, realmmgr_errors.NewInvalidArgumentError(
+			"createdAfter",
+			fmt.Sprintf("must be at most %d days before createdBefore", MaxRealmStatsDays),
+		)
+	}
+
+	key := realmStatsKey{
+		labelSelector:  input.LabelSelector,
+		createdAfter:   unixNanoOrZero(input.CreatedAfter),
+		createdBefore:  unixNanoOrZero(input.CreatedBefore),
+		staleDraftDays: staleDraftDays,
+	}
+	if stats, ok := r.cachedStats(key, now); ok {
+		return stats, nil
+	}
+
+	stats, err := repos.Repository.GetRealmStats(ctx, entities.RealmStatsOptions{
+		LabelSelector:     labelSelector,
+		CreatedAfter:      input.CreatedAfter,
+		CreatedBefore:     input.CreatedBefore,
+		StaleDraftsBefore: now.Add(-time.Duration(staleDraftDays) * day),
+		DailyFrom:         dailyFrom,
+		DailyTo:           dailyTo,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to get realm stats from repository")
+		return entities.RealmStats{}, realmmgr_errors.NewInternalError("failed to get realm stats from repository", nil)
+	}
+	stats.GeneratedAt = now
+
+	r.cacheStats(key, stats, now)
+
+	return stats, nil
+}
+
+// cachedStats returns the stats cached for key, unless they expired at now.
+func (r *GetRealmStats) cachedStats(key realmStatsKey, now time.Time) (entities.RealmStats, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	cached, ok := r.cache[key]
+	if !ok || !now.Before(cached.expiresAt) {
+		return entities.RealmStats{}, false
+	}
+	return cached.stats, true
+}
+
+// cacheStats caches the stats for key. Expired stats are evicted once the cache is full, and
+// the whole cache is dropped when that does not free any space.
+func (r *GetRealmStats) cacheStats(key realmStatsKey, stats entities.RealmStats, now time.Time) {
+	if r.cacheTTL == 0 {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if len(r.cache) >= maxCachedRealmStats {
+		for cachedKey, cached := range r.cache {
+			if !now.Before(cached.expiresAt) {
+				delete(r.cache, cachedKey)
+			}
+		}
+		if len(r.cache) >= maxCachedRealmStats {
+			r.cache = make(map[realmStatsKey]cachedRealmStats)
+		}
+	}
+
+	r.cache[key] = cachedRealmStats{
+		stats:     stats,
+		expiresAt: now.Add(r.cacheTTL),
+	}
+}
+
+func unixNanoOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
@@ -0,0 +1,71 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type GetRealmTemplateInput struct {
+	TemplateID uuid.UUID
+	// Version of the template to get, the latest version is returned when zero
+	Version int64
+}
+
+func (i *GetRealmTemplateInput) Validate() error {
+	if i.TemplateID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("templateID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.Version < 0 {
+		return realmmgr_errors.NewInvalidArgumentError("version", "cannot be negative")
+	}
+	return nil
+}
+
+type GetRealmTemplateRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *GetRealmTemplateRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type GetRealmTemplate struct {
+}
+
+func NewGetRealmTemplate() *GetRealmTemplate {
+	return &GetRealmTemplate{}
+}
+
+func (r *GetRealmTemplate) GetRealmTemplate(
+	ctx context.Context,
+	repos GetRealmTemplateRepos,
+	input GetRealmTemplateInput,
+) (entities.RealmTemplate, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmTemplate{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmTemplate{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":    "get-realm-template",
+		"template-id": input.TemplateID,
+	})
+
+	return getLiveRealmTemplate(ctx, logger, repos.Repository, input.TemplateID, input.Version)
+}
@@ -0,0 +1,234 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+// hierarchyStatuses are the statuses a realm is looked up in when walking the hierarchy,
+// in order of preference. The active realm defines the hierarchy once it was released.
+var hierarchyStatuses = []entities.Status{
+	entities.StatusActive,
+	entities.StatusDisabled,
+	entities.StatusDraft,
+}
+
+// RealmHierarchy enforces the shape of the realm hierarchy shared by the use cases that
+// change or read the parent of a realm.
+type RealmHierarchy struct {
+	maxDepth int
+}
+
+// NewRealmHierarchy creates a realm hierarchy that is at most maxDepth levels deep, root
+// realms are on the first level.
+func NewRealmHierarchy(maxDepth int) (*RealmHierarchy, error) {
+	if maxDepth < 1 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("maxDepth", "must be positive")
+	}
+	return &RealmHierarchy{
+		maxDepth: maxDepth,
+	}, nil
+}
+
+// checkParent verifies that the realm can be moved under parentID without creating a
+// cycle or growing the hierarchy beyond its maximum depth. realmID is uuid.Nil for realms
+// that are being created.
+func (h *RealmHierarchy) checkParent(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+	parentID uuid.UUID,
+) error {
+	if parentID == uuid.Nil {
+		return nil
+	}
+	if parentID == realmID {
+		return realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("realm with ID %s cannot be its own parent", realmID),
+			nil,
+		)
+	}
+
+	parent, found, err := getHierarchyRealm(ctx, logger, repository, parentID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"parentId",
+			fmt.Sprintf("references realm %s which does not exist", parentID),
+		)
+	}
+
+	ancestors, err := h.ancestors(ctx, logger, repository, parent)
+	if err != nil {
+		return err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ID == realmID {
+			return realmmgr_errors.NewFailedPreconditionError(
+				fmt.Sprintf("parent realm with ID %s is a descendant of realm with ID %s", parentID, realmID),
+				nil,
+			)
+		}
+	}
+
+	// the realm is placed one level below its parent and takes its descendants along
+	depth := len(ancestors) + 2
+	if realmID != uuid.Nil {
+		levels, levelsErr := h.descendants(ctx, logger, repository, realmID)
+		if levelsErr != nil {
+			return levelsErr
+		}
+		depth += len(levels)
+	}
+
+	if depth > h.maxDepth {
+		return realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("realm hierarchy cannot be deeper than %d levels", h.maxDepth),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// ancestors lists the ancestors of the realm from its direct parent up to the root. The
+// walk stops at parents that no longer exist and never goes beyond the maximum depth.
+func (h *RealmHierarchy) ancestors(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realm entities.Realm,
+) ([]entities.Realm, error) {
+	ancestors := make([]entities.Realm, 0)
+	visited := map[uuid.UUID]struct{}{realm.ID: {}}
+
+	parentID := realm.ParentID
+	for parentID != uuid.Nil && len(ancestors) < h.maxDepth {
+		if _, ok := visited[parentID]; ok {
+			// hierarchy changes are checked for cycles, this only guards against broken data
+			logger.WithField("parent-id", parentID).Warn("cycle detected in realm hierarchy")
+			break
+		}
+		visited[parentID] = struct{}{}
+
+		parent, found, err := getHierarchyRealm(ctx, logger, repository, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			break
+		}
+
+		ancestors = append(ancestors, parent)
+		parentID = parent.ParentID
+	}
+
+	return ancestors, nil
+}
+
+// descendants lists the IDs of the descendants of the realm level by level, starting with
+// its direct children. Deleted realms are left out.
+func (h *RealmHierarchy) descendants(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+) ([][]uuid.UUID, error) {
+	levels := make([][]uuid.UUID, 0)
+	visited := map[uuid.UUID]struct{}{realmID: {}}
+
+	parentIDs := []uuid.UUID{realmID}
+	for len(parentIDs) > 0 && len(levels) < h.maxDepth {
+		childIDs, err := repository.ListRealmChildIDs(ctx, parentIDs)
+		if err != nil {
+			logger.WithError(err).Error("failed to list child realms from repository")
+			return nil, realmmgr_errors.NewInternalError("failed to list child realms from repository", nil)
+		}
+
+		level := make([]uuid.UUID, 0, len(childIDs))
+		for _, childID := range childIDs {
+			if _, ok := visited[childID]; ok {
+				continue
+			}
+			visited[childID] = struct{}{}
+			level = append(level, childID)
+		}
+		if len(level) == 0 {
+			break
+		}
+
+		levels = append(levels, level)
+		parentIDs = level
+	}
+
+	return levels, nil
+}
+
+// getHierarchyRealm looks up the version of the realm that defines its place in the
+// hierarchy, found is false when the realm does not exist or was deleted.
+func getHierarchyRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+) (entities.Realm, bool, error) {
+	for _, status := range hierarchyStatuses {
+		realm, err := repository.GetRealm(ctx, realmID, status)
+		switch err.(type) {
+		case nil:
+			return realm, true, nil
+		case *realmmgr_errors.NotFoundError:
+			continue
+		default:
+			logger.WithError(err).Error("failed to get realm from repository")
+			return entities.Realm{}, false, realmmgr_errors.NewInternalError("failed to get realm from repository", nil)
+		}
+	}
+	return entities.Realm{}, false, nil
+}
+
+// checkNoChildRealms rejects changes to realms that still have child realms in any of the
+// given statuses unless they are cascaded.
+func checkNoChildRealms(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+	action string,
+	statuses ...entities.Status,
+) error {
+	childIDs, err := repository.ListRealmChildIDs(ctx, []uuid.UUID{realmID})
+	if err != nil {
+		logger.WithError(err).Error("failed to list child realms from repository")
+		return realmmgr_errors.NewInternalError("failed to list child realms from repository", nil)
+	}
+	if len(childIDs) == 0 {
+		return nil
+	}
+
+	for _, status := range statuses {
+		children, getErr := repository.GetRealms(ctx, childIDs, status)
+		if getErr != nil {
+			logger.WithError(getErr).Error("failed to get child realms from repository")
+			return realmmgr_errors.NewInternalError("failed to get child realms from repository", nil)
+		}
+		if len(children) > 0 {
+			return realmmgr_errors.NewFailedPreconditionError(
+				fmt.Sprintf("realm with ID %s has child realms, %s it requires cascade", realmID, action),
+				nil,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,409 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/attributeschema"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+// MaxRealmNameLength is the length of the longest realm name that can be stored.
+const MaxRealmNameLength = 50
+
+type ImportRealmsInput struct {
+	Records        []entities.RealmExportRecord
+	ConflictPolicy entities.ImportConflictPolicy
+}
+
+func (i *ImportRealmsInput) Validate() error {
+	switch i.ConflictPolicy {
+	case entities.ImportConflictPolicyFail, entities.ImportConflictPolicySkip, entities.ImportConflictPolicyOverwrite:
+		return nil
+	default:
+		return realmmgr_errors.NewInvalidArgumentError("conflictPolicy", fmt.Sprintf("has unexpected value %d", i.ConflictPolicy))
+	}
+}
+
+type ImportRealmsRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ImportRealmsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ImportRealms struct {
+	attributeValidator attributeschema.Validator
+}
+
+// NewImportRealms creates the use case importing exported realms, with the attributes of
+// imported realms checked by attributeValidator.
+func NewImportRealms(attributeValidator attributeschema.Validator) (*ImportRealms, error) {
+	if attributeValidator == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("attributeValidator", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &ImportRealms{
+		attributeValidator: attributeValidator,
+	}, nil
+}
+
+// ImportRealms imports the records in order and returns the result of every record. Invalid
+// records, and records whose slug is taken by another realm, are rejected without affecting
+// the other records. Realms that already exist in any status, including deleted, are handled
+// by the conflict policy of the input.
+//
+// Under the fail policy the first realm that already exists ends the import with a failed
+// precondition error, returned together with the results up to and including the rejected
+// record. The changes made for the records before it must not be committed then.
+//
+// Parent references are imported as they are, so records can be imported in any order.
+func (r *ImportRealms) ImportRealms(
+	ctx context.Context,
+	repos ImportRealmsRepos,
+	input ImportRealmsInput,
+) ([]entities.RealmImportResult, error) {
+	if err := repos.Validate(); err != nil {
+		return nil, err
+	}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":        "import-realms",
+		"conflict-policy": input.ConflictPolicy,
+	})
+
+	existingIDs, err := r.existingRealmIDs(ctx, logger, repos.Repository, input.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]entities.RealmImportResult, 0, len(input.Records))
+	for _, record := range input.Records {
+		realmID := record.RealmID()
+		recordLogger := logger.WithField("realm-id", realmID)
+
+		_, exists := existingIDs[realmID]
+		if exists && input.ConflictPolicy == entities.ImportConflictPolicySkip {
+			results = append(results, entities.RealmImportResult{
+				RealmID: realmID,
+				Outcome: entities.RealmImportOutcomeSkipped,
+			})
+			continue
+		}
+		if exists && input.ConflictPolicy == entities.ImportConflictPolicyFail {
+			conflictErr := realmmgr_errors.NewFailedPreconditionError(
+				fmt.Sprintf("realm with ID %s already exists", realmID),
+				nil,
+			)
+			results = append(results, entities.RealmImportResult{
+				RealmID: realmID,
+				Outcome: entities.RealmImportOutcomeRejected,
+				Err:     conflictErr,
+			})
+			return results, conflictErr
+		}
+
+		importErr := r.importRealm(ctx, recordLogger, repos, record, exists)
+		switch importErr.(type) {
+		case nil:
+			outcome := entities.RealmImportOutcomeCreated
+			if exists {
+				outcome = entities.RealmImportOutcomeUpdated
+			}
+			results = append(results, entities.RealmImportResult{
+				RealmID: realmID,
+				Outcome: outcome,
+			})
+			existingIDs[realmID] = struct{}{}
+		case *realmmgr_errors.InvalidArgumentError, *realmmgr_errors.FailedPreconditionError:
+			recordLogger.WithError(importErr).Info("realm import rejected")
+			results = append(results, entities.RealmImportResult{
+				RealmID: realmID,
+				Outcome: entities.RealmImportOutcomeRejected,
+				Err:     importErr,
+			})
+		default:
+			return results, importErr
+		}
+	}
+
+	return results, nil
+}
+
+// existingRealmIDs looks up which realms of the records already exist.
+func (r *ImportRealms) existingRealmIDs(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	records []entities.RealmExportRecord,
+) (map[uuid.UUID]struct{}, error) {
+	realmIDs := make([]uuid.UUID, 0, len(records))
+	for _, record := range records {
+		if realmID := record.RealmID(); realmID != uuid.Nil {
+			realmIDs = append(realmIDs, realmID)
+		}
+	}
+
+	existingIDs := make(map[uuid.UUID]struct{})
+	if len(realmIDs) == 0 {
+		return existingIDs, nil
+	}
+
+	ids, err := repository.ListExistingRealmIDs(ctx, realmIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to list existing realm IDs from repository")
+		return nil, realmmgr_errors.NewInternalError("failed to list existing realm IDs from repository", nil)
+	}
+	for _, id := range ids {
+		existingIDs[id] = struct{}{}
+	}
+	return existingIDs, nil
+}
+
+// importRealm stores the copies of the record, replacing all rows of the realm when it
+// already exists. Everything that can reject the record is checked before anything is
+// changed, so a rejected record leaves no changes behind.
+func (r *ImportRealms) importRealm(
+	ctx context.Context,
+	logger logging.Logger,
+	repos ImportRealmsRepos,
+	record entities.RealmExportRecord,
+	exists bool,
+) error {
+	now := repos.Clock.Now()
+
+	copies, err := r.realmCopies(record, now)
+	if err != nil {
+		return err
+	}
+	realmID := copies[0].ID
+
+	if revisionErr := advancePastRevisionHistory(ctx, logger, repos.Repository, copies); revisionErr != nil {
+		return revisionErr
+	}
+
+	slug, err := availableImportSlug(ctx, logger, repos.Repository, realmID, copies[0].Slug, copies[0].Name, now)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		if deleteErr := repos.Repository.DeleteRealm(
+			ctx,
+			realmID,
+			entities.StatusActive,
+			entities.StatusDraft,
+			entities.StatusDisabled,
+			entities.StatusDeleted,
+		); deleteErr != nil {
+			logger.WithError(deleteErr).Error("failed to delete realm from repository")
+			return realmmgr_errors.NewInternalError("failed to delete realm from repository", nil)
+		}
+		if slugErr := deleteRealmSlugs(ctx, logger, repos.Repository, realmID); slugErr != nil {
+			return slugErr
+		}
+		if scheduleErr := deleteReleaseSchedule(ctx, logger, repos.Repository, realmID); scheduleErr != nil {
+			return scheduleErr
+		}
+		if approvalErr := deleteReleaseApproval(ctx, logger, repos.Repository, realmID); approvalErr != nil {
+			return approvalErr
+		}
+	}
+
+	if claimErr := claimRealmSlug(ctx, logger, repos.Repository, realmID, slug, now); claimErr != nil {
+		return claimErr
+	}
+
+	for _, realm := range copies {
+		realm.Slug = slug
+		if createErr := repos.Repository.CreateRealm(ctx, realm); createErr != nil {
+			logger.WithError(createErr).Error("failed to create realm in repository")
+			return realmmgr_errors.NewInternalError("failed to create realm in repository", nil)
+		}
+	}
+
+	eventType := entities.RealmEventTypeCreated
+	if exists {
+		eventType = entities.RealmEventTypeUpdated
+	}
+	return recordRealmEvent(ctx, logger, repos.Repository, realmID, eventType, copies[0].Status, now)
+}
+
+// realmCopies validates the record and returns its copies, the released copy first.
+// Timestamps missing from the record are set to now.
+func (r *ImportRealms) realmCopies(record entities.RealmExportRecord, now time.Time) ([]entities.Realm, error) {
+	if record.FormatVersion != entities.RealmExportFormatVersion {
+		return nil, realmmgr_errors.NewInvalidArgumentError(
+			"formatVersion",
+			fmt.Sprintf("must be %d", entities.RealmExportFormatVersion),
+		)
+	}
+
+	copies := make([]entities.Realm, 0, 2)
+	if record.Released != nil {
+		if record.Released.Status != entities.StatusActive && record.Released.Status != entities.StatusDisabled {
+			return nil, realmmgr_errors.NewInvalidArgumentError("released", "must be either active or disabled")
+		}
+		copies = append(copies, record.Released.DeepCopyRealm())
+	}
+	if record.Draft != nil {
+		if record.Draft.Status != entities.StatusDraft {
+			return nil, realmmgr_errors.NewInvalidArgumentError("draft", "must be a draft")
+		}
+		copies = append(copies, record.Draft.DeepCopyRealm())
+	}
+	if len(copies) == 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("record", "must hold a released or a draft realm")
+	}
+
+	for i := range copies {
+		if err := r.validateRealm(copies[i]); err != nil {
+			return nil, err
+		}
+		if copies[i].ID != copies[0].ID {
+			return nil, realmmgr_errors.NewInvalidArgumentError("draft", "must have the ID of the released realm")
+		}
+		if copies[i].Slug != copies[0].Slug {
+			return nil, realmmgr_errors.NewInvalidArgumentError("draft", "must have the slug of the released realm")
+		}
+
+		if copies[i].CreatedAt.IsZero() {
+			copies[i].CreatedAt = now
+		}
+		if copies[i].UpdatedAt.IsZero() {
+			copies[i].UpdatedAt = now
+		}
+		if copies[i].Revision == 0 {
+			copies[i].Revision = 1
+		}
+		copies[i].ReleaseSchedule = nil
+	}
+
+	return copies, nil
+}
+
+// advancePastRevisionHistory moves the revisions of the copies past the revision history of
+// the realm, which is kept when the realm is replaced or was deleted before. Releasing the
+// imported realm would reuse a revision of its history otherwise. The copies keep their
+// revisions relative to each other.
+func advancePastRevisionHistory(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	copies []entities.Realm,
+) error {
+	latest, err := repository.ListRealmRevisions(ctx, entities.ListRealmRevisionsOptions{
+		RealmID: copies[0].ID,
+		Limit:   1,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to list realm revisions from repository")
+		return realmmgr_errors.NewInternalError("failed to list realm revisions from repository", nil)
+	}
+	if len(latest) == 0 {
+		return nil
+	}
+
+	lowest := copies[0].Revision
+	for _, realm := range copies[1:] {
+		if realm.Revision < lowest {
+			lowest = realm.Revision
+		}
+	}
+
+	if offset := latest[0].Realm.Revision - lowest + 1; offset > 0 {
+		for i := range copies {
+			copies[i].Revision += offset
+		}
+	}
+	return nil
+}
+
+func (r *ImportRealms) validateRealm(realm entities.Realm) error {
+	if realm.ID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("id", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if realm.Name == "" {
+		return realmmgr_errors.NewInvalidArgumentError("name", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if len(realm.Name) > MaxRealmNameLength {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"name",
+			fmt.Sprintf("must be at most %d characters long", MaxRealmNameLength),
+		)
+	}
+	if realm.ParentID == realm.ID {
+		return realmmgr_errors.NewInvalidArgumentError("parentId", "cannot be the realm itself")
+	}
+	if realm.Slug != "" {
+		if err := validateRealmSlug(realm.Slug); err != nil {
+			return err
+		}
+	}
+	if err := validateLabels(realm.Labels); err != nil {
+		return err
+	}
+	return r.attributeValidator.Validate(realm.Attributes)
+}
+
+// availableImportSlug returns the slug an imported realm is stored with, the requested slug
+// or, when none was requested, the first slug derived from the realm name that is available.
+// Slugs of the realm itself count as available, as they are released when the realm is
+// overwritten.
+func availableImportSlug(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+	requested string,
+	name string,
+	now time.Time,
+) (string, error) {
+	candidates := []string{requested}
+	if requested == "" {
+		candidates = deriveRealmSlugs(realmID, name)
+	}
+
+	for _, slug := range candidates {
+		existing, err := repository.GetRealmSlug(ctx, slug)
+		switch err.(type) {
+		case nil:
+			if existing.RealmID == realmID || existing.IsExpired(now) {
+				return slug, nil
+			}
+		case *realmmgr_errors.NotFoundError:
+			return slug, nil
+		default:
+			logger.WithError(err).Error("failed to get realm slug from repository")
+			return "", realmmgr_errors.NewInternalError("failed to get realm slug from repository", nil)
+		}
+	}
+
+	return "", realmmgr_errors.NewFailedPreconditionError(
+		fmt.Sprintf("realm slug %s is already in use", candidates[len(candidates)-1]),
+		nil,
+	)
+}
@@ -0,0 +1,185 @@
+package realms
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+)
+
+const (
+	// MaxRealmLabels is the number of labels a single realm can carry
+	MaxRealmLabels = 64
+
+	maxLabelNameLength   = 63
+	maxLabelPrefixLength = 253
+)
+
+var (
+	labelNameRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	labelPrefixRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	labelSetRegexp    = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+// validateLabels checks the labels of a realm. Keys are an optional DNS subdomain prefix
+// and a name separated by a slash, e.g. example.com/team. Names and values are at most 63
+// alphanumeric characters, with dashes, underscores and dots allowed in between. Values
+// may be empty.
+func validateLabels(labels map[string]string) error {
+	if len(labels) > MaxRealmLabels {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"labels",
+			fmt.Sprintf("cannot contain more than %d labels", MaxRealmLabels),
+		)
+	}
+
+	for _, key := range sortedKeys(labels) {
+		if !isValidLabelKey(key) {
+			return realmmgr_errors.NewInvalidArgumentError("labels", fmt.Sprintf("contains invalid key %q", key))
+		}
+		if !isValidLabelValue(labels[key]) {
+			return realmmgr_errors.NewInvalidArgumentError(
+				"labels",
+				fmt.Sprintf("contains invalid value %q for key %q", labels[key], key),
+			)
+		}
+	}
+
+	return nil
+}
+
+func isValidLabelKey(key string) bool {
+	name := key
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		prefix := key[:i]
+		if prefix == "" || len(prefix) > maxLabelPrefixLength || !labelPrefixRegexp.MatchString(prefix) {
+			return false
+		}
+		name = key[i+1:]
+	}
+	return len(name) <= maxLabelNameLength && labelNameRegexp.MatchString(name)
+}
+
+func isValidLabelValue(value string) bool {
+	if value == "" {
+		return true
+	}
+	return len(value) <= maxLabelNameLength && labelNameRegexp.MatchString(value)
+}
+
+// parseLabelSelector parses a comma separated list of label requirements in the
+// Kubernetes selector syntax, a realm has to satisfy all of them to be selected:
+//
+//	env=prod, tier!=cache, region in (eu, us), team notin (qa), canary, !legacy
+func parseLabelSelector(selector string) ([]entities.LabelRequirement, error) {
+	if strings.TrimSpace(selector) == "" {
+		return nil, nil
+	}
+
+	terms, err := splitLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := make([]entities.LabelRequirement, 0, len(terms))
+	for _, term := range terms {
+		requirement, ok := parseLabelRequirement(term)
+		if !ok {
+			return nil, realmmgr_errors.NewInvalidArgumentError(
+				"labelSelector",
+				fmt.Sprintf("contains invalid requirement %q", term),
+			)
+		}
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
+
+// splitLabelSelector splits the selector on commas that are not part of a value set.
+func splitLabelSelector(selector string) ([]string, error) {
+	terms := make([]string, 0)
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, strings.TrimSpace(selector[start:i]))
+				start = i + 1
+			}
+		}
+		if depth < 0 || depth > 1 {
+			return nil, realmmgr_errors.NewInvalidArgumentError("labelSelector", "contains unbalanced parentheses")
+		}
+	}
+	if depth != 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("labelSelector", "contains unbalanced parentheses")
+	}
+	return append(terms, strings.TrimSpace(selector[start:])), nil
+}
+
+func parseLabelRequirement(term string) (entities.LabelRequirement, bool) {
+	if match := labelSetRegexp.FindStringSubmatch(term); match != nil {
+		operator := entities.LabelOperatorIn
+		if match[2] == "notin" {
+			operator = entities.LabelOperatorNotIn
+		}
+
+		values := make([]string, 0)
+		for _, value := range strings.Split(match[3], ",") {
+			value = strings.TrimSpace(value)
+			if !isValidLabelValue(value) {
+				return entities.LabelRequirement{}, false
+			}
+			values = append(values, value)
+		}
+
+		return newLabelRequirement(match[1], operator, values...)
+	}
+
+	for _, operator := range []struct {
+		token    string
+		operator entities.LabelOperator
+	}{
+		{token: "!=", operator: entities.LabelOperatorNotEquals},
+		{token: "==", operator: entities.LabelOperatorEquals},
+		{token: "=", operator: entities.LabelOperatorEquals},
+	} {
+		if i := strings.Index(term, operator.token); i >= 0 {
+			value := strings.TrimSpace(term[i+len(operator.token):])
+			if !isValidLabelValue(value) {
+				return entities.LabelRequirement{}, false
+			}
+			return newLabelRequirement(term[:i], operator.operator, value)
+		}
+	}
+
+	if strings.HasPrefix(term, "!") {
+		return newLabelRequirement(term[1:], entities.LabelOperatorDoesNotExist)
+	}
+
+	return newLabelRequirement(term, entities.LabelOperatorExists)
+}
+
+func newLabelRequirement(
+	key string,
+	operator entities.LabelOperator,
+	values ...string,
+) (entities.LabelRequirement, bool) {
+	key = strings.TrimSpace(key)
+	if !isValidLabelKey(key) {
+		return entities.LabelRequirement{}, false
+	}
+
+	return entities.LabelRequirement{
+		Key:      key,
+		Operator: operator,
+		Values:   values,
+	}, true
+}
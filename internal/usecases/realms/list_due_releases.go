@@ -0,0 +1,76 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type ListDueReleasesInput struct {
+	Limit int
+}
+
+func (i *ListDueReleasesInput) Validate() error {
+	if i.Limit <= 0 {
+		return realmmgr_errors.NewInvalidArgumentError("limit", "must be positive")
+	}
+	return nil
+}
+
+type ListDueReleasesRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ListDueReleasesRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ListDueReleases struct {
+}
+
+func NewListDueReleases() *ListDueReleases {
+	return &ListDueReleases{}
+}
+
+// ListDueReleases lists the pending release schedules whose next attempt is due.
+func (r *ListDueReleases) ListDueReleases(
+	ctx context.Context,
+	repos ListDueReleasesRepos,
+	input ListDueReleasesInput,
+) ([]entities.ReleaseSchedule, error) {
+	if err := repos.Validate(); err != nil {
+		return nil, err
+	}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "list-due-releases",
+	})
+
+	schedules, err := repos.Repository.ListDueReleaseSchedules(ctx, repos.Clock.Now(), input.Limit)
+	if err != nil {
+		logger.WithError(err).Error("failed to list release schedules from repository")
+		return nil, realmmgr_errors.NewInternalError("failed to list release schedules from repository", nil)
+	}
+
+	return schedules, nil
+}
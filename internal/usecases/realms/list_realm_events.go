@@ -0,0 +1,161 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	DefaultListRealmEventsLimit = 100
+	MaxListRealmEventsLimit     = 1000
+)
+
+type ListRealmEventsInput struct {
+	// RealmID lists only events of the given realm, events of all realms are listed when nil
+	RealmID uuid.UUID
+	// Status lists only events of realm rows in the given status and deletes of whole realms,
+	// events in any status are listed when zero
+	Status entities.Status
+	// SequenceToken lists the events following the token, only events recorded from now on
+	// are listed when blank
+	SequenceToken string
+	Limit         int
+}
+
+func (i *ListRealmEventsInput) Validate() error {
+	if i.Limit < 0 || i.Limit > MaxListRealmEventsLimit {
+		return realmmgr_errors.NewInvalidArgumentError("limit", "must be between 0 and 1000")
+	}
+	return nil
+}
+
+type ListRealmEventsRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ListRealmEventsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ListRealmEvents struct {
+}
+
+func NewListRealmEvents() *ListRealmEvents {
+	return &ListRealmEvents{}
+}
+
+// ListRealmEvents lists the realm events following the sequence token of the input, oldest
+// first. Every event carries the token to resume listing after it, the next token of the
+// page is the token of the last event, or the token of the input when no events follow.
+func (r *ListRealmEvents) ListRealmEvents(
+	ctx context.Context,
+	repos ListRealmEventsRepos,
+	input ListRealmEventsInput,
+) (entities.RealmEventPage, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmEventPage{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmEventPage{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "list-realm-events",
+		"realm-id": input.RealmID,
+	})
+
+	if input.SequenceToken == "" {
+		return r.latestPage(ctx, logger, repos)
+	}
+
+	token, err := decodeRealmEventToken(input.SequenceToken)
+	if err != nil || token.Transaction < 0 || token.Sequence < 0 {
+		logger.WithError(err).Info("failed to decode sequence token")
+		return entities.RealmEventPage{}, realmmgr_errors.NewInvalidArgumentError("sequenceToken", "is malformed")
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = DefaultListRealmEventsLimit
+	}
+
+	events, err := repos.Repository.ListRealmEvents(ctx, entities.ListRealmEventsOptions{
+		After: entities.RealmEventCursor{
+			Transaction: token.Transaction,
+			Sequence:    token.Sequence,
+		},
+		RealmID: input.RealmID,
+		Status:  input.Status,
+		Limit:   limit,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to list realm events from repository")
+		return entities.RealmEventPage{}, realmmgr_errors.NewInternalError("failed to list realm events from repository", nil)
+	}
+
+	page := entities.RealmEventPage{
+		Events:    make([]entities.WatchedRealmEvent, 0, len(events)),
+		NextToken: input.SequenceToken,
+	}
+	for _, event := range events {
+		sequenceToken, encodeErr := encodeRealmEventToken(realmEventToken{
+			Transaction: event.Transaction,
+			Sequence:    event.Sequence,
+		})
+		if encodeErr != nil {
+			logger.WithError(encodeErr).Error("failed to encode sequence token")
+			return entities.RealmEventPage{}, realmmgr_errors.NewInternalError("failed to encode sequence token", nil)
+		}
+		page.Events = append(page.Events, entities.WatchedRealmEvent{
+			RealmEvent:    event,
+			SequenceToken: sequenceToken,
+		})
+		page.NextToken = sequenceToken
+	}
+
+	return page, nil
+}
+
+// latestPage returns an empty page positioned after the latest event of a finished transaction.
+func (r *ListRealmEvents) latestPage(
+	ctx context.Context,
+	logger logging.Logger,
+	repos ListRealmEventsRepos,
+) (entities.RealmEventPage, error) {
+	cursor, err := repos.Repository.GetLatestRealmEventCursor(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to get latest realm event cursor from repository")
+		return entities.RealmEventPage{}, realmmgr_errors.NewInternalError(
+			"failed to get latest realm event cursor from repository",
+			nil,
+		)
+	}
+
+	nextToken, err := encodeRealmEventToken(realmEventToken{
+		Transaction: cursor.Transaction,
+		Sequence:    cursor.Sequence,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to encode sequence token")
+		return entities.RealmEventPage{}, realmmgr_errors.NewInternalError("failed to encode sequence token", nil)
+	}
+
+	return entities.RealmEventPage{
+		Events:    []entities.WatchedRealmEvent{},
+		NextToken: nextToken,
+	}, nil
+}
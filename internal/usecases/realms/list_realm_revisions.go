@@ -0,0 +1,127 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	DefaultListRealmRevisionsPageSize = 25
+	MaxListRealmRevisionsPageSize     = 100
+)
+
+type ListRealmRevisionsInput struct {
+	RealmID   uuid.UUID
+	PageSize  int
+	PageToken string
+}
+
+func (i *ListRealmRevisionsInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.PageSize < 0 || i.PageSize > MaxListRealmRevisionsPageSize {
+		return realmmgr_errors.NewInvalidArgumentError("pageSize", "must be between 0 and 100")
+	}
+	return nil
+}
+
+type ListRealmRevisionsRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ListRealmRevisionsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ListRealmRevisions struct {
+}
+
+func NewListRealmRevisions() *ListRealmRevisions {
+	return &ListRealmRevisions{}
+}
+
+// ListRealmRevisions lists the revision history of the realm, newest first.
+func (r *ListRealmRevisions) ListRealmRevisions(
+	ctx context.Context,
+	repos ListRealmRevisionsRepos,
+	input ListRealmRevisionsInput,
+) (entities.RealmRevisionPage, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmRevisionPage{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmRevisionPage{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "list-realm-revisions",
+		"realm-id": input.RealmID,
+	})
+
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultListRealmRevisionsPageSize
+	}
+
+	options := entities.ListRealmRevisionsOptions{
+		RealmID: input.RealmID,
+		// fetch one extra revision to find out whether another page follows
+		Limit: pageSize + 1,
+	}
+
+	if input.PageToken != "" {
+		token, err := decodeRevisionPageToken(input.PageToken)
+		if err != nil {
+			logger.WithError(err).Info("failed to decode page token")
+			return entities.RealmRevisionPage{}, realmmgr_errors.NewInvalidArgumentError("pageToken", "is malformed")
+		}
+		if token.RealmID != input.RealmID {
+			return entities.RealmRevisionPage{}, realmmgr_errors.NewInvalidArgumentError(
+				"pageToken",
+				"does not match the realm of the request",
+			)
+		}
+		options.Before = token.Before
+	}
+
+	revisions, err := repos.Repository.ListRealmRevisions(ctx, options)
+	if err != nil {
+		logger.WithError(err).Error("failed to list realm revisions from repository")
+		return entities.RealmRevisionPage{}, realmmgr_errors.NewInternalError("failed to list realm revisions from repository", nil)
+	}
+
+	if len(revisions) <= pageSize {
+		return entities.RealmRevisionPage{Revisions: revisions}, nil
+	}
+
+	revisions = revisions[:pageSize]
+
+	nextPageToken, err := encodeRevisionPageToken(revisionPageToken{
+		RealmID: input.RealmID,
+		Before:  revisions[len(revisions)-1].Realm.Revision,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to encode next page token")
+		return entities.RealmRevisionPage{}, realmmgr_errors.NewInternalError("failed to encode next page token", nil)
+	}
+
+	return entities.RealmRevisionPage{
+		Revisions:     revisions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	DefaultListRealmTemplatesPageSize = 25
+	MaxListRealmTemplatesPageSize     = 100
+)
+
+type ListRealmTemplatesInput struct {
+	PageSize  int
+	PageToken string
+}
+
+func (i *ListRealmTemplatesInput) Validate() error {
+	if i.PageSize < 0 || i.PageSize > MaxListRealmTemplatesPageSize {
+		return realmmgr_errors.NewInvalidArgumentError("pageSize", "must be between 0 and 100")
+	}
+	return nil
+}
+
+type ListRealmTemplatesRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ListRealmTemplatesRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ListRealmTemplates struct {
+}
+
+func NewListRealmTemplates() *ListRealmTemplates {
+	return &ListRealmTemplates{}
+}
+
+// ListRealmTemplates lists the latest version of every template that was not deleted.
+func (r *ListRealmTemplates) ListRealmTemplates(
+	ctx context.Context,
+	repos ListRealmTemplatesRepos,
+	input ListRealmTemplatesInput,
+) (entities.RealmTemplatePage, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmTemplatePage{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmTemplatePage{}, err
+	}
+
+	logger := repos.Logger.WithField("use-case", "list-realm-templates")
+
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultListRealmTemplatesPageSize
+	}
+
+	options := entities.ListRealmTemplatesOptions{
+		// fetch one extra template to find out whether another page follows
+		Limit: pageSize + 1,
+	}
+
+	if input.PageToken != "" {
+		token, err := decodeTemplatePageToken(input.PageToken)
+		if err != nil {
+			logger.WithError(err).Info("failed to decode page token")
+			return entities.RealmTemplatePage{}, realmmgr_errors.NewInvalidArgumentError("pageToken", "is malformed")
+		}
+		options.After = token.After
+	}
+
+	templates, err := repos.Repository.ListRealmTemplates(ctx, options)
+	if err != nil {
+		logger.WithError(err).Error("failed to list realm templates from repository")
+		return entities.RealmTemplatePage{}, realmmgr_errors.NewInternalError("failed to list realm templates from repository", nil)
+	}
+
+	if len(templates) <= pageSize {
+		return entities.RealmTemplatePage{Templates: templates}, nil
+	}
+
+	templates = templates[:pageSize]
+
+	nextPageToken, err := encodeTemplatePageToken(templatePageToken{
+		After: templates[len(templates)-1].ID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to encode next page token")
+		return entities.RealmTemplatePage{}, realmmgr_errors.NewInternalError("failed to encode next page token", nil)
+	}
+
+	return entities.RealmTemplatePage{
+		Templates:     templates,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	DefaultListRealmsPageSize = 25
+	MaxListRealmsPageSize     = 100
+)
+
+type ListRealmsInput struct {
+	Status    entities.Status
+	Sorting   entities.RealmSorting
+	PageSize  int
+	PageToken string
+	// LabelSelector filters realms by their labels, e.g. "env=prod,region in (eu,us)"
+	LabelSelector string
+	// ParentID limits the listing to the direct children of a realm when set
+	ParentID uuid.UUID
+}
+
+func (i *ListRealmsInput) Validate() error {
+	if i.Status == entities.StatusDeleted {
+		return realmmgr_errors.NewInvalidArgumentError("status", "cannot be deleted")
+	}
+	if i.PageSize < 0 || i.PageSize > MaxListRealmsPageSize {
+		return realmmgr_errors.NewInvalidArgumentError("pageSize", "must be between 0 and 100")
+	}
+	return nil
+}
+
+type ListRealmsRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ListRealmsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ListRealms struct {
+}
+
+func NewListRealms() *ListRealms {
+	return &ListRealms{}
+}
+
+func (r *ListRealms) ListRealms(ctx context.Context, repos ListRealmsRepos, input ListRealmsInput) (entities.RealmPage, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmPage{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmPage{}, err
+	}
+
+	logger := repos.Logger.WithField("use-case", "list-realms")
+
+	if input.ParentID != uuid.Nil {
+		if _, found, err := getHierarchyRealm(ctx, logger, repos.Repository, input.ParentID); err != nil {
+			return entities.RealmPage{}, err
+		} else if !found {
+			return entities.RealmPage{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("parent realm with ID %s not found", input.ParentID),
+				nil,
+			)
+		}
+	}
+
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultListRealmsPageSize
+	}
+
+	if input.Sorting.Field == 0 {
+		input.Sorting.Field = entities.SortFieldName
+	}
+	if input.Sorting.Direction == 0 {
+		input.Sorting.Direction = entities.SortDirectionAsc
+	}
+
+	labelSelector, err := parseLabelSelector(input.LabelSelector)
+	if err != nil {
+		return entities.RealmPage{}, err
+	}
+
+	options := entities.ListRealmsOptions{
+		Status:        input.Status,
+		Sorting:       input.Sorting,
+		LabelSelector: labelSelector,
+		ParentID:      input.ParentID,
+		// fetch one extra realm to find out whether another page follows
+		Limit: pageSize + 1,
+	}
+
+	if input.PageToken != "" {
+		token, err := decodePageToken(input.PageToken)
+		if err != nil {
+			logger.WithError(err).Info("failed to decode page token")
+			return entities.RealmPage{}, realmmgr_errors.NewInvalidArgumentError("pageToken", "is malformed")
+		}
+		if token.Status != input.Status ||
+			token.LabelSelector != input.LabelSelector ||
+			token.Sorting != input.Sorting {
+			return entities.RealmPage{}, realmmgr_errors.NewInvalidArgumentError(
+				"pageToken",
+				"does not match the status, label selector and sorting of the request",
+			)
+		}
+		if token.ParentID != input.ParentID {
+			return entities.RealmPage{}, realmmgr_errors.NewInvalidArgumentError(
+				"pageToken",
+				"does not match the parent of the request",
+			)
+		}
+		options.After = &token.Cursor
+	}
+
+	realms, err := repos.Repository.ListRealms(ctx, options)
+	if err != nil {
+		logger.WithError(err).Error("failed to list realms from repository")
+		return entities.RealmPage{}, realmmgr_errors.NewInternalError("failed to list realms from repository", nil)
+	}
+
+	if len(realms) <= pageSize {
+		return entities.RealmPage{Realms: realms}, nil
+	}
+
+	realms = realms[:pageSize]
+
+	nextPageToken, err := encodePageToken(pageToken{
+		Status:        input.Status,
+		LabelSelector: input.LabelSelector,
+		ParentID:      input.ParentID,
+		Sorting:       input.Sorting,
+		Cursor:        entities.CursorFromRealm(realms[len(realms)-1]),
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to encode next page token")
+		return entities.RealmPage{}, realmmgr_errors.NewInternalError("failed to encode next page token", nil)
+	}
+
+	return entities.RealmPage{
+		Realms:        realms,
+		NextPageToken: nextPageToken,
+	}, nil
+}
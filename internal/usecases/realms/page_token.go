@@ -0,0 +1,134 @@
+package realms
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+)
+
+// pageToken is the state carried between ListRealms calls. It is handed out to
+// clients as an opaque base64 string and must only be used with the same
+// filtering and sorting it was created for.
+type pageToken struct {
+	Status        entities.Status       `json:"status"`
+	LabelSelector string                `json:"labelSelector,omitempty"`
+	ParentID      uuid.UUID             `json:"parentId"`
+	Sorting       entities.RealmSorting `json:"sorting"`
+	Cursor        entities.RealmCursor  `json:"cursor"`
+}
+
+// revisionPageToken is the state carried between ListRealmRevisions calls, handed out
+// to clients the same way as pageToken.
+type revisionPageToken struct {
+	RealmID uuid.UUID `json:"realmId"`
+	Before  int64     `json:"before"`
+}
+
+// templatePageToken is the state carried between ListRealmTemplates calls, handed out
+// to clients the same way as pageToken.
+type templatePageToken struct {
+	After uuid.UUID `json:"after"`
+}
+
+// searchPageToken is the state carried between SearchRealms calls, handed out to clients
+// the same way as pageToken.
+type searchPageToken struct {
+	Query  string          `json:"query"`
+	Status entities.Status `json:"status"`
+	Offset int             `json:"offset"`
+}
+
+// realmEventToken is the position of a watcher in the realm event log, handed out to
+// clients the same way as pageToken. Unlike page tokens it is not tied to the filters of a
+// watch, so a watcher may resume with different filters.
+type realmEventToken struct {
+	Transaction int64 `json:"transaction"`
+	Sequence    int64 `json:"sequence"`
+}
+
+// exportPageToken is the state carried between ExportRealms calls, handed out to clients the
+// same way as pageToken. Cursor is nil at the start of the export of a status.
+type exportPageToken struct {
+	Status        entities.Status       `json:"status"`
+	IncludeDrafts bool                  `json:"includeDrafts"`
+	Cursor        *entities.RealmCursor `json:"cursor,omitempty"`
+}
+
+func encodePageToken(token pageToken) (string, error) {
+	return encodeToken(token)
+}
+
+func decodePageToken(value string) (pageToken, error) {
+	var token pageToken
+	err := decodeToken(value, &token)
+	return token, err
+}
+
+func encodeRevisionPageToken(token revisionPageToken) (string, error) {
+	return encodeToken(token)
+}
+
+func decodeRevisionPageToken(value string) (revisionPageToken, error) {
+	var token revisionPageToken
+	err := decodeToken(value, &token)
+	return token, err
+}
+
+func encodeTemplatePageToken(token templatePageToken) (string, error) {
+	return encodeToken(token)
+}
+
+func decodeTemplatePageToken(value string) (templatePageToken, error) {
+	var token templatePageToken
+	err := decodeToken(value, &token)
+	return token, err
+}
+
+func encodeSearchPageToken(token searchPageToken) (string, error) {
+	return encodeToken(token)
+}
+
+func decodeSearchPageToken(value string) (searchPageToken, error) {
+	var token searchPageToken
+	err := decodeToken(value, &token)
+	return token, err
+}
+
+func encodeRealmEventToken(token realmEventToken) (string, error) {
+	return encodeToken(token)
+}
+
+func decodeRealmEventToken(value string) (realmEventToken, error) {
+	var token realmEventToken
+	err := decodeToken(value, &token)
+	return token, err
+}
+
+func encodeExportPageToken(token exportPageToken) (string, error) {
+	return encodeToken(token)
+}
+
+func decodeExportPageToken(value string) (exportPageToken, error) {
+	var token exportPageToken
+	err := decodeToken(value, &token)
+	return token, err
+}
+
+func encodeToken(token interface{}) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeToken(value string, token interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, token)
+}
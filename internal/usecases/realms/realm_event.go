@@ -0,0 +1,37 @@
+package realms
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+// recordRealmEvent records a change made to a realm for watchers. It is recorded in the
+// transaction of the change, so watchers are only notified of changes that were committed.
+func recordRealmEvent(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+	eventType entities.RealmEventType,
+	status entities.Status,
+	now time.Time,
+) error {
+	event := entities.RealmEvent{
+		RealmID:    realmID,
+		Type:       eventType,
+		Status:     status,
+		OccurredAt: now,
+	}
+	if err := repository.CreateRealmEvent(ctx, event); err != nil {
+		logger.WithError(err).Error("failed to create realm event in repository")
+		return realmmgr_errors.NewInternalError("failed to create realm event in repository", nil)
+	}
+	return nil
+}
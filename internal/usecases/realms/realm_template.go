@@ -0,0 +1,277 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	// MaxRealmTemplateParameters is the number of parameters a single template can declare
+	MaxRealmTemplateParameters = 32
+	// RealmTemplateParameterName is the built-in parameter holding the name of the realm
+	// created from a template, it cannot be declared by templates
+	RealmTemplateParameterName = "name"
+
+	maxTemplateParameterNameLength = 63
+)
+
+var (
+	templateParameterNameRegexp = regexp.MustCompile(`^[a-z]([a-z0-9_]*[a-z0-9])?$`)
+	// templatePlaceholderRegexp matches placeholders such as ${environment}, the parameter
+	// name is captured as is and checked separately
+	templatePlaceholderRegexp = regexp.MustCompile(`\$\{([^{}]*)\}`)
+)
+
+// validateRealmTemplate checks the content of a template. Label values and attributes may
+// hold placeholders, so they are only checked in full once a realm is created from the
+// template and the placeholders are substituted.
+func validateRealmTemplate(
+	name string,
+	realmDescription string,
+	labels map[string]string,
+	attributes map[string]interface{},
+	parameters []entities.RealmTemplateParameter,
+) error {
+	if name == "" {
+		return realmmgr_errors.NewInvalidArgumentError("name", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+
+	if err := validateTemplateParameters(parameters); err != nil {
+		return err
+	}
+
+	declared := map[string]struct{}{RealmTemplateParameterName: {}}
+	for _, parameter := range parameters {
+		declared[parameter.Name] = struct{}{}
+	}
+
+	if err := checkTemplatePlaceholders("realmDescription", declared, realmDescription); err != nil {
+		return err
+	}
+
+	if len(labels) > MaxRealmLabels {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"labels",
+			fmt.Sprintf("cannot contain more than %d labels", MaxRealmLabels),
+		)
+	}
+	for _, key := range sortedKeys(labels) {
+		if !isValidLabelKey(key) {
+			return realmmgr_errors.NewInvalidArgumentError("labels", fmt.Sprintf("contains invalid key %q", key))
+		}
+		if err := checkTemplatePlaceholders("labels", declared, labels[key]); err != nil {
+			return err
+		}
+	}
+
+	return checkTemplatePlaceholders("attributes", declared, attributeStrings(attributes)...)
+}
+
+func validateTemplateParameters(parameters []entities.RealmTemplateParameter) error {
+	if len(parameters) > MaxRealmTemplateParameters {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"parameters",
+			fmt.Sprintf("cannot contain more than %d parameters", MaxRealmTemplateParameters),
+		)
+	}
+
+	names := make(map[string]struct{}, len(parameters))
+	for _, parameter := range parameters {
+		if len(parameter.Name) > maxTemplateParameterNameLength || !templateParameterNameRegexp.MatchString(parameter.Name) {
+			return realmmgr_errors.NewInvalidArgumentError(
+				"parameters",
+				fmt.Sprintf("contains invalid parameter name %q", parameter.Name),
+			)
+		}
+		if parameter.Name == RealmTemplateParameterName {
+			return realmmgr_errors.NewInvalidArgumentError(
+				"parameters",
+				fmt.Sprintf("cannot declare built-in parameter %q", parameter.Name),
+			)
+		}
+		if _, ok := names[parameter.Name]; ok {
+			return realmmgr_errors.NewInvalidArgumentError(
+				"parameters",
+				fmt.Sprintf("contains duplicate parameter %q", parameter.Name),
+			)
+		}
+		if parameter.Required && parameter.DefaultValue != "" {
+			return realmmgr_errors.NewInvalidArgumentError(
+				"parameters",
+				fmt.Sprintf("cannot have a default value for required parameter %q", parameter.Name),
+			)
+		}
+		names[parameter.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// checkTemplatePlaceholders rejects placeholders in values that do not reference a declared
+// parameter.
+func checkTemplatePlaceholders(field string, declared map[string]struct{}, values ...string) error {
+	for _, value := range values {
+		for _, match := range templatePlaceholderRegexp.FindAllStringSubmatch(value, -1) {
+			if _, ok := declared[match[1]]; !ok {
+				return realmmgr_errors.NewInvalidArgumentError(
+					field,
+					fmt.Sprintf("references undeclared parameter %q", match[1]),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveTemplateParameters determines the value of every parameter of the template from
+// the supplied values, falling back to the default values of parameters that were not
+// supplied. The built-in name parameter is set to realmName.
+func resolveTemplateParameters(
+	template entities.RealmTemplate,
+	realmName string,
+	supplied map[string]string,
+) (map[string]string, error) {
+	values := map[string]string{RealmTemplateParameterName: realmName}
+
+	declared := make(map[string]struct{}, len(template.Parameters))
+	for _, parameter := range template.Parameters {
+		declared[parameter.Name] = struct{}{}
+
+		value, ok := supplied[parameter.Name]
+		switch {
+		case ok:
+			values[parameter.Name] = value
+		case parameter.Required:
+			return nil, realmmgr_errors.NewInvalidArgumentError(
+				"parameters",
+				fmt.Sprintf("is missing required parameter %q", parameter.Name),
+			)
+		default:
+			values[parameter.Name] = parameter.DefaultValue
+		}
+	}
+
+	for _, name := range sortedKeys(supplied) {
+		if _, ok := declared[name]; !ok {
+			return nil, realmmgr_errors.NewInvalidArgumentError(
+				"parameters",
+				fmt.Sprintf("contains unknown parameter %q", name),
+			)
+		}
+	}
+
+	return values, nil
+}
+
+// substitutePlaceholders replaces the placeholders in value by the parameter values,
+// placeholders of unknown parameters are left untouched.
+func substitutePlaceholders(value string, values map[string]string) string {
+	return templatePlaceholderRegexp.ReplaceAllStringFunc(value, func(placeholder string) string {
+		if substitute, ok := values[placeholder[2:len(placeholder)-1]]; ok {
+			return substitute
+		}
+		return placeholder
+	})
+}
+
+// substituteAttributePlaceholders returns a copy of attributes with the placeholders in all
+// string values substituted, object keys are left untouched.
+func substituteAttributePlaceholders(value interface{}, values map[string]string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = substituteAttributePlaceholders(item, values)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = substituteAttributePlaceholders(item, values)
+		}
+		return result
+	case string:
+		return substitutePlaceholders(v, values)
+	default:
+		return v
+	}
+}
+
+// attributeStrings collects all string values nested in attributes.
+func attributeStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make([]string, 0)
+		for _, item := range v {
+			result = append(result, attributeStrings(item)...)
+		}
+		return result
+	case []interface{}:
+		result := make([]string, 0)
+		for _, item := range v {
+			result = append(result, attributeStrings(item)...)
+		}
+		return result
+	case string:
+		return []string{v}
+	default:
+		return nil
+	}
+}
+
+// sortedKeys returns the keys of values in sorted order, so that errors are reported
+// deterministically.
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getLiveRealmTemplate gets the given version of a template, or its latest version when
+// version is zero. Deleted templates are reported as not found.
+func getLiveRealmTemplate(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	templateID uuid.UUID,
+	version int64,
+) (entities.RealmTemplate, error) {
+	template, err := repository.GetRealmTemplate(ctx, templateID, version)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.RealmTemplate{}, realmTemplateNotFoundError(templateID, version)
+		default:
+			logger.WithError(err).Error("failed to get realm template from repository")
+			return entities.RealmTemplate{}, realmmgr_errors.NewInternalError("failed to get realm template from repository", nil)
+		}
+	}
+
+	if !template.DeletedAt.IsZero() {
+		return entities.RealmTemplate{}, realmTemplateNotFoundError(templateID, version)
+	}
+
+	return template, nil
+}
+
+func realmTemplateNotFoundError(templateID uuid.UUID, version int64) error {
+	if version > 0 {
+		return realmmgr_errors.NewNotFoundError(
+			fmt.Sprintf("realm template with ID %s and version %d not found", templateID, version),
+			nil,
+		)
+	}
+	return realmmgr_errors.NewNotFoundError(fmt.Sprintf("realm template with ID %s not found", templateID), nil)
+}
@@ -0,0 +1,154 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+// maxReleaseRetryBackoff caps the delay between two attempts of a scheduled release.
+const maxReleaseRetryBackoff = 24 * time.Hour
+
+type RecordReleaseFailureInput struct {
+	RealmID uuid.UUID
+	// Attempts the schedule had made when the failed attempt claimed it
+	Attempts int
+	// Reason the release attempt failed with
+	Reason string
+}
+
+func (i *RecordReleaseFailureInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type RecordReleaseFailureRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *RecordReleaseFailureRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type RecordReleaseFailure struct {
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewRecordReleaseFailure creates the use case recording failed attempts of scheduled
+// releases. Failed releases are retried after backoff, doubled with every attempt, until
+// maxAttempts is reached.
+func NewRecordReleaseFailure(maxAttempts int, backoff time.Duration) (*RecordReleaseFailure, error) {
+	if maxAttempts <= 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("maxAttempts", "must be positive")
+	}
+	if backoff <= 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("backoff", "must be positive")
+	}
+	return &RecordReleaseFailure{
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}, nil
+}
+
+// RecordReleaseFailure records the failed attempt on the schedule, provided the schedule is
+// still due and no other worker attempted the release since. A not found error is returned
+// otherwise, so the failure of a release attempted concurrently is recorded only once.
+func (r *RecordReleaseFailure) RecordReleaseFailure(
+	ctx context.Context,
+	repos RecordReleaseFailureRepos,
+	input RecordReleaseFailureInput,
+) (entities.ReleaseSchedule, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.ReleaseSchedule{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.ReleaseSchedule{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "record-release-failure",
+		"realm-id": input.RealmID,
+	})
+
+	now := repos.Clock.Now()
+
+	schedule, err := repos.Repository.ClaimDueReleaseSchedule(ctx, input.RealmID, now)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.ReleaseSchedule{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("due release of realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to claim release schedule in repository")
+			return entities.ReleaseSchedule{}, realmmgr_errors.NewInternalError("failed to claim release schedule in repository", nil)
+		}
+	}
+	if schedule.Attempts != input.Attempts {
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewNotFoundError(
+			fmt.Sprintf("due release of realm with ID %s was attempted concurrently", input.RealmID),
+			nil,
+		)
+	}
+
+	schedule.Attempts++
+	schedule.LastError = input.Reason
+	schedule.UpdatedAt = now
+	if schedule.Attempts >= r.maxAttempts {
+		schedule.State = entities.ReleaseScheduleStateFailed
+	} else {
+		schedule.NextAttemptAt = now.Add(r.retryBackoff(schedule.Attempts))
+	}
+
+	updated, err := repos.Repository.UpdateReleaseSchedule(ctx, schedule)
+	if err != nil {
+		logger.WithError(err).Error("failed to update release schedule in repository")
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewInternalError("failed to update release schedule in repository", nil)
+	}
+	if updated == 0 {
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewNotFoundError(
+			fmt.Sprintf("scheduled release of realm with ID %s not found", input.RealmID),
+			nil,
+		)
+	}
+
+	return schedule, nil
+}
+
+// retryBackoff returns the delay before the next attempt, given the number of attempts
+// that failed so far.
+func (r *RecordReleaseFailure) retryBackoff(attempts int) time.Duration {
+	backoff := r.backoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxReleaseRetryBackoff {
+			return maxReleaseRetryBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,88 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+// checkReleaseApproval verifies that the draft revision was approved by at least
+// requiredApprovals distinct reviewers and rejected by none.
+func checkReleaseApproval(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	draftRealm entities.Realm,
+	requiredApprovals int,
+) error {
+	approval, err := repository.GetReleaseApproval(ctx, draftRealm.ID)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return realmmgr_errors.NewFailedPreconditionError(
+				fmt.Sprintf("release of realm with ID %s requires %d approvals, but no approval was requested", draftRealm.ID, requiredApprovals),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get release approval from repository")
+			return realmmgr_errors.NewInternalError("failed to get release approval from repository", nil)
+		}
+	}
+
+	if err := checkApprovedRevision(approval, draftRealm); err != nil {
+		return err
+	}
+
+	if rejection, rejected := approval.Rejection(); rejected {
+		return realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("release of realm with ID %s was rejected by %s", draftRealm.ID, rejection.Reviewer),
+			nil,
+		)
+	}
+
+	if approvers := approval.Approvers(); approvers < requiredApprovals {
+		return realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("release of realm with ID %s has %d of %d required approvals", draftRealm.ID, approvers, requiredApprovals),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// checkApprovedRevision verifies that the draft was not changed since its approval was
+// requested, any change of the draft invalidates the approval.
+func checkApprovedRevision(approval entities.ReleaseApproval, draftRealm entities.Realm) error {
+	if approval.Realm.Revision == draftRealm.Revision {
+		return nil
+	}
+	return realmmgr_errors.NewFailedPreconditionError(
+		fmt.Sprintf(
+			"draft of realm with ID %s changed after its approval was requested at revision %d, approval must be requested again",
+			draftRealm.ID,
+			approval.Realm.Revision,
+		),
+		nil,
+	)
+}
+
+// deleteReleaseApproval drops the approval request of the realm, if any. Once the draft
+// is released or discarded the approval has no draft left to apply to.
+func deleteReleaseApproval(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+) error {
+	if err := repository.DeleteReleaseApproval(ctx, realmID); err != nil {
+		logger.WithError(err).Error("failed to delete release approval from repository")
+		return realmmgr_errors.NewInternalError("failed to delete release approval from repository", nil)
+	}
+	return nil
+}
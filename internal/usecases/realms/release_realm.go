@@ -2,6 +2,7 @@ package realms
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -14,6 +15,10 @@ import (
 
 type ReleaseRealmInput struct {
 	RealmID uuid.UUID
+	// ExpectedRevision of the draft to be released, not checked when zero
+	ExpectedRevision int64
+	// ReleasedBy identifies who released the realm in its revision history
+	ReleasedBy string
 }
 
 func (i *ReleaseRealmInput) Validate() error {
@@ -35,10 +40,26 @@ func (r *ReleaseRealmRepos) Validate() error {
 }
 
 type ReleaseRealm struct {
+	requiredApprovals int
+	hierarchy         *RealmHierarchy
 }
 
-func NewReleaseRealm() *ReleaseRealm {
-	return &ReleaseRealm{}
+// NewReleaseRealm creates the use case releasing drafts. Drafts can only be released once
+// requiredApprovals distinct reviewers approved them, no approval is required when zero.
+// Releases requiring approval cannot be made anonymously.
+// Drafts moving the realm to another parent are checked against hierarchy again, as the
+// hierarchy may have changed since the draft was updated.
+func NewReleaseRealm(requiredApprovals int, hierarchy *RealmHierarchy) (*ReleaseRealm, error) {
+	if requiredApprovals < 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("requiredApprovals", "cannot be negative")
+	}
+	if hierarchy == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("hierarchy", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &ReleaseRealm{
+		requiredApprovals: requiredApprovals,
+		hierarchy:         hierarchy,
+	}, nil
 }
 
 func (r *ReleaseRealm) ReleaseRealm(ctx context.Context, repos ReleaseRealmRepos, input ReleaseRealmInput) (entities.Realm, error) {
@@ -48,6 +69,12 @@ func (r *ReleaseRealm) ReleaseRealm(ctx context.Context, repos ReleaseRealmRepos
 	if err := input.Validate(); err != nil {
 		return entities.Realm{}, nil
 	}
+	if r.requiredApprovals > 0 && input.ReleasedBy == "" {
+		return entities.Realm{}, realmmgr_errors.NewInvalidArgumentError(
+			"releasedBy",
+			"cannot be blank when releases require approval",
+		)
+	}
 
 	logger := repos.Logger.WithFields(map[string]interface{}{
 		"use-case": "release-realm",
@@ -68,20 +95,59 @@ func (r *ReleaseRealm) ReleaseRealm(ctx context.Context, repos ReleaseRealmRepos
 		}
 	}
 
+	if revisionErr := checkRealmRevision(draftRealm, input.ExpectedRevision); revisionErr != nil {
+		return entities.Realm{}, revisionErr
+	}
+
+	if r.requiredApprovals > 0 {
+		if approvalErr := checkReleaseApproval(ctx, logger, repos.Repository, draftRealm, r.requiredApprovals); approvalErr != nil {
+			return entities.Realm{}, approvalErr
+		}
+	}
+
 	activeRealm, err := repos.Repository.GetRealm(ctx, input.RealmID, entities.StatusActive)
 	if err != nil {
 		switch err.(type) {
 		case *realmmgr_errors.NotFoundError:
 			// it's a newly created realm that required update in status
+			if parentErr := r.hierarchy.checkParent(ctx, logger, repos.Repository, draftRealm.ID, draftRealm.ParentID); parentErr != nil {
+				return entities.Realm{}, parentErr
+			}
+
+			draftRevision := draftRealm.Revision
 			draftRealm.UpdatedAt = now
 			draftRealm.Status = entities.StatusActive
-			if updateErr := repos.Repository.UpdateRealm(ctx, draftRealm, entities.StatusDraft); updateErr != nil {
-				logger.WithError(updateErr).Error("failed to update draft realm in repository")
-				return entities.Realm{}, realmmgr_errors.NewInternalError("failed to update draft realm in repository", nil)
+			draftRealm.Revision++
+			if updateErr := repos.Repository.UpdateRealm(ctx, draftRealm, entities.StatusDraft, draftRevision); updateErr != nil {
+				return entities.Realm{}, updateRealmError(logger, updateErr, "failed to update draft realm in repository")
 			}
 
 			// TODO: perform other realm initializations
 
+			if revisionErr := r.createRevision(ctx, logger, repos, input, draftRealm, now); revisionErr != nil {
+				return entities.Realm{}, revisionErr
+			}
+
+			if eventErr := recordRealmEvent(
+				ctx,
+				logger,
+				repos.Repository,
+				draftRealm.ID,
+				entities.RealmEventTypeReleased,
+				draftRealm.Status,
+				now,
+			); eventErr != nil {
+				return entities.Realm{}, eventErr
+			}
+
+			if scheduleErr := deleteReleaseSchedule(ctx, logger, repos.Repository, input.RealmID); scheduleErr != nil {
+				return entities.Realm{}, scheduleErr
+			}
+
+			if approvalErr := deleteReleaseApproval(ctx, logger, repos.Repository, input.RealmID); approvalErr != nil {
+				return entities.Realm{}, approvalErr
+			}
+
 			return draftRealm, nil
 		default:
 			logger.WithError(err).Error("failed to get draft realm from repository")
@@ -89,18 +155,79 @@ func (r *ReleaseRealm) ReleaseRealm(ctx context.Context, repos ReleaseRealmRepos
 		}
 	}
 
+	if draftRealm.ParentID != activeRealm.ParentID {
+		if parentErr := r.hierarchy.checkParent(ctx, logger, repos.Repository, draftRealm.ID, draftRealm.ParentID); parentErr != nil {
+			return entities.Realm{}, parentErr
+		}
+	}
+
+	activeRevision := activeRealm.Revision
+
 	activeRealm = activeRealm.Merge(draftRealm)
 	activeRealm.UpdatedAt = now
+	// the released realm continues from the draft revision, which is always the latest one
+	activeRealm.Revision = draftRealm.Revision + 1
+
+	// the draft is only deleted at the revision that was merged, so an update of the draft
+	// committed in the meantime aborts the release instead of being lost
+	if deleteErr := repos.Repository.DeleteRealmAtRevision(
+		ctx,
+		draftRealm.ID,
+		draftRealm.Status,
+		draftRealm.Revision,
+	); deleteErr != nil {
+		return entities.Realm{}, updateRealmError(logger, deleteErr, "failed to delete draft realm from repository")
+	}
+
+	if updateErr := repos.Repository.UpdateRealm(ctx, activeRealm, activeRealm.Status, activeRevision); updateErr != nil {
+		return entities.Realm{}, updateRealmError(logger, updateErr, "failed to update active realm in repository")
+	}
 
-	if deleteErr := repos.Repository.DeleteRealm(ctx, draftRealm.ID, draftRealm.Status); deleteErr != nil {
-		logger.WithError(deleteErr).Error("failed to delete draft realm from repository")
-		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to delete draft realm from repository", nil)
+	if revisionErr := r.createRevision(ctx, logger, repos, input, activeRealm, now); revisionErr != nil {
+		return entities.Realm{}, revisionErr
 	}
 
-	if updateErr := repos.Repository.UpdateRealm(ctx, activeRealm, activeRealm.Status); updateErr != nil {
-		logger.WithError(updateErr).Error("failed to update active realm in repository")
-		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to update active realm in repository", nil)
+	if eventErr := recordRealmEvent(
+		ctx,
+		logger,
+		repos.Repository,
+		activeRealm.ID,
+		entities.RealmEventTypeReleased,
+		activeRealm.Status,
+		now,
+	); eventErr != nil {
+		return entities.Realm{}, eventErr
+	}
+
+	if scheduleErr := deleteReleaseSchedule(ctx, logger, repos.Repository, input.RealmID); scheduleErr != nil {
+		return entities.Realm{}, scheduleErr
+	}
+
+	if approvalErr := deleteReleaseApproval(ctx, logger, repos.Repository, input.RealmID); approvalErr != nil {
+		return entities.Realm{}, approvalErr
 	}
 
 	return activeRealm, nil
 }
+
+// createRevision records the released state of the realm in its revision history, under the
+// revision the realm was released with.
+func (r *ReleaseRealm) createRevision(
+	ctx context.Context,
+	logger logging.Logger,
+	repos ReleaseRealmRepos,
+	input ReleaseRealmInput,
+	realm entities.Realm,
+	releasedAt time.Time,
+) error {
+	revision := entities.RealmRevision{
+		Realm:      realm,
+		ReleasedBy: input.ReleasedBy,
+		ReleasedAt: releasedAt,
+	}
+	if err := repos.Repository.CreateRealmRevision(ctx, revision); err != nil {
+		logger.WithError(err).Error("failed to create realm revision in repository")
+		return realmmgr_errors.NewInternalError("failed to create realm revision in repository", nil)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package realms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+// deleteReleaseSchedule drops the release schedule of the realm, if any. Once the draft is
+// released or discarded there is nothing left for the schedule to release.
+func deleteReleaseSchedule(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+) error {
+	if _, err := repository.DeleteReleaseSchedule(ctx, realmID); err != nil {
+		logger.WithError(err).Error("failed to delete release schedule from repository")
+		return realmmgr_errors.NewInternalError("failed to delete release schedule from repository", nil)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type RenameRealmSlugInput struct {
+	RealmID uuid.UUID
+	Slug    string
+}
+
+func (i *RenameRealmSlugInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return validateRealmSlug(i.Slug)
+}
+
+type RenameRealmSlugRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *RenameRealmSlugRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type RenameRealmSlug struct {
+	redirectPeriod time.Duration
+}
+
+// NewRenameRealmSlug creates the use case renaming realm slugs. The former slug of a realm
+// keeps resolving to it for redirectPeriod, it is released right away when zero.
+func NewRenameRealmSlug(redirectPeriod time.Duration) (*RenameRealmSlug, error) {
+	if redirectPeriod < 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("redirectPeriod", "cannot be negative")
+	}
+	return &RenameRealmSlug{
+		redirectPeriod: redirectPeriod,
+	}, nil
+}
+
+// RenameRealmSlug replaces the slug of all copies of the realm. Renaming a realm back to one
+// of its redirects takes the redirect over.
+func (r *RenameRealmSlug) RenameRealmSlug(
+	ctx context.Context,
+	repos RenameRealmSlugRepos,
+	input RenameRealmSlugInput,
+) (entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "rename-realm-slug",
+		"realm-id": input.RealmID,
+		"slug":     input.Slug,
+	})
+
+	realm, found, err := getHierarchyRealm(ctx, logger, repos.Repository, input.RealmID)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+	if !found {
+		return entities.Realm{}, realmmgr_errors.NewNotFoundError(
+			fmt.Sprintf("realm with ID %s not found", input.RealmID),
+			nil,
+		)
+	}
+
+	if realm.Slug == input.Slug {
+		return realm, nil
+	}
+
+	now := repos.Clock.Now()
+
+	if claimErr := claimRealmSlug(ctx, logger, repos.Repository, input.RealmID, input.Slug, now); claimErr != nil {
+		return entities.Realm{}, claimErr
+	}
+
+	// realms created before slugs were introduced have no former slug to redirect from
+	if realm.Slug != "" {
+		if redirectErr := r.redirectFormerSlug(ctx, logger, repos.Repository, realm.Slug, now); redirectErr != nil {
+			return entities.Realm{}, redirectErr
+		}
+	}
+
+	if _, updateErr := repos.Repository.UpdateRealmSlug(ctx, input.RealmID, input.Slug); updateErr != nil {
+		logger.WithError(updateErr).Error("failed to update realm slug in repository")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to update realm slug in repository", nil)
+	}
+
+	realm.Slug = input.Slug
+
+	if eventErr := recordRealmEvent(
+		ctx,
+		logger,
+		repos.Repository,
+		input.RealmID,
+		entities.RealmEventTypeUpdated,
+		realm.Status,
+		now,
+	); eventErr != nil {
+		return entities.Realm{}, eventErr
+	}
+
+	return realm, nil
+}
+
+func (r *RenameRealmSlug) redirectFormerSlug(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	slug string,
+	now time.Time,
+) error {
+	if r.redirectPeriod == 0 {
+		if _, err := repository.DeleteRealmSlug(ctx, slug); err != nil {
+			logger.WithError(err).Error("failed to delete realm slug from repository")
+			return realmmgr_errors.NewInternalError("failed to delete realm slug from repository", nil)
+		}
+		return nil
+	}
+
+	if _, err := repository.ExpireRealmSlug(ctx, slug, now.Add(r.redirectPeriod)); err != nil {
+		logger.WithError(err).Error("failed to expire realm slug in repository")
+		return realmmgr_errors.NewInternalError("failed to expire realm slug in repository", nil)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type RequestReleaseApprovalInput struct {
+	RealmID     uuid.UUID
+	RequestedBy string
+}
+
+func (i *RequestReleaseApprovalInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	// reviewers are checked against the requester, so the request cannot be anonymous
+	if i.RequestedBy == "" {
+		return realmmgr_errors.NewInvalidArgumentError("requestedBy", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type RequestReleaseApprovalRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *RequestReleaseApprovalRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type RequestReleaseApproval struct {
+}
+
+func NewRequestReleaseApproval() *RequestReleaseApproval {
+	return &RequestReleaseApproval{}
+}
+
+// RequestReleaseApproval freezes a snapshot of the current draft for reviewers to decide
+// on. A previous approval request of the realm is replaced together with its decisions.
+func (r *RequestReleaseApproval) RequestReleaseApproval(
+	ctx context.Context,
+	repos RequestReleaseApprovalRepos,
+	input RequestReleaseApprovalInput,
+) (entities.ReleaseApproval, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "request-release-approval",
+		"realm-id": input.RealmID,
+	})
+
+	draftRealm, err := repos.Repository.GetRealm(ctx, input.RealmID, entities.StatusDraft)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.ReleaseApproval{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("draft of realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get draft realm from repository")
+			return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to get draft realm from repository", nil)
+		}
+	}
+
+	if deleteErr := deleteReleaseApproval(ctx, logger, repos.Repository, input.RealmID); deleteErr != nil {
+		return entities.ReleaseApproval{}, deleteErr
+	}
+
+	approval := entities.ReleaseApproval{
+		Realm:       draftRealm,
+		RequestedBy: input.RequestedBy,
+		RequestedAt: repos.Clock.Now(),
+		Decisions:   make([]entities.ReleaseApprovalDecision, 0),
+	}
+	if saveErr := repos.Repository.SaveReleaseApproval(ctx, approval); saveErr != nil {
+		logger.WithError(saveErr).Error("failed to save release approval in repository")
+		return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to save release approval in repository", nil)
+	}
+
+	return approval, nil
+}
@@ -0,0 +1,173 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type RestoreRealmInput struct {
+	RealmID uuid.UUID
+	Reason  string
+}
+
+func (i *RestoreRealmInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type RestoreRealmRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository      repositories.RealmManagerRepository
+	AuditRepository repositories.RealmManagerAuditRepository
+}
+
+func (r *RestoreRealmRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.AuditRepository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("auditRepository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type RestoreRealm struct {
+	retention time.Duration
+}
+
+// NewRestoreRealm creates the use case restoring deleted realms. Realms can only
+// be restored when they were deleted no longer than retention ago.
+func NewRestoreRealm(retention time.Duration) (*RestoreRealm, error) {
+	if retention <= 0 {
+		return nil, realmmgr_errors.NewInvalidArgumentError("retention", "must be positive")
+	}
+	return &RestoreRealm{
+		retention: retention,
+	}, nil
+}
+
+func (r *RestoreRealm) RestoreRealm(ctx context.Context, repos RestoreRealmRepos, input RestoreRealmInput) (entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "restore-realm",
+		"realm-id": input.RealmID,
+	})
+
+	deletedRealm, err := repos.Repository.GetRealm(ctx, input.RealmID, entities.StatusDeleted)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.Realm{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("deleted realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get deleted realm from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get deleted realm from repository", nil)
+		}
+	}
+
+	if deletedRealm.PreviousStatus == 0 {
+		return entities.Realm{}, realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("realm with ID %s was deleted without its previous status and cannot be restored", input.RealmID),
+			nil,
+		)
+	}
+
+	if repos.Clock.Since(deletedRealm.DeletedAt) > r.retention {
+		return entities.Realm{}, realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("realm with ID %s was deleted outside of the %s retention period", input.RealmID, r.retention),
+			nil,
+		)
+	}
+
+	// a realm deleted along with its parent would be cut off from the hierarchy when restored alone
+	if deletedRealm.ParentID != uuid.Nil {
+		_, found, parentErr := getHierarchyRealm(ctx, logger, repos.Repository, deletedRealm.ParentID)
+		if parentErr != nil {
+			return entities.Realm{}, parentErr
+		}
+		if !found {
+			return entities.Realm{}, realmmgr_errors.NewFailedPreconditionError(
+				fmt.Sprintf(
+					"parent realm with ID %s of realm with ID %s is deleted, restore the parent first",
+					deletedRealm.ParentID,
+					input.RealmID,
+				),
+				nil,
+			)
+		}
+	}
+
+	now := repos.Clock.Now()
+
+	if _, restoreErr := repos.Repository.RestoreRealm(ctx, input.RealmID, now); restoreErr != nil {
+		logger.WithError(restoreErr).Error("failed to restore realm in repository")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to restore realm in repository", nil)
+	}
+
+	auditRecord := entities.AuditRecord{
+		RealmID:   input.RealmID,
+		Action:    entities.AuditActionRestore,
+		Reason:    input.Reason,
+		CreatedAt: now,
+	}
+	if auditErr := repos.AuditRepository.CreateAuditRecord(ctx, auditRecord); auditErr != nil {
+		logger.WithError(auditErr).Error("failed to create audit record in repository")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to create audit record in repository", nil)
+	}
+
+	// a realm may be restored together with its draft, the released state takes precedence
+	for _, status := range []entities.Status{entities.StatusActive, entities.StatusDisabled, entities.StatusDraft} {
+		realm, getErr := repos.Repository.GetRealm(ctx, input.RealmID, status)
+		switch getErr.(type) {
+		case nil:
+			if eventErr := recordRealmEvent(
+				ctx,
+				logger,
+				repos.Repository,
+				input.RealmID,
+				entities.RealmEventTypeUpdated,
+				realm.Status,
+				now,
+			); eventErr != nil {
+				return entities.Realm{}, eventErr
+			}
+			return realm, nil
+		case *realmmgr_errors.NotFoundError:
+			continue
+		default:
+			logger.WithError(getErr).Error("failed to get restored realm from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get restored realm from repository", nil)
+		}
+	}
+
+	logger.Error("restored realm not found in repository")
+	return entities.Realm{}, realmmgr_errors.NewInternalError("restored realm not found in repository", nil)
+}
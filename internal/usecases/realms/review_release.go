@@ -0,0 +1,150 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type ReviewReleaseInput struct {
+	RealmID  uuid.UUID
+	Reviewer string
+	Decision entities.ApprovalDecision
+	Comment  string
+	// ExpectedRevision of the draft the reviewer decided on, not checked when zero
+	ExpectedRevision int64
+}
+
+func (i *ReviewReleaseInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.Reviewer == "" {
+		return realmmgr_errors.NewInvalidArgumentError("reviewer", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.Decision != entities.ApprovalDecisionApprove && i.Decision != entities.ApprovalDecisionReject {
+		return realmmgr_errors.NewInvalidArgumentError("decision", fmt.Sprintf("has unexpected value %d", i.Decision))
+	}
+	return nil
+}
+
+type ReviewReleaseRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ReviewReleaseRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ReviewRelease struct {
+}
+
+func NewReviewRelease() *ReviewRelease {
+	return &ReviewRelease{}
+}
+
+// ReviewRelease records the decision of a reviewer on the draft snapshot approval was
+// requested for. A reviewer deciding again replaces their previous decision, while the
+// requester of the approval cannot decide on it.
+func (r *ReviewRelease) ReviewRelease(
+	ctx context.Context,
+	repos ReviewReleaseRepos,
+	input ReviewReleaseInput,
+) (entities.ReleaseApproval, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.ReleaseApproval{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "review-release",
+		"realm-id": input.RealmID,
+		"reviewer": input.Reviewer,
+	})
+
+	approval, err := repos.Repository.GetReleaseApproval(ctx, input.RealmID)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.ReleaseApproval{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("release approval of realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get release approval from repository")
+			return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to get release approval from repository", nil)
+		}
+	}
+
+	if approval.RequestedBy == input.Reviewer {
+		return entities.ReleaseApproval{}, realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("release approval of realm with ID %s cannot be reviewed by its requester %s", input.RealmID, input.Reviewer),
+			nil,
+		)
+	}
+
+	if revisionErr := checkRealmRevision(approval.Realm, input.ExpectedRevision); revisionErr != nil {
+		return entities.ReleaseApproval{}, revisionErr
+	}
+
+	draftRealm, err := repos.Repository.GetRealm(ctx, input.RealmID, entities.StatusDraft)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.ReleaseApproval{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("draft of realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get draft realm from repository")
+			return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to get draft realm from repository", nil)
+		}
+	}
+
+	if revisionErr := checkApprovedRevision(approval, draftRealm); revisionErr != nil {
+		return entities.ReleaseApproval{}, revisionErr
+	}
+
+	decision := entities.ReleaseApprovalDecision{
+		RealmID:   input.RealmID,
+		Revision:  approval.Realm.Revision,
+		Reviewer:  input.Reviewer,
+		Decision:  input.Decision,
+		Comment:   input.Comment,
+		DecidedAt: repos.Clock.Now(),
+	}
+	if saveErr := repos.Repository.SaveReleaseApprovalDecision(ctx, decision); saveErr != nil {
+		logger.WithError(saveErr).Error("failed to save release approval decision in repository")
+		return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to save release approval decision in repository", nil)
+	}
+
+	approval, err = repos.Repository.GetReleaseApproval(ctx, input.RealmID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get release approval from repository")
+		return entities.ReleaseApproval{}, realmmgr_errors.NewInternalError("failed to get release approval from repository", nil)
+	}
+
+	return approval, nil
+}
@@ -0,0 +1,31 @@
+package realms
+
+import (
+	"fmt"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+// checkRealmRevision verifies that realm is still at the revision the caller based its
+// change on. An expected revision of zero skips the check.
+func checkRealmRevision(realm entities.Realm, expectedRevision int64) error {
+	if expectedRevision == 0 || realm.Revision == expectedRevision {
+		return nil
+	}
+	return realmmgr_errors.NewAbortedError(
+		fmt.Sprintf("realm with ID %s is at revision %d, expected revision %d", realm.ID, realm.Revision, expectedRevision),
+		nil,
+	)
+}
+
+// updateRealmError passes through concurrent change errors of a realm update, any other
+// error is logged and reported as internal with msg.
+func updateRealmError(logger logging.Logger, err error, msg string) error {
+	if _, ok := err.(*realmmgr_errors.AbortedError); ok {
+		return err
+	}
+	logger.WithError(err).Error(msg)
+	return realmmgr_errors.NewInternalError(msg, nil)
+}
@@ -0,0 +1,181 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type RollbackRealmInput struct {
+	RealmID uuid.UUID
+	// Revision to be restored into the draft of the realm
+	Revision int64
+	Reason   string
+}
+
+func (i *RollbackRealmInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.Revision <= 0 {
+		return realmmgr_errors.NewInvalidArgumentError("revision", "must be positive")
+	}
+	return nil
+}
+
+type RollbackRealmRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository      repositories.RealmManagerRepository
+	AuditRepository repositories.RealmManagerAuditRepository
+}
+
+func (r *RollbackRealmRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.AuditRepository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("auditRepository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type RollbackRealm struct {
+}
+
+func NewRollbackRealm() *RollbackRealm {
+	return &RollbackRealm{}
+}
+
+// RollbackRealm copies the content of a released revision into the draft of the realm,
+// so that it can be reviewed and released again. An existing draft is replaced.
+func (r *RollbackRealm) RollbackRealm(ctx context.Context, repos RollbackRealmRepos, input RollbackRealmInput) (entities.Realm, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.Realm{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "rollback-realm",
+		"realm-id": input.RealmID,
+		"revision": input.Revision,
+	})
+
+	revision, err := repos.Repository.GetRealmRevision(ctx, input.RealmID, input.Revision)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.Realm{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("revision %d of realm with ID %s not found", input.Revision, input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get realm revision from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get realm revision from repository", nil)
+		}
+	}
+
+	now := repos.Clock.Now()
+
+	snapshot := revision.Realm
+	snapshot.UpdatedAt = now
+
+	draftRealm, err := r.rollbackDraft(ctx, logger, repos, input.RealmID, snapshot)
+	if err != nil {
+		return entities.Realm{}, err
+	}
+
+	auditRecord := entities.AuditRecord{
+		RealmID:   input.RealmID,
+		Action:    entities.AuditActionRollback,
+		Reason:    input.Reason,
+		CreatedAt: now,
+	}
+	if auditErr := repos.AuditRepository.CreateAuditRecord(ctx, auditRecord); auditErr != nil {
+		logger.WithError(auditErr).Error("failed to create audit record in repository")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to create audit record in repository", nil)
+	}
+
+	if eventErr := recordRealmEvent(
+		ctx,
+		logger,
+		repos.Repository,
+		input.RealmID,
+		entities.RealmEventTypeUpdated,
+		draftRealm.Status,
+		now,
+	); eventErr != nil {
+		return entities.Realm{}, eventErr
+	}
+
+	return draftRealm, nil
+}
+
+func (r *RollbackRealm) rollbackDraft(
+	ctx context.Context,
+	logger logging.Logger,
+	repos RollbackRealmRepos,
+	realmID uuid.UUID,
+	snapshot entities.Realm,
+) (entities.Realm, error) {
+	draftRealm, err := repos.Repository.GetRealm(ctx, realmID, entities.StatusDraft)
+	switch err.(type) {
+	case nil:
+		// replace the content of the existing draft
+		draftRevision := draftRealm.Revision
+
+		draftRealm = draftRealm.Merge(snapshot)
+		draftRealm.Revision++
+
+		if updateErr := repos.Repository.UpdateRealm(ctx, draftRealm, draftRealm.Status, draftRevision); updateErr != nil {
+			return entities.Realm{}, updateRealmError(logger, updateErr, "failed to update draft realm in repository")
+		}
+		return draftRealm, nil
+	case *realmmgr_errors.NotFoundError:
+		// create a new draft from the active realm
+	default:
+		logger.WithError(err).Error("failed to get draft realm from repository")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get draft realm from repository", nil)
+	}
+
+	activeRealm, err := repos.Repository.GetRealm(ctx, realmID, entities.StatusActive)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.Realm{}, realmmgr_errors.NewFailedPreconditionError(
+				fmt.Sprintf("realm with ID %s is not active and cannot be rolled back", realmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get active realm from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get active realm from repository", nil)
+		}
+	}
+
+	draftRealm = activeRealm.Merge(snapshot)
+	draftRealm.Status = entities.StatusDraft
+	draftRealm.Revision++
+
+	if createErr := repos.Repository.CreateRealm(ctx, draftRealm); createErr != nil {
+		return entities.Realm{}, updateRealmError(logger, createErr, "failed to create draft realm in repository")
+	}
+
+	return draftRealm, nil
+}
@@ -0,0 +1,114 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type ScheduleReleaseInput struct {
+	RealmID     uuid.UUID
+	ReleaseAt   time.Time
+	ScheduledBy string
+}
+
+func (i *ScheduleReleaseInput) Validate() error {
+	if i.RealmID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("realmID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.ReleaseAt.IsZero() {
+		return realmmgr_errors.NewInvalidArgumentError("releaseAt", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	return nil
+}
+
+type ScheduleReleaseRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *ScheduleReleaseRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type ScheduleRelease struct {
+}
+
+func NewScheduleRelease() *ScheduleRelease {
+	return &ScheduleRelease{}
+}
+
+// ScheduleRelease sets the point in time the draft of the realm is released at, replacing
+// any previous schedule of the realm.
+func (r *ScheduleRelease) ScheduleRelease(
+	ctx context.Context,
+	repos ScheduleReleaseRepos,
+	input ScheduleReleaseInput,
+) (entities.ReleaseSchedule, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.ReleaseSchedule{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.ReleaseSchedule{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "schedule-release",
+		"realm-id": input.RealmID,
+	})
+
+	now := repos.Clock.Now()
+
+	if !input.ReleaseAt.After(now) {
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewInvalidArgumentError("releaseAt", "must be in the future")
+	}
+
+	if _, err := repos.Repository.GetRealm(ctx, input.RealmID, entities.StatusDraft); err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.ReleaseSchedule{}, realmmgr_errors.NewNotFoundError(
+				fmt.Sprintf("draft of realm with ID %s not found", input.RealmID),
+				nil,
+			)
+		default:
+			logger.WithError(err).Error("failed to get draft realm from repository")
+			return entities.ReleaseSchedule{}, realmmgr_errors.NewInternalError("failed to get draft realm from repository", nil)
+		}
+	}
+
+	schedule := entities.ReleaseSchedule{
+		RealmID:       input.RealmID,
+		ReleaseAt:     input.ReleaseAt,
+		ScheduledBy:   input.ScheduledBy,
+		State:         entities.ReleaseScheduleStatePending,
+		NextAttemptAt: input.ReleaseAt,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := repos.Repository.SaveReleaseSchedule(ctx, schedule); err != nil {
+		logger.WithError(err).Error("failed to save release schedule in repository")
+		return entities.ReleaseSchedule{}, realmmgr_errors.NewInternalError("failed to save release schedule in repository", nil)
+	}
+
+	return schedule, nil
+}
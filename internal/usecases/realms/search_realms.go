@@ -0,0 +1,141 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	DefaultSearchRealmsPageSize = 25
+	MaxSearchRealmsPageSize     = 100
+	MaxSearchRealmsQueryLength  = 256
+)
+
+type SearchRealmsInput struct {
+	Query     string
+	Status    entities.Status
+	PageSize  int
+	PageToken string
+}
+
+func (i *SearchRealmsInput) Validate() error {
+	if i.Status == entities.StatusDeleted {
+		return realmmgr_errors.NewInvalidArgumentError("status", "cannot be deleted")
+	}
+	if strings.TrimSpace(i.Query) == "" {
+		return realmmgr_errors.NewInvalidArgumentError("query", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if len(i.Query) > MaxSearchRealmsQueryLength {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"query",
+			fmt.Sprintf("cannot be longer than %d characters", MaxSearchRealmsQueryLength),
+		)
+	}
+	if i.PageSize < 0 || i.PageSize > MaxSearchRealmsPageSize {
+		return realmmgr_errors.NewInvalidArgumentError("pageSize", "must be between 0 and 100")
+	}
+	return nil
+}
+
+type SearchRealmsRepos struct {
+	Logger logging.Logger
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *SearchRealmsRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type SearchRealms struct {
+}
+
+func NewSearchRealms() *SearchRealms {
+	return &SearchRealms{}
+}
+
+// SearchRealms lists the realms matching the query by name or description, most relevant
+// first.
+func (r *SearchRealms) SearchRealms(
+	ctx context.Context,
+	repos SearchRealmsRepos,
+	input SearchRealmsInput,
+) (entities.RealmSearchPage, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmSearchPage{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmSearchPage{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case": "search-realms",
+		"status":   input.Status,
+	})
+
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultSearchRealmsPageSize
+	}
+
+	options := entities.SearchRealmsOptions{
+		Query:  input.Query,
+		Status: input.Status,
+		// fetch one extra result to find out whether another page follows
+		Limit: pageSize + 1,
+	}
+
+	if input.PageToken != "" {
+		token, err := decodeSearchPageToken(input.PageToken)
+		if err != nil {
+			logger.WithError(err).Info("failed to decode page token")
+			return entities.RealmSearchPage{}, realmmgr_errors.NewInvalidArgumentError("pageToken", "is malformed")
+		}
+		if token.Query != input.Query || token.Status != input.Status {
+			return entities.RealmSearchPage{}, realmmgr_errors.NewInvalidArgumentError(
+				"pageToken",
+				"does not match the query and status of the request",
+			)
+		}
+		options.Offset = token.Offset
+	}
+
+	results, err := repos.Repository.SearchRealms(ctx, options)
+	if err != nil {
+		logger.WithError(err).Error("failed to search realms in repository")
+		return entities.RealmSearchPage{}, realmmgr_errors.NewInternalError("failed to search realms in repository", nil)
+	}
+
+	if len(results) <= pageSize {
+		return entities.RealmSearchPage{Results: results}, nil
+	}
+
+	results = results[:pageSize]
+
+	nextPageToken, err := encodeSearchPageToken(searchPageToken{
+		Query:  input.Query,
+		Status: input.Status,
+		Offset: options.Offset + pageSize,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to encode next page token")
+		return entities.RealmSearchPage{}, realmmgr_errors.NewInternalError("failed to encode next page token", nil)
+	}
+
+	return entities.RealmSearchPage{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,184 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+const (
+	MinRealmSlugLength = 3
+	MaxRealmSlugLength = 63
+
+	// derivedSlugSuffixLength is the number of characters of the realm ID appended to slugs
+	// derived from realm names that are already taken
+	derivedSlugSuffixLength = 8
+)
+
+var (
+	realmSlugRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	// realmSlugSeparatorRegexp matches the characters replaced by a hyphen when a slug is
+	// derived from a realm name
+	realmSlugSeparatorRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+	// reservedRealmSlugs cannot be used as slugs as they clash with paths of clients
+	// resolving realms by slug
+	reservedRealmSlugs = map[string]struct{}{
+		"admin":   {},
+		"api":     {},
+		"default": {},
+		"new":     {},
+		"realm":   {},
+		"realms":  {},
+		"root":    {},
+		"system":  {},
+	}
+)
+
+// validateRealmSlug checks that slug is made of lowercase letters and digits separated by
+// single hyphens and is not reserved. Slugs that parse as UUIDs are rejected, so clients
+// can tell slugs and IDs apart.
+func validateRealmSlug(slug string) error {
+	if len(slug) < MinRealmSlugLength || len(slug) > MaxRealmSlugLength {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"slug",
+			fmt.Sprintf("must be between %d and %d characters long", MinRealmSlugLength, MaxRealmSlugLength),
+		)
+	}
+	if !realmSlugRegexp.MatchString(slug) {
+		return realmmgr_errors.NewInvalidArgumentError(
+			"slug",
+			"must consist of lowercase letters and digits separated by single hyphens",
+		)
+	}
+	if _, ok := reservedRealmSlugs[slug]; ok {
+		return realmmgr_errors.NewInvalidArgumentError("slug", fmt.Sprintf("%q is reserved", slug))
+	}
+	if _, err := uuid.Parse(slug); err == nil {
+		return realmmgr_errors.NewInvalidArgumentError("slug", "cannot be a UUID")
+	}
+	return nil
+}
+
+// deriveRealmSlugs returns the slugs tried in order for a realm created without a slug. The
+// first one is derived from the realm name, the second one is made unique by a prefix of the
+// realm ID.
+func deriveRealmSlugs(realmID uuid.UUID, name string) []string {
+	base := strings.Trim(realmSlugSeparatorRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	suffix := realmID.String()[:derivedSlugSuffixLength]
+
+	maxBaseLength := MaxRealmSlugLength - derivedSlugSuffixLength - 1
+	if len(base) > maxBaseLength {
+		base = strings.TrimRight(base[:maxBaseLength], "-")
+	}
+
+	slugs := make([]string, 0, 2)
+	if validateRealmSlug(base) == nil {
+		slugs = append(slugs, base)
+	}
+	if base == "" {
+		return append(slugs, fmt.Sprintf("realm-%s", suffix))
+	}
+	return append(slugs, fmt.Sprintf("%s-%s", base, suffix))
+}
+
+// assignRealmSlug claims the requested slug for a new realm, or derives one from the realm
+// name when no slug was requested.
+func assignRealmSlug(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+	requested string,
+	name string,
+	now time.Time,
+) (string, error) {
+	if requested != "" {
+		if err := claimRealmSlug(ctx, logger, repository, realmID, requested, now); err != nil {
+			return "", err
+		}
+		return requested, nil
+	}
+
+	var claimErr error
+	for _, slug := range deriveRealmSlugs(realmID, name) {
+		claimErr = claimRealmSlug(ctx, logger, repository, realmID, slug, now)
+		switch claimErr.(type) {
+		case nil:
+			return slug, nil
+		case *realmmgr_errors.FailedPreconditionError:
+			continue
+		default:
+			return "", claimErr
+		}
+	}
+	return "", claimErr
+}
+
+// claimRealmSlug stores slug as the current slug of the realm. Redirects that have expired,
+// or that point at the realm itself, are taken over.
+func claimRealmSlug(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+	slug string,
+	now time.Time,
+) error {
+	existing, err := repository.GetRealmSlug(ctx, slug)
+	switch err.(type) {
+	case nil:
+		if !existing.IsExpired(now) && !(existing.IsRedirect() && existing.RealmID == realmID) {
+			return realmmgr_errors.NewFailedPreconditionError(
+				fmt.Sprintf("realm slug %s is already in use", slug),
+				nil,
+			)
+		}
+		if _, deleteErr := repository.DeleteRealmSlug(ctx, slug); deleteErr != nil {
+			logger.WithError(deleteErr).Error("failed to delete realm slug from repository")
+			return realmmgr_errors.NewInternalError("failed to delete realm slug from repository", nil)
+		}
+	case *realmmgr_errors.NotFoundError:
+	default:
+		logger.WithError(err).Error("failed to get realm slug from repository")
+		return realmmgr_errors.NewInternalError("failed to get realm slug from repository", nil)
+	}
+
+	createErr := repository.CreateRealmSlug(ctx, entities.RealmSlug{
+		Slug:      slug,
+		RealmID:   realmID,
+		CreatedAt: now,
+	})
+	switch createErr.(type) {
+	case nil:
+		return nil
+	case *realmmgr_errors.AbortedError:
+		return createErr
+	default:
+		logger.WithError(createErr).Error("failed to create realm slug in repository")
+		return realmmgr_errors.NewInternalError("failed to create realm slug in repository", nil)
+	}
+}
+
+// deleteRealmSlugs releases the slug and redirects of a realm that no longer exists.
+func deleteRealmSlugs(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+) error {
+	if err := repository.DeleteRealmSlugs(ctx, realmID); err != nil {
+		logger.WithError(err).Error("failed to delete realm slugs from repository")
+		return realmmgr_errors.NewInternalError("failed to delete realm slugs from repository", nil)
+	}
+	return nil
+}
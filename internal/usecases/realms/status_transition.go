@@ -0,0 +1,127 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type statusTransition struct {
+	from   entities.Status
+	to     entities.Status
+	action entities.AuditAction
+	event  entities.RealmEventType
+}
+
+type statusTransitionRepos struct {
+	Clock           clock.Clock
+	Repository      repositories.RealmManagerRepository
+	AuditRepository repositories.RealmManagerAuditRepository
+}
+
+// transitionRealmStatus moves the realm row in transition.from status into transition.to
+// status and records the change in the audit log and for watchers. Realms with a pending
+// draft are rejected, as releasing that draft afterwards would silently undo the transition.
+func transitionRealmStatus(
+	ctx context.Context,
+	logger logging.Logger,
+	repos statusTransitionRepos,
+	realmID uuid.UUID,
+	reason string,
+	transition statusTransition,
+) (entities.Realm, error) {
+	_, err := repos.Repository.GetRealm(ctx, realmID, entities.StatusDraft)
+	switch err.(type) {
+	case nil:
+		return entities.Realm{}, realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("realm with ID %s has a pending draft", realmID),
+			nil,
+		)
+	case *realmmgr_errors.NotFoundError:
+		// no draft pending, transition can go ahead
+	default:
+		logger.WithError(err).Error("failed to get draft realm from repository")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get draft realm from repository", nil)
+	}
+
+	realm, err := repos.Repository.GetRealm(ctx, realmID, transition.from)
+	if err != nil {
+		switch err.(type) {
+		case *realmmgr_errors.NotFoundError:
+			return entities.Realm{}, realmInTargetStatusOrNotFound(ctx, logger, repos.Repository, realmID, transition.to)
+		default:
+			logger.WithError(err).Error("failed to get realm from repository")
+			return entities.Realm{}, realmmgr_errors.NewInternalError("failed to get realm from repository", nil)
+		}
+	}
+
+	now := repos.Clock.Now()
+
+	currentRevision := realm.Revision
+
+	realm.Status = transition.to
+	realm.UpdatedAt = now
+	realm.Revision++
+
+	if updateErr := repos.Repository.UpdateRealm(ctx, realm, transition.from, currentRevision); updateErr != nil {
+		return entities.Realm{}, updateRealmError(logger, updateErr, "failed to update realm in repository")
+	}
+
+	auditRecord := entities.AuditRecord{
+		RealmID:   realmID,
+		Action:    transition.action,
+		Reason:    reason,
+		CreatedAt: now,
+	}
+	if auditErr := repos.AuditRepository.CreateAuditRecord(ctx, auditRecord); auditErr != nil {
+		logger.WithError(auditErr).Error("failed to create audit record in repository")
+		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to create audit record in repository", nil)
+	}
+
+	if eventErr := recordRealmEvent(ctx, logger, repos.Repository, realmID, transition.event, realm.Status, now); eventErr != nil {
+		return entities.Realm{}, eventErr
+	}
+
+	return realm, nil
+}
+
+// realmInTargetStatusOrNotFound builds the error returned when the realm could not be
+// found in the status it is transitioned from.
+func realmInTargetStatusOrNotFound(
+	ctx context.Context,
+	logger logging.Logger,
+	repository repositories.RealmManagerRepository,
+	realmID uuid.UUID,
+	target entities.Status,
+) error {
+	_, err := repository.GetRealm(ctx, realmID, target)
+	switch err.(type) {
+	case nil:
+		return realmmgr_errors.NewFailedPreconditionError(
+			fmt.Sprintf("realm with ID %s is already %s", realmID, statusNames[target]),
+			nil,
+		)
+	case *realmmgr_errors.NotFoundError:
+		return realmmgr_errors.NewNotFoundError(
+			fmt.Sprintf("realm with ID %s not found", realmID),
+			nil,
+		)
+	default:
+		logger.WithError(err).Error("failed to get realm from repository")
+		return realmmgr_errors.NewInternalError("failed to get realm from repository", nil)
+	}
+}
+
+var statusNames = map[entities.Status]string{
+	entities.StatusActive:   "active",
+	entities.StatusDraft:    "draft",
+	entities.StatusDisabled: "disabled",
+	entities.StatusDeleted:  "deleted",
+}
@@ -7,19 +7,56 @@ import (
 	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
 	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
 	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/attributeschema"
 	realmmgr_clock "github.com/alexZaicev/realm-mgr/internal/drivers/clock"
 	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
 )
 
 type UpdateRealmInput struct {
 	Realm entities.Realm
+	// UpdateMask lists the fields to be updated, the fields listed by
+	// entities.UnmaskedUpdateFields are updated when empty
+	UpdateMask []entities.RealmField
+	// ExpectedRevision of the realm being updated, not checked when zero
+	ExpectedRevision int64
 }
 
 func (i *UpdateRealmInput) Validate() error {
-	// TODO: add validation
+	for _, field := range i.UpdateMask {
+		if !field.IsKnown() {
+			return realmmgr_errors.NewInvalidArgumentError("updateMask", fmt.Sprintf("contains unknown path %s", field))
+		}
+		if !field.IsMutable() {
+			return realmmgr_errors.NewInvalidArgumentError("updateMask", fmt.Sprintf("contains immutable path %s", field))
+		}
+	}
+	if i.updatesField(entities.RealmFieldName) && i.Realm.Name == "" {
+		return realmmgr_errors.NewInvalidArgumentError("name", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.updatesField(entities.RealmFieldLabels) {
+		if err := validateLabels(i.Realm.Labels); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (i *UpdateRealmInput) updateMask() []entities.RealmField {
+	if len(i.UpdateMask) == 0 {
+		return entities.UnmaskedUpdateFields(i.Realm)
+	}
+	return i.UpdateMask
+}
+
+func (i *UpdateRealmInput) updatesField(field entities.RealmField) bool {
+	for _, maskField := range i.updateMask() {
+		if maskField == field {
+			return true
+		}
+	}
+	return false
+}
+
 type UpdateRealmRepos struct {
 	Logger     logging.Logger
 	Clock      realmmgr_clock.Clock
@@ -32,10 +69,23 @@ func (r *UpdateRealmRepos) Validate() error {
 }
 
 type UpdateRealm struct {
+	attributeValidator attributeschema.Validator
+	hierarchy          *RealmHierarchy
 }
 
-func NewUpdateRealm() *UpdateRealm {
-	return &UpdateRealm{}
+// NewUpdateRealm creates the use case updating realm drafts, with updated realm attributes
+// checked by attributeValidator and changed parents checked against hierarchy.
+func NewUpdateRealm(attributeValidator attributeschema.Validator, hierarchy *RealmHierarchy) (*UpdateRealm, error) {
+	if attributeValidator == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("attributeValidator", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if hierarchy == nil {
+		return nil, realmmgr_errors.NewInvalidArgumentError("hierarchy", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return &UpdateRealm{
+		attributeValidator: attributeValidator,
+		hierarchy:          hierarchy,
+	}, nil
 }
 
 func (r *UpdateRealm) UpdateRealm(ctx context.Context, repos UpdateRealmRepos, input UpdateRealmInput) (entities.Realm, error) {
@@ -43,7 +93,12 @@ func (r *UpdateRealm) UpdateRealm(ctx context.Context, repos UpdateRealmRepos, i
 		return entities.Realm{}, nil
 	}
 	if err := input.Validate(); err != nil {
-		return entities.Realm{}, nil
+		return entities.Realm{}, err
+	}
+	if input.updatesField(entities.RealmFieldAttributes) {
+		if err := r.attributeValidator.Validate(input.Realm.Attributes); err != nil {
+			return entities.Realm{}, err
+		}
 	}
 
 	logger := repos.Logger.WithFields(map[string]interface{}{
@@ -67,12 +122,38 @@ func (r *UpdateRealm) UpdateRealm(ctx context.Context, repos UpdateRealmRepos, i
 		}
 	}
 
+	if revisionErr := checkRealmRevision(draftRealm, input.ExpectedRevision); revisionErr != nil {
+		return entities.Realm{}, revisionErr
+	}
+
 	// update existing draft
-	draftRealm = draftRealm.Merge(input.Realm)
+	draftRevision := draftRealm.Revision
+
+	parentID := draftRealm.ParentID
+
+	draftRealm = draftRealm.MergeFields(input.Realm, input.updateMask())
+	draftRealm.Revision++
 
-	if updateErr := repos.Repository.UpdateRealm(ctx, draftRealm, draftRealm.Status); updateErr != nil {
-		logger.WithError(err).Error("failed to update realm in repository")
-		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to update realm in repository", nil)
+	if draftRealm.ParentID != parentID {
+		if parentErr := r.hierarchy.checkParent(ctx, logger, repos.Repository, draftRealm.ID, draftRealm.ParentID); parentErr != nil {
+			return entities.Realm{}, parentErr
+		}
+	}
+
+	if updateErr := repos.Repository.UpdateRealm(ctx, draftRealm, draftRealm.Status, draftRevision); updateErr != nil {
+		return entities.Realm{}, updateRealmError(logger, updateErr, "failed to update realm in repository")
+	}
+
+	if eventErr := recordRealmEvent(
+		ctx,
+		logger,
+		repos.Repository,
+		draftRealm.ID,
+		entities.RealmEventTypeUpdated,
+		draftRealm.Status,
+		now,
+	); eventErr != nil {
+		return entities.Realm{}, eventErr
 	}
 
 	return draftRealm, nil
@@ -98,12 +179,35 @@ func (r *UpdateRealm) createNewDraft(
 		}
 	}
 
-	draftRealm := activeRealm.Merge(input.Realm)
+	if revisionErr := checkRealmRevision(activeRealm, input.ExpectedRevision); revisionErr != nil {
+		return entities.Realm{}, revisionErr
+	}
+
+	draftRealm := activeRealm.MergeFields(input.Realm, input.updateMask())
 	draftRealm.Status = entities.StatusDraft
+	draftRealm.Revision++
+
+	if draftRealm.ParentID != activeRealm.ParentID {
+		if parentErr := r.hierarchy.checkParent(ctx, logger, repos.Repository, draftRealm.ID, draftRealm.ParentID); parentErr != nil {
+			return entities.Realm{}, parentErr
+		}
+	}
 
+	// a concurrent first edit of the realm creates its draft in the meantime
 	if createErr := repos.Repository.CreateRealm(ctx, draftRealm); createErr != nil {
-		logger.WithError(err).Error("failed to create draft realm in repository")
-		return entities.Realm{}, realmmgr_errors.NewInternalError("failed to create draft realm in repository", nil)
+		return entities.Realm{}, updateRealmError(logger, createErr, "failed to create draft realm in repository")
+	}
+
+	if eventErr := recordRealmEvent(
+		ctx,
+		logger,
+		repos.Repository,
+		draftRealm.ID,
+		entities.RealmEventTypeUpdated,
+		draftRealm.Status,
+		input.Realm.UpdatedAt,
+	); eventErr != nil {
+		return entities.Realm{}, eventErr
 	}
 
 	return draftRealm, nil
@@ -0,0 +1,131 @@
+package realms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realmmgr_errors "github.com/alexZaicev/realm-mgr/internal/domain/errors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/repositories"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/clock"
+	"github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+)
+
+type UpdateRealmTemplateInput struct {
+	TemplateID uuid.UUID
+	// ExpectedVersion is the latest version of the template the caller based the update on,
+	// the check is skipped when zero
+	ExpectedVersion int64
+
+	Name             string
+	Description      string
+	RealmDescription string
+	Labels           map[string]string
+	Attributes       map[string]interface{}
+	Parameters       []entities.RealmTemplateParameter
+	// UpdatedBy is the identity of the caller updating the template
+	UpdatedBy string
+}
+
+func (i *UpdateRealmTemplateInput) Validate() error {
+	if i.TemplateID == uuid.Nil {
+		return realmmgr_errors.NewInvalidArgumentError("templateID", realmmgr_errors.ErrMsgCannotBeBlank)
+	}
+	if i.ExpectedVersion < 0 {
+		return realmmgr_errors.NewInvalidArgumentError("expectedVersion", "cannot be negative")
+	}
+	return validateRealmTemplate(i.Name, i.RealmDescription, i.Labels, i.Attributes, i.Parameters)
+}
+
+type UpdateRealmTemplateRepos struct {
+	Logger logging.Logger
+
+	Clock clock.Clock
+
+	Repository repositories.RealmManagerRepository
+}
+
+func (r *UpdateRealmTemplateRepos) Validate() error {
+	if r.Logger == nil {
+		return realmmgr_errors.NewInvalidArgumentError("logger", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Clock == nil {
+		return realmmgr_errors.NewInvalidArgumentError("clock", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	if r.Repository == nil {
+		return realmmgr_errors.NewInvalidArgumentError("repository", realmmgr_errors.ErrMsgCannotBeNil)
+	}
+	return nil
+}
+
+type UpdateRealmTemplate struct {
+}
+
+func NewUpdateRealmTemplate() *UpdateRealmTemplate {
+	return &UpdateRealmTemplate{}
+}
+
+// UpdateRealmTemplate replaces the content of the template by storing it as a new version.
+// Realms created from earlier versions keep referencing them.
+func (r *UpdateRealmTemplate) UpdateRealmTemplate(
+	ctx context.Context,
+	repos UpdateRealmTemplateRepos,
+	input UpdateRealmTemplateInput,
+) (entities.RealmTemplate, error) {
+	if err := repos.Validate(); err != nil {
+		return entities.RealmTemplate{}, err
+	}
+	if err := input.Validate(); err != nil {
+		return entities.RealmTemplate{}, err
+	}
+
+	logger := repos.Logger.WithFields(map[string]interface{}{
+		"use-case":    "update-realm-template",
+		"template-id": input.TemplateID,
+	})
+
+	latest, err := getLiveRealmTemplate(ctx, logger, repos.Repository, input.TemplateID, 0)
+	if err != nil {
+		return entities.RealmTemplate{}, err
+	}
+
+	if input.ExpectedVersion != 0 && latest.Version != input.ExpectedVersion {
+		return entities.RealmTemplate{}, realmmgr_errors.NewAbortedError(
+			fmt.Sprintf(
+				"realm template with ID %s is at version %d, expected version %d",
+				input.TemplateID,
+				latest.Version,
+				input.ExpectedVersion,
+			),
+			nil,
+		)
+	}
+
+	template := entities.RealmTemplate{
+		ID:               input.TemplateID,
+		Version:          latest.Version + 1,
+		Name:             input.Name,
+		Description:      input.Description,
+		RealmDescription: input.RealmDescription,
+		Labels:           entities.CopyLabels(input.Labels),
+		Attributes:       entities.CopyAttributes(input.Attributes),
+		Parameters:       input.Parameters,
+		CreatedBy:        input.UpdatedBy,
+		CreatedAt:        repos.Clock.Now(),
+	}
+
+	if createErr := repos.Repository.CreateRealmTemplate(ctx, template); createErr != nil {
+		if _, ok := createErr.(*realmmgr_errors.AbortedError); ok {
+			return entities.RealmTemplate{}, createErr
+		}
+		logger.WithError(createErr).Error("failed to create realm template version in repository")
+		return entities.RealmTemplate{}, realmmgr_errors.NewInternalError(
+			"failed to create realm template version in repository",
+			nil,
+		)
+	}
+
+	return template, nil
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// DueReleaseClaimer is an autogenerated mock type for the DueReleaseClaimer type
+type DueReleaseClaimer struct {
+	mock.Mock
+}
+
+// ClaimDueRelease provides a mock function with given fields: ctx, repos, input
+func (_m *DueReleaseClaimer) ClaimDueRelease(ctx context.Context, repos realms.ClaimDueReleaseRepos, input realms.ClaimDueReleaseInput) (entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ClaimDueReleaseRepos, realms.ClaimDueReleaseInput) entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ClaimDueReleaseRepos, realms.ClaimDueReleaseInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDueReleaseClaimer interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDueReleaseClaimer creates a new instance of DueReleaseClaimer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDueReleaseClaimer(t mockConstructorTestingTNewDueReleaseClaimer) *DueReleaseClaimer {
+	mock := &DueReleaseClaimer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// DueReleaseLister is an autogenerated mock type for the DueReleaseLister type
+type DueReleaseLister struct {
+	mock.Mock
+}
+
+// ListDueReleases provides a mock function with given fields: ctx, repos, input
+func (_m *DueReleaseLister) ListDueReleases(ctx context.Context, repos realms.ListDueReleasesRepos, input realms.ListDueReleasesInput) ([]entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 []entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ListDueReleasesRepos, realms.ListDueReleasesInput) []entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ReleaseSchedule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ListDueReleasesRepos, realms.ListDueReleasesInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDueReleaseLister interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDueReleaseLister creates a new instance of DueReleaseLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDueReleaseLister(t mockConstructorTestingTNewDueReleaseLister) *DueReleaseLister {
+	mock := &DueReleaseLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
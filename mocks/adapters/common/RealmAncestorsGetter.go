@@ -0,0 +1,55 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmAncestorsGetter is an autogenerated mock type for the RealmAncestorsGetter type
+type RealmAncestorsGetter struct {
+	mock.Mock
+}
+
+// GetRealmAncestors provides a mock function with given fields: ctx, repos, input
+func (_m *RealmAncestorsGetter) GetRealmAncestors(ctx context.Context, repos realms.GetRealmAncestorsRepos, input realms.GetRealmAncestorsInput) ([]entities.Realm, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 []entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, realms.GetRealmAncestorsRepos, realms.GetRealmAncestorsInput) []entities.Realm); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Realm)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.GetRealmAncestorsRepos, realms.GetRealmAncestorsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmAncestorsGetter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmAncestorsGetter creates a new instance of RealmAncestorsGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmAncestorsGetter(t mockConstructorTestingTNewRealmAncestorsGetter) *RealmAncestorsGetter {
+	mock := &RealmAncestorsGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmApprovalRequester is an autogenerated mock type for the RealmApprovalRequester type
+type RealmApprovalRequester struct {
+	mock.Mock
+}
+
+// RequestReleaseApproval provides a mock function with given fields: ctx, repos, input
+func (_m *RealmApprovalRequester) RequestReleaseApproval(ctx context.Context, repos realms.RequestReleaseApprovalRepos, input realms.RequestReleaseApprovalInput) (entities.ReleaseApproval, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.ReleaseApproval
+	if rf, ok := ret.Get(0).(func(context.Context, realms.RequestReleaseApprovalRepos, realms.RequestReleaseApprovalInput) entities.ReleaseApproval); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseApproval)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.RequestReleaseApprovalRepos, realms.RequestReleaseApprovalInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmApprovalRequester interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmApprovalRequester creates a new instance of RealmApprovalRequester. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmApprovalRequester(t mockConstructorTestingTNewRealmApprovalRequester) *RealmApprovalRequester {
+	mock := &RealmApprovalRequester{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmBatchGetter is an autogenerated mock type for the RealmBatchGetter type
+type RealmBatchGetter struct {
+	mock.Mock
+}
+
+// BatchGetRealms provides a mock function with given fields: ctx, repos, input
+func (_m *RealmBatchGetter) BatchGetRealms(ctx context.Context, repos realms.BatchGetRealmsRepos, input realms.BatchGetRealmsInput) ([]entities.RealmBatchResult, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 []entities.RealmBatchResult
+	if rf, ok := ret.Get(0).(func(context.Context, realms.BatchGetRealmsRepos, realms.BatchGetRealmsInput) []entities.RealmBatchResult); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmBatchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.BatchGetRealmsRepos, realms.BatchGetRealmsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmBatchGetter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmBatchGetter creates a new instance of RealmBatchGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmBatchGetter(t mockConstructorTestingTNewRealmBatchGetter) *RealmBatchGetter {
+	mock := &RealmBatchGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,44 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RealmDeleter is an autogenerated mock type for the RealmDeleter type
+type RealmDeleter struct {
+	mock.Mock
+}
+
+// DeleteRealm provides a mock function with given fields: ctx, repos, input
+func (_m *RealmDeleter) DeleteRealm(ctx context.Context, repos realms.DeleteRealmRepos, input realms.DeleteRealmInput) error {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, realms.DeleteRealmRepos, realms.DeleteRealmInput) error); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRealmDeleter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmDeleter creates a new instance of RealmDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmDeleter(t mockConstructorTestingTNewRealmDeleter) *RealmDeleter {
+	mock := &RealmDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmDiffer is an autogenerated mock type for the RealmDiffer type
+type RealmDiffer struct {
+	mock.Mock
+}
+
+// DiffRealm provides a mock function with given fields: ctx, repos, input
+func (_m *RealmDiffer) DiffRealm(ctx context.Context, repos realms.DiffRealmRepos, input realms.DiffRealmInput) ([]entities.RealmFieldChange, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 []entities.RealmFieldChange
+	if rf, ok := ret.Get(0).(func(context.Context, realms.DiffRealmRepos, realms.DiffRealmInput) []entities.RealmFieldChange); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmFieldChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.DiffRealmRepos, realms.DiffRealmInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmDiffer interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmDiffer creates a new instance of RealmDiffer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmDiffer(t mockConstructorTestingTNewRealmDiffer) *RealmDiffer {
+	mock := &RealmDiffer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
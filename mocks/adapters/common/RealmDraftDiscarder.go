@@ -0,0 +1,44 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RealmDraftDiscarder is an autogenerated mock type for the RealmDraftDiscarder type
+type RealmDraftDiscarder struct {
+	mock.Mock
+}
+
+// DiscardDraft provides a mock function with given fields: ctx, repos, input
+func (_m *RealmDraftDiscarder) DiscardDraft(ctx context.Context, repos realms.DiscardDraftRepos, input realms.DiscardDraftInput) error {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, realms.DiscardDraftRepos, realms.DiscardDraftInput) error); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRealmDraftDiscarder interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmDraftDiscarder creates a new instance of RealmDraftDiscarder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmDraftDiscarder(t mockConstructorTestingTNewRealmDraftDiscarder) *RealmDraftDiscarder {
+	mock := &RealmDraftDiscarder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
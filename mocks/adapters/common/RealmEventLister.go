@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmEventLister is an autogenerated mock type for the RealmEventLister type
+type RealmEventLister struct {
+	mock.Mock
+}
+
+// ListRealmEvents provides a mock function with given fields: ctx, repos, input
+func (_m *RealmEventLister) ListRealmEvents(ctx context.Context, repos realms.ListRealmEventsRepos, input realms.ListRealmEventsInput) (entities.RealmEventPage, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmEventPage
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ListRealmEventsRepos, realms.ListRealmEventsInput) entities.RealmEventPage); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmEventPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ListRealmEventsRepos, realms.ListRealmEventsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmEventLister interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmEventLister creates a new instance of RealmEventLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmEventLister(t mockConstructorTestingTNewRealmEventLister) *RealmEventLister {
+	mock := &RealmEventLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmExporter is an autogenerated mock type for the RealmExporter type
+type RealmExporter struct {
+	mock.Mock
+}
+
+// ExportRealms provides a mock function with given fields: ctx, repos, input
+func (_m *RealmExporter) ExportRealms(ctx context.Context, repos realms.ExportRealmsRepos, input realms.ExportRealmsInput) (entities.RealmExportPage, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmExportPage
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ExportRealmsRepos, realms.ExportRealmsInput) entities.RealmExportPage); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmExportPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ExportRealmsRepos, realms.ExportRealmsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmExporter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmExporter creates a new instance of RealmExporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmExporter(t mockConstructorTestingTNewRealmExporter) *RealmExporter {
+	mock := &RealmExporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmImporter is an autogenerated mock type for the RealmImporter type
+type RealmImporter struct {
+	mock.Mock
+}
+
+// ImportRealms provides a mock function with given fields: ctx, repos, input
+func (_m *RealmImporter) ImportRealms(ctx context.Context, repos realms.ImportRealmsRepos, input realms.ImportRealmsInput) ([]entities.RealmImportResult, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 []entities.RealmImportResult
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ImportRealmsRepos, realms.ImportRealmsInput) []entities.RealmImportResult); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmImportResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ImportRealmsRepos, realms.ImportRealmsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmImporter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmImporter creates a new instance of RealmImporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmImporter(t mockConstructorTestingTNewRealmImporter) *RealmImporter {
+	mock := &RealmImporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmLister is an autogenerated mock type for the RealmLister type
+type RealmLister struct {
+	mock.Mock
+}
+
+// ListRealms provides a mock function with given fields: ctx, repos, input
+func (_m *RealmLister) ListRealms(ctx context.Context, repos realms.ListRealmsRepos, input realms.ListRealmsInput) (entities.RealmPage, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmPage
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ListRealmsRepos, realms.ListRealmsInput) entities.RealmPage); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ListRealmsRepos, realms.ListRealmsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmLister interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmLister creates a new instance of RealmLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmLister(t mockConstructorTestingTNewRealmLister) *RealmLister {
+	mock := &RealmLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
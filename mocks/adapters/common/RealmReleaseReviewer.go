@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmReleaseReviewer is an autogenerated mock type for the RealmReleaseReviewer type
+type RealmReleaseReviewer struct {
+	mock.Mock
+}
+
+// ReviewRelease provides a mock function with given fields: ctx, repos, input
+func (_m *RealmReleaseReviewer) ReviewRelease(ctx context.Context, repos realms.ReviewReleaseRepos, input realms.ReviewReleaseInput) (entities.ReleaseApproval, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.ReleaseApproval
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ReviewReleaseRepos, realms.ReviewReleaseInput) entities.ReleaseApproval); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseApproval)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ReviewReleaseRepos, realms.ReviewReleaseInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmReleaseReviewer interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmReleaseReviewer creates a new instance of RealmReleaseReviewer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmReleaseReviewer(t mockConstructorTestingTNewRealmReleaseReviewer) *RealmReleaseReviewer {
+	mock := &RealmReleaseReviewer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
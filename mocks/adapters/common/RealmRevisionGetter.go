@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmRevisionGetter is an autogenerated mock type for the RealmRevisionGetter type
+type RealmRevisionGetter struct {
+	mock.Mock
+}
+
+// GetRealmRevision provides a mock function with given fields: ctx, repos, input
+func (_m *RealmRevisionGetter) GetRealmRevision(ctx context.Context, repos realms.GetRealmRevisionRepos, input realms.GetRealmRevisionInput) (entities.RealmRevision, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmRevision
+	if rf, ok := ret.Get(0).(func(context.Context, realms.GetRealmRevisionRepos, realms.GetRealmRevisionInput) entities.RealmRevision); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmRevision)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.GetRealmRevisionRepos, realms.GetRealmRevisionInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmRevisionGetter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmRevisionGetter creates a new instance of RealmRevisionGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmRevisionGetter(t mockConstructorTestingTNewRealmRevisionGetter) *RealmRevisionGetter {
+	mock := &RealmRevisionGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
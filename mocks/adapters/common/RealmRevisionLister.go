@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmRevisionLister is an autogenerated mock type for the RealmRevisionLister type
+type RealmRevisionLister struct {
+	mock.Mock
+}
+
+// ListRealmRevisions provides a mock function with given fields: ctx, repos, input
+func (_m *RealmRevisionLister) ListRealmRevisions(ctx context.Context, repos realms.ListRealmRevisionsRepos, input realms.ListRealmRevisionsInput) (entities.RealmRevisionPage, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmRevisionPage
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ListRealmRevisionsRepos, realms.ListRealmRevisionsInput) entities.RealmRevisionPage); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmRevisionPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ListRealmRevisionsRepos, realms.ListRealmRevisionsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmRevisionLister interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmRevisionLister creates a new instance of RealmRevisionLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmRevisionLister(t mockConstructorTestingTNewRealmRevisionLister) *RealmRevisionLister {
+	mock := &RealmRevisionLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
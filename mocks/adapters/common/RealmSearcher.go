@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmSearcher is an autogenerated mock type for the RealmSearcher type
+type RealmSearcher struct {
+	mock.Mock
+}
+
+// SearchRealms provides a mock function with given fields: ctx, repos, input
+func (_m *RealmSearcher) SearchRealms(ctx context.Context, repos realms.SearchRealmsRepos, input realms.SearchRealmsInput) (entities.RealmSearchPage, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmSearchPage
+	if rf, ok := ret.Get(0).(func(context.Context, realms.SearchRealmsRepos, realms.SearchRealmsInput) entities.RealmSearchPage); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmSearchPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.SearchRealmsRepos, realms.SearchRealmsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmSearcher interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmSearcher creates a new instance of RealmSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmSearcher(t mockConstructorTestingTNewRealmSearcher) *RealmSearcher {
+	mock := &RealmSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmStatsGetter is an autogenerated mock type for the RealmStatsGetter type
+type RealmStatsGetter struct {
+	mock.Mock
+}
+
+// GetRealmStats provides a mock function with given fields: ctx, repos, input
+func (_m *RealmStatsGetter) GetRealmStats(ctx context.Context, repos realms.GetRealmStatsRepos, input realms.GetRealmStatsInput) (entities.RealmStats, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmStats
+	if rf, ok := ret.Get(0).(func(context.Context, realms.GetRealmStatsRepos, realms.GetRealmStatsInput) entities.RealmStats); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.GetRealmStatsRepos, realms.GetRealmStatsInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmStatsGetter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmStatsGetter creates a new instance of RealmStatsGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmStatsGetter(t mockConstructorTestingTNewRealmStatsGetter) *RealmStatsGetter {
+	mock := &RealmStatsGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
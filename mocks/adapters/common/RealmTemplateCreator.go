@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmTemplateCreator is an autogenerated mock type for the RealmTemplateCreator type
+type RealmTemplateCreator struct {
+	mock.Mock
+}
+
+// CreateRealmTemplate provides a mock function with given fields: ctx, repos, input
+func (_m *RealmTemplateCreator) CreateRealmTemplate(ctx context.Context, repos realms.CreateRealmTemplateRepos, input realms.CreateRealmTemplateInput) (entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, realms.CreateRealmTemplateRepos, realms.CreateRealmTemplateInput) entities.RealmTemplate); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.CreateRealmTemplateRepos, realms.CreateRealmTemplateInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmTemplateCreator interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmTemplateCreator creates a new instance of RealmTemplateCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmTemplateCreator(t mockConstructorTestingTNewRealmTemplateCreator) *RealmTemplateCreator {
+	mock := &RealmTemplateCreator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,44 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RealmTemplateDeleter is an autogenerated mock type for the RealmTemplateDeleter type
+type RealmTemplateDeleter struct {
+	mock.Mock
+}
+
+// DeleteRealmTemplate provides a mock function with given fields: ctx, repos, input
+func (_m *RealmTemplateDeleter) DeleteRealmTemplate(ctx context.Context, repos realms.DeleteRealmTemplateRepos, input realms.DeleteRealmTemplateInput) error {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, realms.DeleteRealmTemplateRepos, realms.DeleteRealmTemplateInput) error); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRealmTemplateDeleter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmTemplateDeleter creates a new instance of RealmTemplateDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmTemplateDeleter(t mockConstructorTestingTNewRealmTemplateDeleter) *RealmTemplateDeleter {
+	mock := &RealmTemplateDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
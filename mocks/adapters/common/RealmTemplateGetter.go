@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmTemplateGetter is an autogenerated mock type for the RealmTemplateGetter type
+type RealmTemplateGetter struct {
+	mock.Mock
+}
+
+// GetRealmTemplate provides a mock function with given fields: ctx, repos, input
+func (_m *RealmTemplateGetter) GetRealmTemplate(ctx context.Context, repos realms.GetRealmTemplateRepos, input realms.GetRealmTemplateInput) (entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, realms.GetRealmTemplateRepos, realms.GetRealmTemplateInput) entities.RealmTemplate); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.GetRealmTemplateRepos, realms.GetRealmTemplateInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmTemplateGetter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmTemplateGetter creates a new instance of RealmTemplateGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmTemplateGetter(t mockConstructorTestingTNewRealmTemplateGetter) *RealmTemplateGetter {
+	mock := &RealmTemplateGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
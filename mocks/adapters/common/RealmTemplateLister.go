@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// RealmTemplateLister is an autogenerated mock type for the RealmTemplateLister type
+type RealmTemplateLister struct {
+	mock.Mock
+}
+
+// ListRealmTemplates provides a mock function with given fields: ctx, repos, input
+func (_m *RealmTemplateLister) ListRealmTemplates(ctx context.Context, repos realms.ListRealmTemplatesRepos, input realms.ListRealmTemplatesInput) (entities.RealmTemplatePage, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.RealmTemplatePage
+	if rf, ok := ret.Get(0).(func(context.Context, realms.ListRealmTemplatesRepos, realms.ListRealmTemplatesInput) entities.RealmTemplatePage); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplatePage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.ListRealmTemplatesRepos, realms.ListRealmTemplatesInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmTemplateLister interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmTemplateLister creates a new instance of RealmTemplateLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmTemplateLister(t mockConstructorTestingTNewRealmTemplateLister) *RealmTemplateLister {
+	mock := &RealmTemplateLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+)
+
+// ReleaseFailureRecorder is an autogenerated mock type for the ReleaseFailureRecorder type
+type ReleaseFailureRecorder struct {
+	mock.Mock
+}
+
+// RecordReleaseFailure provides a mock function with given fields: ctx, repos, input
+func (_m *ReleaseFailureRecorder) RecordReleaseFailure(ctx context.Context, repos realms.RecordReleaseFailureRepos, input realms.RecordReleaseFailureInput) (entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, realms.RecordReleaseFailureRepos, realms.RecordReleaseFailureInput) entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, realms.RecordReleaseFailureRepos, realms.RecordReleaseFailureInput) error); ok {
+		r1 = rf(ctx, repos, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewReleaseFailureRecorder interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReleaseFailureRecorder creates a new instance of ReleaseFailureRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReleaseFailureRecorder(t mockConstructorTestingTNewReleaseFailureRecorder) *ReleaseFailureRecorder {
+	mock := &ReleaseFailureRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,44 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	realms "github.com/alexZaicev/realm-mgr/internal/usecases/realms"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScheduledReleaseCanceler is an autogenerated mock type for the ScheduledReleaseCanceler type
+type ScheduledReleaseCanceler struct {
+	mock.Mock
+}
+
+// CancelScheduledRelease provides a mock function with given fields: ctx, repos, input
+func (_m *ScheduledReleaseCanceler) CancelScheduledRelease(ctx context.Context, repos realms.CancelScheduledReleaseRepos, input realms.CancelScheduledReleaseInput) error {
+	ret := _m.Called(ctx, repos, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, realms.CancelScheduledReleaseRepos, realms.CancelScheduledReleaseInput) error); ok {
+		r0 = rf(ctx, repos, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewScheduledReleaseCanceler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewScheduledReleaseCanceler creates a new instance of ScheduledReleaseCanceler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewScheduledReleaseCanceler(t mockConstructorTestingTNewScheduledReleaseCanceler) *ScheduledReleaseCanceler {
+	mock := &ScheduledReleaseCanceler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -18,20 +20,80 @@ type RealmOps struct {
 	mock.Mock
 }
 
-// CreateRealm provides a mock function with given fields: ctx, logger, name, description
-func (_m *RealmOps) CreateRealm(ctx context.Context, logger logging.Logger, name string, description string) (entities.Realm, error) {
-	ret := _m.Called(ctx, logger, name, description)
+// BatchGetRealms provides a mock function with given fields: ctx, logger, realmIDs, status
+func (_m *RealmOps) BatchGetRealms(ctx context.Context, logger logging.Logger, realmIDs []uuid.UUID, status entities.Status) ([]entities.RealmBatchResult, error) {
+	ret := _m.Called(ctx, logger, realmIDs, status)
+
+	var r0 []entities.RealmBatchResult
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, []uuid.UUID, entities.Status) []entities.RealmBatchResult); ok {
+		r0 = rf(ctx, logger, realmIDs, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmBatchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, []uuid.UUID, entities.Status) error); ok {
+		r1 = rf(ctx, logger, realmIDs, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchMutateRealms provides a mock function with given fields: ctx, logger, mutations, actor, validateOnly
+func (_m *RealmOps) BatchMutateRealms(ctx context.Context, logger logging.Logger, mutations []entities.RealmMutation, actor string, validateOnly bool) ([]entities.Realm, error) {
+	ret := _m.Called(ctx, logger, mutations, actor, validateOnly)
+
+	var r0 []entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, []entities.RealmMutation, string, bool) []entities.Realm); ok {
+		r0 = rf(ctx, logger, mutations, actor, validateOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Realm)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, []entities.RealmMutation, string, bool) error); ok {
+		r1 = rf(ctx, logger, mutations, actor, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelScheduledRelease provides a mock function with given fields: ctx, logger, realmID, validateOnly
+func (_m *RealmOps) CancelScheduledRelease(ctx context.Context, logger logging.Logger, realmID uuid.UUID, validateOnly bool) error {
+	ret := _m.Called(ctx, logger, realmID, validateOnly)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, logger, realmID, validateOnly)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloneRealm provides a mock function with given fields: ctx, logger, sourceID, sourceStatus, name, validateOnly
+func (_m *RealmOps) CloneRealm(ctx context.Context, logger logging.Logger, sourceID uuid.UUID, sourceStatus entities.Status, name string, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, sourceID, sourceStatus, name, validateOnly)
 
 	var r0 entities.Realm
-	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, string, string) entities.Realm); ok {
-		r0 = rf(ctx, logger, name, description)
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, entities.Status, string, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, sourceID, sourceStatus, name, validateOnly)
 	} else {
 		r0 = ret.Get(0).(entities.Realm)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, string, string) error); ok {
-		r1 = rf(ctx, logger, name, description)
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, entities.Status, string, bool) error); ok {
+		r1 = rf(ctx, logger, sourceID, sourceStatus, name, validateOnly)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -39,17 +101,154 @@ func (_m *RealmOps) CreateRealm(ctx context.Context, logger logging.Logger, name
 	return r0, r1
 }
 
-// GetRealm provides a mock function with given fields: ctx, logger, realmID, status
-func (_m *RealmOps) GetRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, status entities.Status) (entities.Realm, error) {
-	ret := _m.Called(ctx, logger, realmID, status)
+// CreateRealm provides a mock function with given fields: ctx, logger, name, slug, description, labels, attributes, parentID, validateOnly
+func (_m *RealmOps) CreateRealm(ctx context.Context, logger logging.Logger, name string, slug string, description string, labels map[string]string, attributes map[string]interface{}, parentID uuid.UUID, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, name, slug, description, labels, attributes, parentID, validateOnly)
 
 	var r0 entities.Realm
-	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, entities.Status) entities.Realm); ok {
-		r0 = rf(ctx, logger, realmID, status)
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, string, string, string, map[string]string, map[string]interface{}, uuid.UUID, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, name, slug, description, labels, attributes, parentID, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.Realm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, string, string, string, map[string]string, map[string]interface{}, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, logger, name, slug, description, labels, attributes, parentID, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealm provides a mock function with given fields: ctx, logger, realmID, force, cascade, reason, validateOnly
+func (_m *RealmOps) DeleteRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, force bool, cascade bool, reason string, validateOnly bool) error {
+	ret := _m.Called(ctx, logger, realmID, force, cascade, reason, validateOnly)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, bool, bool, string, bool) error); ok {
+		r0 = rf(ctx, logger, realmID, force, cascade, reason, validateOnly)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiffRealm provides a mock function with given fields: ctx, logger, realmID, from, to
+func (_m *RealmOps) DiffRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, from entities.RealmVersion, to entities.RealmVersion) ([]entities.RealmFieldChange, error) {
+	ret := _m.Called(ctx, logger, realmID, from, to)
+
+	var r0 []entities.RealmFieldChange
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, entities.RealmVersion, entities.RealmVersion) []entities.RealmFieldChange); ok {
+		r0 = rf(ctx, logger, realmID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmFieldChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, entities.RealmVersion, entities.RealmVersion) error); ok {
+		r1 = rf(ctx, logger, realmID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableRealm provides a mock function with given fields: ctx, logger, realmID, reason, cascade, validateOnly
+func (_m *RealmOps) DisableRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, reason string, cascade bool, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realmID, reason, cascade, validateOnly)
+
+	var r0 entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, string, bool, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, realmID, reason, cascade, validateOnly)
 	} else {
 		r0 = ret.Get(0).(entities.Realm)
 	}
 
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, string, bool, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, reason, cascade, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscardDraft provides a mock function with given fields: ctx, logger, realmID, deleteRealm, validateOnly
+func (_m *RealmOps) DiscardDraft(ctx context.Context, logger logging.Logger, realmID uuid.UUID, deleteRealm bool, validateOnly bool) error {
+	ret := _m.Called(ctx, logger, realmID, deleteRealm, validateOnly)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, bool, bool) error); ok {
+		r0 = rf(ctx, logger, realmID, deleteRealm, validateOnly)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableRealm provides a mock function with given fields: ctx, logger, realmID, reason, validateOnly
+func (_m *RealmOps) EnableRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, reason string, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realmID, reason, validateOnly)
+
+	var r0 entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, string, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, realmID, reason, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.Realm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, string, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, reason, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealm provides a mock function with given fields: ctx, logger, realmID, status, view
+func (_m *RealmOps) GetRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, status entities.Status, view entities.RealmView) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realmID, status, view)
+
+	var r0 entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, entities.Status, entities.RealmView) entities.Realm); ok {
+		r0 = rf(ctx, logger, realmID, status, view)
+	} else {
+		r0 = ret.Get(0).(entities.Realm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, entities.Status, entities.RealmView) error); ok {
+		r1 = rf(ctx, logger, realmID, status, view)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmAncestors provides a mock function with given fields: ctx, logger, realmID, status
+func (_m *RealmOps) GetRealmAncestors(ctx context.Context, logger logging.Logger, realmID uuid.UUID, status entities.Status) ([]entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realmID, status)
+
+	var r0 []entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, entities.Status) []entities.Realm); ok {
+		r0 = rf(ctx, logger, realmID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Realm)
+		}
+	}
+
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, entities.Status) error); ok {
 		r1 = rf(ctx, logger, realmID, status)
@@ -60,20 +259,293 @@ func (_m *RealmOps) GetRealm(ctx context.Context, logger logging.Logger, realmID
 	return r0, r1
 }
 
-// ReleaseRealm provides a mock function with given fields: ctx, logger, realmID
-func (_m *RealmOps) ReleaseRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID) (entities.Realm, error) {
-	ret := _m.Called(ctx, logger, realmID)
+// GetRealmBySlug provides a mock function with given fields: ctx, logger, slug, status, view
+func (_m *RealmOps) GetRealmBySlug(ctx context.Context, logger logging.Logger, slug string, status entities.Status, view entities.RealmView) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, slug, status, view)
 
 	var r0 entities.Realm
-	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID) entities.Realm); ok {
-		r0 = rf(ctx, logger, realmID)
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, string, entities.Status, entities.RealmView) entities.Realm); ok {
+		r0 = rf(ctx, logger, slug, status, view)
 	} else {
 		r0 = ret.Get(0).(entities.Realm)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID) error); ok {
-		r1 = rf(ctx, logger, realmID)
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, string, entities.Status, entities.RealmView) error); ok {
+		r1 = rf(ctx, logger, slug, status, view)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmRevision provides a mock function with given fields: ctx, logger, realmID, revision, asOf
+func (_m *RealmOps) GetRealmRevision(ctx context.Context, logger logging.Logger, realmID uuid.UUID, revision int64, asOf time.Time) (entities.RealmRevision, error) {
+	ret := _m.Called(ctx, logger, realmID, revision, asOf)
+
+	var r0 entities.RealmRevision
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, int64, time.Time) entities.RealmRevision); ok {
+		r0 = rf(ctx, logger, realmID, revision, asOf)
+	} else {
+		r0 = ret.Get(0).(entities.RealmRevision)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, int64, time.Time) error); ok {
+		r1 = rf(ctx, logger, realmID, revision, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmStats provides a mock function with given fields: ctx, logger, labelSelector, createdAfter, createdBefore, staleDraftDays
+func (_m *RealmOps) GetRealmStats(ctx context.Context, logger logging.Logger, labelSelector string, createdAfter time.Time, createdBefore time.Time, staleDraftDays int) (entities.RealmStats, error) {
+	ret := _m.Called(ctx, logger, labelSelector, createdAfter, createdBefore, staleDraftDays)
+
+	var r0 entities.RealmStats
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, string, time.Time, time.Time, int) entities.RealmStats); ok {
+		r0 = rf(ctx, logger, labelSelector, createdAfter, createdBefore, staleDraftDays)
+	} else {
+		r0 = ret.Get(0).(entities.RealmStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, logger, labelSelector, createdAfter, createdBefore, staleDraftDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChildRealms provides a mock function with given fields: ctx, logger, parentID, status, pageSize, pageToken
+func (_m *RealmOps) ListChildRealms(ctx context.Context, logger logging.Logger, parentID uuid.UUID, status entities.Status, pageSize int, pageToken string) (entities.RealmPage, error) {
+	ret := _m.Called(ctx, logger, parentID, status, pageSize, pageToken)
+
+	var r0 entities.RealmPage
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, entities.Status, int, string) entities.RealmPage); ok {
+		r0 = rf(ctx, logger, parentID, status, pageSize, pageToken)
+	} else {
+		r0 = ret.Get(0).(entities.RealmPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, entities.Status, int, string) error); ok {
+		r1 = rf(ctx, logger, parentID, status, pageSize, pageToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmRevisions provides a mock function with given fields: ctx, logger, realmID, pageSize, pageToken
+func (_m *RealmOps) ListRealmRevisions(ctx context.Context, logger logging.Logger, realmID uuid.UUID, pageSize int, pageToken string) (entities.RealmRevisionPage, error) {
+	ret := _m.Called(ctx, logger, realmID, pageSize, pageToken)
+
+	var r0 entities.RealmRevisionPage
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, int, string) entities.RealmRevisionPage); ok {
+		r0 = rf(ctx, logger, realmID, pageSize, pageToken)
+	} else {
+		r0 = ret.Get(0).(entities.RealmRevisionPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, int, string) error); ok {
+		r1 = rf(ctx, logger, realmID, pageSize, pageToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealms provides a mock function with given fields: ctx, logger, status, sorting, pageSize, pageToken, labelSelector
+func (_m *RealmOps) ListRealms(ctx context.Context, logger logging.Logger, status entities.Status, sorting entities.RealmSorting, pageSize int, pageToken string, labelSelector string) (entities.RealmPage, error) {
+	ret := _m.Called(ctx, logger, status, sorting, pageSize, pageToken, labelSelector)
+
+	var r0 entities.RealmPage
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, entities.Status, entities.RealmSorting, int, string, string) entities.RealmPage); ok {
+		r0 = rf(ctx, logger, status, sorting, pageSize, pageToken, labelSelector)
+	} else {
+		r0 = ret.Get(0).(entities.RealmPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, entities.Status, entities.RealmSorting, int, string, string) error); ok {
+		r1 = rf(ctx, logger, status, sorting, pageSize, pageToken, labelSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseRealm provides a mock function with given fields: ctx, logger, realmID, expectedRevision, releasedBy, validateOnly
+func (_m *RealmOps) ReleaseRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, expectedRevision int64, releasedBy string, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realmID, expectedRevision, releasedBy, validateOnly)
+
+	var r0 entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, int64, string, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, realmID, expectedRevision, releasedBy, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.Realm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, int64, string, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, expectedRevision, releasedBy, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenameRealmSlug provides a mock function with given fields: ctx, logger, realmID, slug, validateOnly
+func (_m *RealmOps) RenameRealmSlug(ctx context.Context, logger logging.Logger, realmID uuid.UUID, slug string, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realmID, slug, validateOnly)
+
+	var r0 entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, string, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, realmID, slug, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.Realm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, string, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, slug, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestReleaseApproval provides a mock function with given fields: ctx, logger, realmID, requestedBy, validateOnly
+func (_m *RealmOps) RequestReleaseApproval(ctx context.Context, logger logging.Logger, realmID uuid.UUID, requestedBy string, validateOnly bool) (entities.ReleaseApproval, error) {
+	ret := _m.Called(ctx, logger, realmID, requestedBy, validateOnly)
+
+	var r0 entities.ReleaseApproval
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, string, bool) entities.ReleaseApproval); ok {
+		r0 = rf(ctx, logger, realmID, requestedBy, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseApproval)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, string, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, requestedBy, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreRealm provides a mock function with given fields: ctx, logger, realmID, reason, validateOnly
+func (_m *RealmOps) RestoreRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, reason string, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realmID, reason, validateOnly)
+
+	var r0 entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, string, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, realmID, reason, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.Realm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, string, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, reason, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewRelease provides a mock function with given fields: ctx, logger, realmID, reviewer, decision, expectedRevision, comment, validateOnly
+func (_m *RealmOps) ReviewRelease(ctx context.Context, logger logging.Logger, realmID uuid.UUID, reviewer string, decision entities.ApprovalDecision, expectedRevision int64, comment string, validateOnly bool) (entities.ReleaseApproval, error) {
+	ret := _m.Called(ctx, logger, realmID, reviewer, decision, expectedRevision, comment, validateOnly)
+
+	var r0 entities.ReleaseApproval
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, string, entities.ApprovalDecision, int64, string, bool) entities.ReleaseApproval); ok {
+		r0 = rf(ctx, logger, realmID, reviewer, decision, expectedRevision, comment, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseApproval)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, string, entities.ApprovalDecision, int64, string, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, reviewer, decision, expectedRevision, comment, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackRealm provides a mock function with given fields: ctx, logger, realmID, revision, reason, validateOnly
+func (_m *RealmOps) RollbackRealm(ctx context.Context, logger logging.Logger, realmID uuid.UUID, revision int64, reason string, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realmID, revision, reason, validateOnly)
+
+	var r0 entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, int64, string, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, realmID, revision, reason, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.Realm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, int64, string, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, revision, reason, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleRelease provides a mock function with given fields: ctx, logger, realmID, releaseAt, scheduledBy, validateOnly
+func (_m *RealmOps) ScheduleRelease(ctx context.Context, logger logging.Logger, realmID uuid.UUID, releaseAt time.Time, scheduledBy string, validateOnly bool) (entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, logger, realmID, releaseAt, scheduledBy, validateOnly)
+
+	var r0 entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, time.Time, string, bool) entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, logger, realmID, releaseAt, scheduledBy, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, time.Time, string, bool) error); ok {
+		r1 = rf(ctx, logger, realmID, releaseAt, scheduledBy, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRealms provides a mock function with given fields: ctx, logger, query, status, pageSize, pageToken
+func (_m *RealmOps) SearchRealms(ctx context.Context, logger logging.Logger, query string, status entities.Status, pageSize int, pageToken string) (entities.RealmSearchPage, error) {
+	ret := _m.Called(ctx, logger, query, status, pageSize, pageToken)
+
+	var r0 entities.RealmSearchPage
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, string, entities.Status, int, string) entities.RealmSearchPage); ok {
+		r0 = rf(ctx, logger, query, status, pageSize, pageToken)
+	} else {
+		r0 = ret.Get(0).(entities.RealmSearchPage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, string, entities.Status, int, string) error); ok {
+		r1 = rf(ctx, logger, query, status, pageSize, pageToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,20 +553,20 @@ func (_m *RealmOps) ReleaseRealm(ctx context.Context, logger logging.Logger, rea
 	return r0, r1
 }
 
-// UpdateRealm provides a mock function with given fields: ctx, logger, realm
-func (_m *RealmOps) UpdateRealm(ctx context.Context, logger logging.Logger, realm entities.Realm) (entities.Realm, error) {
-	ret := _m.Called(ctx, logger, realm)
+// UpdateRealm provides a mock function with given fields: ctx, logger, realm, updateMask, expectedRevision, validateOnly
+func (_m *RealmOps) UpdateRealm(ctx context.Context, logger logging.Logger, realm entities.Realm, updateMask []entities.RealmField, expectedRevision int64, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, realm, updateMask, expectedRevision, validateOnly)
 
 	var r0 entities.Realm
-	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, entities.Realm) entities.Realm); ok {
-		r0 = rf(ctx, logger, realm)
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, entities.Realm, []entities.RealmField, int64, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, realm, updateMask, expectedRevision, validateOnly)
 	} else {
 		r0 = ret.Get(0).(entities.Realm)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, entities.Realm) error); ok {
-		r1 = rf(ctx, logger, realm)
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, entities.Realm, []entities.RealmField, int64, bool) error); ok {
+		r1 = rf(ctx, logger, realm, updateMask, expectedRevision, validateOnly)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,153 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	logging "github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RealmTemplateOps is an autogenerated mock type for the RealmTemplateOps type
+type RealmTemplateOps struct {
+	mock.Mock
+}
+
+// CreateRealmFromTemplate provides a mock function with given fields: ctx, logger, templateID, templateVersion, name, parameters, parentID, validateOnly
+func (_m *RealmTemplateOps) CreateRealmFromTemplate(ctx context.Context, logger logging.Logger, templateID uuid.UUID, templateVersion int64, name string, parameters map[string]string, parentID uuid.UUID, validateOnly bool) (entities.Realm, error) {
+	ret := _m.Called(ctx, logger, templateID, templateVersion, name, parameters, parentID, validateOnly)
+
+	var r0 entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, int64, string, map[string]string, uuid.UUID, bool) entities.Realm); ok {
+		r0 = rf(ctx, logger, templateID, templateVersion, name, parameters, parentID, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.Realm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, int64, string, map[string]string, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, logger, templateID, templateVersion, name, parameters, parentID, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRealmTemplate provides a mock function with given fields: ctx, logger, template, validateOnly
+func (_m *RealmTemplateOps) CreateRealmTemplate(ctx context.Context, logger logging.Logger, template entities.RealmTemplate, validateOnly bool) (entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, logger, template, validateOnly)
+
+	var r0 entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, entities.RealmTemplate, bool) entities.RealmTemplate); ok {
+		r0 = rf(ctx, logger, template, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, entities.RealmTemplate, bool) error); ok {
+		r1 = rf(ctx, logger, template, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealmTemplate provides a mock function with given fields: ctx, logger, templateID, validateOnly
+func (_m *RealmTemplateOps) DeleteRealmTemplate(ctx context.Context, logger logging.Logger, templateID uuid.UUID, validateOnly bool) error {
+	ret := _m.Called(ctx, logger, templateID, validateOnly)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, bool) error); ok {
+		r0 = rf(ctx, logger, templateID, validateOnly)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRealmTemplate provides a mock function with given fields: ctx, logger, templateID, version
+func (_m *RealmTemplateOps) GetRealmTemplate(ctx context.Context, logger logging.Logger, templateID uuid.UUID, version int64) (entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, logger, templateID, version)
+
+	var r0 entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, int64) entities.RealmTemplate); ok {
+		r0 = rf(ctx, logger, templateID, version)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, logger, templateID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmTemplates provides a mock function with given fields: ctx, logger, pageSize, pageToken
+func (_m *RealmTemplateOps) ListRealmTemplates(ctx context.Context, logger logging.Logger, pageSize int, pageToken string) (entities.RealmTemplatePage, error) {
+	ret := _m.Called(ctx, logger, pageSize, pageToken)
+
+	var r0 entities.RealmTemplatePage
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, int, string) entities.RealmTemplatePage); ok {
+		r0 = rf(ctx, logger, pageSize, pageToken)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplatePage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, int, string) error); ok {
+		r1 = rf(ctx, logger, pageSize, pageToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRealmTemplate provides a mock function with given fields: ctx, logger, template, expectedVersion, validateOnly
+func (_m *RealmTemplateOps) UpdateRealmTemplate(ctx context.Context, logger logging.Logger, template entities.RealmTemplate, expectedVersion int64, validateOnly bool) (entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, logger, template, expectedVersion, validateOnly)
+
+	var r0 entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, entities.RealmTemplate, int64, bool) entities.RealmTemplate); ok {
+		r0 = rf(ctx, logger, template, expectedVersion, validateOnly)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, entities.RealmTemplate, int64, bool) error); ok {
+		r1 = rf(ctx, logger, template, expectedVersion, validateOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmTemplateOps interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmTemplateOps creates a new instance of RealmTemplateOps. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmTemplateOps(t mockConstructorTestingTNewRealmTemplateOps) *RealmTemplateOps {
+	mock := &RealmTemplateOps{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
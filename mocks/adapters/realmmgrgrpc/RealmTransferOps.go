@@ -0,0 +1,67 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	logging "github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RealmTransferOps is an autogenerated mock type for the RealmTransferOps type
+type RealmTransferOps struct {
+	mock.Mock
+}
+
+// ExportRealms provides a mock function with given fields: ctx, logger, includeDrafts, send
+func (_m *RealmTransferOps) ExportRealms(ctx context.Context, logger logging.Logger, includeDrafts bool, send func(entities.RealmExportRecord) error) error {
+	ret := _m.Called(ctx, logger, includeDrafts, send)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, bool, func(entities.RealmExportRecord) error) error); ok {
+		r0 = rf(ctx, logger, includeDrafts, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ImportRealms provides a mock function with given fields: ctx, logger, conflictPolicy, validateOnly, recv
+func (_m *RealmTransferOps) ImportRealms(ctx context.Context, logger logging.Logger, conflictPolicy entities.ImportConflictPolicy, validateOnly bool, recv func() (entities.RealmExportRecord, error)) (entities.RealmImportSummary, error) {
+	ret := _m.Called(ctx, logger, conflictPolicy, validateOnly, recv)
+
+	var r0 entities.RealmImportSummary
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, entities.ImportConflictPolicy, bool, func() (entities.RealmExportRecord, error)) entities.RealmImportSummary); ok {
+		r0 = rf(ctx, logger, conflictPolicy, validateOnly, recv)
+	} else {
+		r0 = ret.Get(0).(entities.RealmImportSummary)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, logging.Logger, entities.ImportConflictPolicy, bool, func() (entities.RealmExportRecord, error)) error); ok {
+		r1 = rf(ctx, logger, conflictPolicy, validateOnly, recv)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmTransferOps interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmTransferOps creates a new instance of RealmTransferOps. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmTransferOps(t mockConstructorTestingTNewRealmTransferOps) *RealmTransferOps {
+	mock := &RealmTransferOps{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
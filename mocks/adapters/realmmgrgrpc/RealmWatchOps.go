@@ -0,0 +1,48 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	logging "github.com/alexZaicev/realm-mgr/internal/drivers/logging"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RealmWatchOps is an autogenerated mock type for the RealmWatchOps type
+type RealmWatchOps struct {
+	mock.Mock
+}
+
+// WatchRealms provides a mock function with given fields: ctx, logger, realmID, status, sequenceToken, send
+func (_m *RealmWatchOps) WatchRealms(ctx context.Context, logger logging.Logger, realmID uuid.UUID, status entities.Status, sequenceToken string, send func(entities.WatchedRealmEvent) error) error {
+	ret := _m.Called(ctx, logger, realmID, status, sequenceToken, send)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, logging.Logger, uuid.UUID, entities.Status, string, func(entities.WatchedRealmEvent) error) error); ok {
+		r0 = rf(ctx, logger, realmID, status, sequenceToken, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRealmWatchOps interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmWatchOps creates a new instance of RealmWatchOps. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmWatchOps(t mockConstructorTestingTNewRealmWatchOps) *RealmWatchOps {
+	mock := &RealmWatchOps{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RealmEventRepository is an autogenerated mock type for the RealmEventRepository type
+type RealmEventRepository struct {
+	mock.Mock
+}
+
+// CreateRealmEvent provides a mock function with given fields: ctx, event
+func (_m *RealmEventRepository) CreateRealmEvent(ctx context.Context, event entities.RealmEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetLatestRealmEventCursor provides a mock function with given fields: ctx
+func (_m *RealmEventRepository) GetLatestRealmEventCursor(ctx context.Context) (entities.RealmEventCursor, error) {
+	ret := _m.Called(ctx)
+
+	var r0 entities.RealmEventCursor
+	if rf, ok := ret.Get(0).(func(context.Context) entities.RealmEventCursor); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entities.RealmEventCursor)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmEvents provides a mock function with given fields: ctx, options
+func (_m *RealmEventRepository) ListRealmEvents(ctx context.Context, options entities.ListRealmEventsOptions) ([]entities.RealmEvent, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.RealmEvent
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ListRealmEventsOptions) []entities.RealmEvent); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ListRealmEventsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmEventRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmEventRepository creates a new instance of RealmEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmEventRepository(t mockConstructorTestingTNewRealmEventRepository) *RealmEventRepository {
+	mock := &RealmEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,13 +2,32 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // RealmManagerAuditRepository is an autogenerated mock type for the RealmManagerAuditRepository type
 type RealmManagerAuditRepository struct {
 	mock.Mock
 }
 
+// CreateAuditRecord provides a mock function with given fields: ctx, record
+func (_m *RealmManagerAuditRepository) CreateAuditRecord(ctx context.Context, record entities.AuditRecord) error {
+	ret := _m.Called(ctx, record)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.AuditRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewRealmManagerAuditRepository interface {
 	mock.TestingT
 	Cleanup(func())
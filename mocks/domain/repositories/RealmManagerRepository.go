@@ -8,6 +8,8 @@ import (
 	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -16,6 +18,27 @@ type RealmManagerRepository struct {
 	mock.Mock
 }
 
+// ClaimDueReleaseSchedule provides a mock function with given fields: ctx, realmID, dueAt
+func (_m *RealmManagerRepository) ClaimDueReleaseSchedule(ctx context.Context, realmID uuid.UUID, dueAt time.Time) (entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, realmID, dueAt)
+
+	var r0 entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, realmID, dueAt)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, realmID, dueAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateRealm provides a mock function with given fields: ctx, realm
 func (_m *RealmManagerRepository) CreateRealm(ctx context.Context, realm entities.Realm) error {
 	ret := _m.Called(ctx, realm)
@@ -30,6 +53,62 @@ func (_m *RealmManagerRepository) CreateRealm(ctx context.Context, realm entitie
 	return r0
 }
 
+// CreateRealmEvent provides a mock function with given fields: ctx, event
+func (_m *RealmManagerRepository) CreateRealmEvent(ctx context.Context, event entities.RealmEvent) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateRealmRevision provides a mock function with given fields: ctx, revision
+func (_m *RealmManagerRepository) CreateRealmRevision(ctx context.Context, revision entities.RealmRevision) error {
+	ret := _m.Called(ctx, revision)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmRevision) error); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateRealmSlug provides a mock function with given fields: ctx, slug
+func (_m *RealmManagerRepository) CreateRealmSlug(ctx context.Context, slug entities.RealmSlug) error {
+	ret := _m.Called(ctx, slug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmSlug) error); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateRealmTemplate provides a mock function with given fields: ctx, template
+func (_m *RealmManagerRepository) CreateRealmTemplate(ctx context.Context, template entities.RealmTemplate) error {
+	ret := _m.Called(ctx, template)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmTemplate) error); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRealm provides a mock function with given fields: ctx, realmID, statuses
 func (_m *RealmManagerRepository) DeleteRealm(ctx context.Context, realmID uuid.UUID, statuses ...entities.Status) error {
 	_va := make([]interface{}, len(statuses))
@@ -51,6 +130,153 @@ func (_m *RealmManagerRepository) DeleteRealm(ctx context.Context, realmID uuid.
 	return r0
 }
 
+// DeleteRealmAtRevision provides a mock function with given fields: ctx, realmID, status, revision
+func (_m *RealmManagerRepository) DeleteRealmAtRevision(ctx context.Context, realmID uuid.UUID, status entities.Status, revision int64) error {
+	ret := _m.Called(ctx, realmID, status, revision)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entities.Status, int64) error); ok {
+		r0 = rf(ctx, realmID, status, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRealmSlug provides a mock function with given fields: ctx, slug
+func (_m *RealmManagerRepository) DeleteRealmSlug(ctx context.Context, slug string) (int64, error) {
+	ret := _m.Called(ctx, slug)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealmSlugs provides a mock function with given fields: ctx, realmID
+func (_m *RealmManagerRepository) DeleteRealmSlugs(ctx context.Context, realmID uuid.UUID) error {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRealmTemplate provides a mock function with given fields: ctx, templateID, deletedAt
+func (_m *RealmManagerRepository) DeleteRealmTemplate(ctx context.Context, templateID uuid.UUID, deletedAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, templateID, deletedAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) int64); ok {
+		r0 = rf(ctx, templateID, deletedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, templateID, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReleaseApproval provides a mock function with given fields: ctx, realmID
+func (_m *RealmManagerRepository) DeleteReleaseApproval(ctx context.Context, realmID uuid.UUID) error {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteReleaseSchedule provides a mock function with given fields: ctx, realmID
+func (_m *RealmManagerRepository) DeleteReleaseSchedule(ctx context.Context, realmID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, realmID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpireRealmSlug provides a mock function with given fields: ctx, slug, expiresAt
+func (_m *RealmManagerRepository) ExpireRealmSlug(ctx context.Context, slug string, expiresAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, slug, expiresAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) int64); ok {
+		r0 = rf(ctx, slug, expiresAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, slug, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestRealmEventCursor provides a mock function with given fields: ctx
+func (_m *RealmManagerRepository) GetLatestRealmEventCursor(ctx context.Context) (entities.RealmEventCursor, error) {
+	ret := _m.Called(ctx)
+
+	var r0 entities.RealmEventCursor
+	if rf, ok := ret.Get(0).(func(context.Context) entities.RealmEventCursor); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entities.RealmEventCursor)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRealm provides a mock function with given fields: ctx, realmID, status
 func (_m *RealmManagerRepository) GetRealm(ctx context.Context, realmID uuid.UUID, status entities.Status) (entities.Realm, error) {
 	ret := _m.Called(ctx, realmID, status)
@@ -72,13 +298,458 @@ func (_m *RealmManagerRepository) GetRealm(ctx context.Context, realmID uuid.UUI
 	return r0, r1
 }
 
-// UpdateRealm provides a mock function with given fields: ctx, realm, currentStatus
-func (_m *RealmManagerRepository) UpdateRealm(ctx context.Context, realm entities.Realm, currentStatus entities.Status) error {
-	ret := _m.Called(ctx, realm, currentStatus)
+// GetRealmRevision provides a mock function with given fields: ctx, realmID, revision
+func (_m *RealmManagerRepository) GetRealmRevision(ctx context.Context, realmID uuid.UUID, revision int64) (entities.RealmRevision, error) {
+	ret := _m.Called(ctx, realmID, revision)
+
+	var r0 entities.RealmRevision
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) entities.RealmRevision); ok {
+		r0 = rf(ctx, realmID, revision)
+	} else {
+		r0 = ret.Get(0).(entities.RealmRevision)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, realmID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmRevisionAt provides a mock function with given fields: ctx, realmID, at
+func (_m *RealmManagerRepository) GetRealmRevisionAt(ctx context.Context, realmID uuid.UUID, at time.Time) (entities.RealmRevision, error) {
+	ret := _m.Called(ctx, realmID, at)
+
+	var r0 entities.RealmRevision
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) entities.RealmRevision); ok {
+		r0 = rf(ctx, realmID, at)
+	} else {
+		r0 = ret.Get(0).(entities.RealmRevision)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, realmID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmSlug provides a mock function with given fields: ctx, slug
+func (_m *RealmManagerRepository) GetRealmSlug(ctx context.Context, slug string) (entities.RealmSlug, error) {
+	ret := _m.Called(ctx, slug)
+
+	var r0 entities.RealmSlug
+	if rf, ok := ret.Get(0).(func(context.Context, string) entities.RealmSlug); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(entities.RealmSlug)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmStats provides a mock function with given fields: ctx, options
+func (_m *RealmManagerRepository) GetRealmStats(ctx context.Context, options entities.RealmStatsOptions) (entities.RealmStats, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 entities.RealmStats
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmStatsOptions) entities.RealmStats); ok {
+		r0 = rf(ctx, options)
+	} else {
+		r0 = ret.Get(0).(entities.RealmStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.RealmStatsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmTemplate provides a mock function with given fields: ctx, templateID, version
+func (_m *RealmManagerRepository) GetRealmTemplate(ctx context.Context, templateID uuid.UUID, version int64) (entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, templateID, version)
+
+	var r0 entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) entities.RealmTemplate); ok {
+		r0 = rf(ctx, templateID, version)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, templateID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealms provides a mock function with given fields: ctx, realmIDs, status
+func (_m *RealmManagerRepository) GetRealms(ctx context.Context, realmIDs []uuid.UUID, status entities.Status) ([]entities.Realm, error) {
+	ret := _m.Called(ctx, realmIDs, status)
+
+	var r0 []entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, entities.Status) []entities.Realm); ok {
+		r0 = rf(ctx, realmIDs, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Realm)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, entities.Status) error); ok {
+		r1 = rf(ctx, realmIDs, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReleaseApproval provides a mock function with given fields: ctx, realmID
+func (_m *RealmManagerRepository) GetReleaseApproval(ctx context.Context, realmID uuid.UUID) (entities.ReleaseApproval, error) {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 entities.ReleaseApproval
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) entities.ReleaseApproval); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseApproval)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, realmID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReleaseSchedule provides a mock function with given fields: ctx, realmID
+func (_m *RealmManagerRepository) GetReleaseSchedule(ctx context.Context, realmID uuid.UUID) (entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, realmID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDueReleaseSchedules provides a mock function with given fields: ctx, dueAt, limit
+func (_m *RealmManagerRepository) ListDueReleaseSchedules(ctx context.Context, dueAt time.Time, limit int) ([]entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, dueAt, limit)
+
+	var r0 []entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, dueAt, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ReleaseSchedule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, dueAt, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExistingRealmIDs provides a mock function with given fields: ctx, realmIDs
+func (_m *RealmManagerRepository) ListExistingRealmIDs(ctx context.Context, realmIDs []uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, realmIDs)
+
+	var r0 []uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(ctx, realmIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, realmIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmChildIDs provides a mock function with given fields: ctx, parentIDs
+func (_m *RealmManagerRepository) ListRealmChildIDs(ctx context.Context, parentIDs []uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, parentIDs)
+
+	var r0 []uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(ctx, parentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, parentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmEvents provides a mock function with given fields: ctx, options
+func (_m *RealmManagerRepository) ListRealmEvents(ctx context.Context, options entities.ListRealmEventsOptions) ([]entities.RealmEvent, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.RealmEvent
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ListRealmEventsOptions) []entities.RealmEvent); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ListRealmEventsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmRevisions provides a mock function with given fields: ctx, options
+func (_m *RealmManagerRepository) ListRealmRevisions(ctx context.Context, options entities.ListRealmRevisionsOptions) ([]entities.RealmRevision, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.RealmRevision
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ListRealmRevisionsOptions) []entities.RealmRevision); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ListRealmRevisionsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmTemplates provides a mock function with given fields: ctx, options
+func (_m *RealmManagerRepository) ListRealmTemplates(ctx context.Context, options entities.ListRealmTemplatesOptions) ([]entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ListRealmTemplatesOptions) []entities.RealmTemplate); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ListRealmTemplatesOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealms provides a mock function with given fields: ctx, options
+func (_m *RealmManagerRepository) ListRealms(ctx context.Context, options entities.ListRealmsOptions) ([]entities.Realm, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ListRealmsOptions) []entities.Realm); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Realm)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ListRealmsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreRealm provides a mock function with given fields: ctx, realmID, restoredAt
+func (_m *RealmManagerRepository) RestoreRealm(ctx context.Context, realmID uuid.UUID, restoredAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, realmID, restoredAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) int64); ok {
+		r0 = rf(ctx, realmID, restoredAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, realmID, restoredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveReleaseApproval provides a mock function with given fields: ctx, approval
+func (_m *RealmManagerRepository) SaveReleaseApproval(ctx context.Context, approval entities.ReleaseApproval) error {
+	ret := _m.Called(ctx, approval)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ReleaseApproval) error); ok {
+		r0 = rf(ctx, approval)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveReleaseApprovalDecision provides a mock function with given fields: ctx, decision
+func (_m *RealmManagerRepository) SaveReleaseApprovalDecision(ctx context.Context, decision entities.ReleaseApprovalDecision) error {
+	ret := _m.Called(ctx, decision)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ReleaseApprovalDecision) error); ok {
+		r0 = rf(ctx, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveReleaseSchedule provides a mock function with given fields: ctx, schedule
+func (_m *RealmManagerRepository) SaveReleaseSchedule(ctx context.Context, schedule entities.ReleaseSchedule) error {
+	ret := _m.Called(ctx, schedule)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ReleaseSchedule) error); ok {
+		r0 = rf(ctx, schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchRealms provides a mock function with given fields: ctx, options
+func (_m *RealmManagerRepository) SearchRealms(ctx context.Context, options entities.SearchRealmsOptions) ([]entities.RealmSearchResult, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.RealmSearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, entities.SearchRealmsOptions) []entities.RealmSearchResult); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.SearchRealmsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteRealm provides a mock function with given fields: ctx, realmID, deletedAt, statuses
+func (_m *RealmManagerRepository) SoftDeleteRealm(ctx context.Context, realmID uuid.UUID, deletedAt time.Time, statuses ...entities.Status) (int64, error) {
+	_va := make([]interface{}, len(statuses))
+	for _i := range statuses {
+		_va[_i] = statuses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, realmID, deletedAt)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, ...entities.Status) int64); ok {
+		r0 = rf(ctx, realmID, deletedAt, statuses...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, ...entities.Status) error); ok {
+		r1 = rf(ctx, realmID, deletedAt, statuses...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRealm provides a mock function with given fields: ctx, realm, currentStatus, currentRevision
+func (_m *RealmManagerRepository) UpdateRealm(ctx context.Context, realm entities.Realm, currentStatus entities.Status, currentRevision int64) error {
+	ret := _m.Called(ctx, realm, currentStatus, currentRevision)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Realm, entities.Status) error); ok {
-		r0 = rf(ctx, realm, currentStatus)
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Realm, entities.Status, int64) error); ok {
+		r0 = rf(ctx, realm, currentStatus, currentRevision)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -86,6 +757,48 @@ func (_m *RealmManagerRepository) UpdateRealm(ctx context.Context, realm entitie
 	return r0
 }
 
+// UpdateRealmSlug provides a mock function with given fields: ctx, realmID, slug
+func (_m *RealmManagerRepository) UpdateRealmSlug(ctx context.Context, realmID uuid.UUID, slug string) (int64, error) {
+	ret := _m.Called(ctx, realmID, slug)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) int64); ok {
+		r0 = rf(ctx, realmID, slug)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, realmID, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateReleaseSchedule provides a mock function with given fields: ctx, schedule
+func (_m *RealmManagerRepository) UpdateReleaseSchedule(ctx context.Context, schedule entities.ReleaseSchedule) (int64, error) {
+	ret := _m.Called(ctx, schedule)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ReleaseSchedule) int64); ok {
+		r0 = rf(ctx, schedule)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ReleaseSchedule) error); ok {
+		r1 = rf(ctx, schedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRealmManagerRepository interface {
 	mock.TestingT
 	Cleanup(func())
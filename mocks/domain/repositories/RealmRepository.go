@@ -8,6 +8,8 @@ import (
 	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -51,6 +53,20 @@ func (_m *RealmRepository) DeleteRealm(ctx context.Context, realmID uuid.UUID, s
 	return r0
 }
 
+// DeleteRealmAtRevision provides a mock function with given fields: ctx, realmID, status, revision
+func (_m *RealmRepository) DeleteRealmAtRevision(ctx context.Context, realmID uuid.UUID, status entities.Status, revision int64) error {
+	ret := _m.Called(ctx, realmID, status, revision)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, entities.Status, int64) error); ok {
+		r0 = rf(ctx, realmID, status, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetRealm provides a mock function with given fields: ctx, realmID, status
 func (_m *RealmRepository) GetRealm(ctx context.Context, realmID uuid.UUID, status entities.Status) (entities.Realm, error) {
 	ret := _m.Called(ctx, realmID, status)
@@ -72,13 +88,177 @@ func (_m *RealmRepository) GetRealm(ctx context.Context, realmID uuid.UUID, stat
 	return r0, r1
 }
 
-// UpdateRealm provides a mock function with given fields: ctx, realm, currentStatus
-func (_m *RealmRepository) UpdateRealm(ctx context.Context, realm entities.Realm, currentStatus entities.Status) error {
-	ret := _m.Called(ctx, realm, currentStatus)
+// GetRealms provides a mock function with given fields: ctx, realmIDs, status
+func (_m *RealmRepository) GetRealms(ctx context.Context, realmIDs []uuid.UUID, status entities.Status) ([]entities.Realm, error) {
+	ret := _m.Called(ctx, realmIDs, status)
+
+	var r0 []entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, entities.Status) []entities.Realm); ok {
+		r0 = rf(ctx, realmIDs, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Realm)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, entities.Status) error); ok {
+		r1 = rf(ctx, realmIDs, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExistingRealmIDs provides a mock function with given fields: ctx, realmIDs
+func (_m *RealmRepository) ListExistingRealmIDs(ctx context.Context, realmIDs []uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, realmIDs)
+
+	var r0 []uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(ctx, realmIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, realmIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmChildIDs provides a mock function with given fields: ctx, parentIDs
+func (_m *RealmRepository) ListRealmChildIDs(ctx context.Context, parentIDs []uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, parentIDs)
+
+	var r0 []uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(ctx, parentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, parentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealms provides a mock function with given fields: ctx, options
+func (_m *RealmRepository) ListRealms(ctx context.Context, options entities.ListRealmsOptions) ([]entities.Realm, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.Realm
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ListRealmsOptions) []entities.Realm); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Realm)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ListRealmsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreRealm provides a mock function with given fields: ctx, realmID, restoredAt
+func (_m *RealmRepository) RestoreRealm(ctx context.Context, realmID uuid.UUID, restoredAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, realmID, restoredAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) int64); ok {
+		r0 = rf(ctx, realmID, restoredAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, realmID, restoredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRealms provides a mock function with given fields: ctx, options
+func (_m *RealmRepository) SearchRealms(ctx context.Context, options entities.SearchRealmsOptions) ([]entities.RealmSearchResult, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.RealmSearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, entities.SearchRealmsOptions) []entities.RealmSearchResult); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.SearchRealmsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteRealm provides a mock function with given fields: ctx, realmID, deletedAt, statuses
+func (_m *RealmRepository) SoftDeleteRealm(ctx context.Context, realmID uuid.UUID, deletedAt time.Time, statuses ...entities.Status) (int64, error) {
+	_va := make([]interface{}, len(statuses))
+	for _i := range statuses {
+		_va[_i] = statuses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, realmID, deletedAt)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, ...entities.Status) int64); ok {
+		r0 = rf(ctx, realmID, deletedAt, statuses...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, ...entities.Status) error); ok {
+		r1 = rf(ctx, realmID, deletedAt, statuses...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRealm provides a mock function with given fields: ctx, realm, currentStatus, currentRevision
+func (_m *RealmRepository) UpdateRealm(ctx context.Context, realm entities.Realm, currentStatus entities.Status, currentRevision int64) error {
+	ret := _m.Called(ctx, realm, currentStatus, currentRevision)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Realm, entities.Status) error); ok {
-		r0 = rf(ctx, realm, currentStatus)
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Realm, entities.Status, int64) error); ok {
+		r0 = rf(ctx, realm, currentStatus, currentRevision)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -86,6 +266,27 @@ func (_m *RealmRepository) UpdateRealm(ctx context.Context, realm entities.Realm
 	return r0
 }
 
+// UpdateRealmSlug provides a mock function with given fields: ctx, realmID, slug
+func (_m *RealmRepository) UpdateRealmSlug(ctx context.Context, realmID uuid.UUID, slug string) (int64, error) {
+	ret := _m.Called(ctx, realmID, slug)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) int64); ok {
+		r0 = rf(ctx, realmID, slug)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, realmID, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRealmRepository interface {
 	mock.TestingT
 	Cleanup(func())
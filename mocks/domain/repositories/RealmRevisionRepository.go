@@ -0,0 +1,113 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// RealmRevisionRepository is an autogenerated mock type for the RealmRevisionRepository type
+type RealmRevisionRepository struct {
+	mock.Mock
+}
+
+// CreateRealmRevision provides a mock function with given fields: ctx, revision
+func (_m *RealmRevisionRepository) CreateRealmRevision(ctx context.Context, revision entities.RealmRevision) error {
+	ret := _m.Called(ctx, revision)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmRevision) error); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRealmRevision provides a mock function with given fields: ctx, realmID, revision
+func (_m *RealmRevisionRepository) GetRealmRevision(ctx context.Context, realmID uuid.UUID, revision int64) (entities.RealmRevision, error) {
+	ret := _m.Called(ctx, realmID, revision)
+
+	var r0 entities.RealmRevision
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) entities.RealmRevision); ok {
+		r0 = rf(ctx, realmID, revision)
+	} else {
+		r0 = ret.Get(0).(entities.RealmRevision)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, realmID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmRevisionAt provides a mock function with given fields: ctx, realmID, at
+func (_m *RealmRevisionRepository) GetRealmRevisionAt(ctx context.Context, realmID uuid.UUID, at time.Time) (entities.RealmRevision, error) {
+	ret := _m.Called(ctx, realmID, at)
+
+	var r0 entities.RealmRevision
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) entities.RealmRevision); ok {
+		r0 = rf(ctx, realmID, at)
+	} else {
+		r0 = ret.Get(0).(entities.RealmRevision)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, realmID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmRevisions provides a mock function with given fields: ctx, options
+func (_m *RealmRevisionRepository) ListRealmRevisions(ctx context.Context, options entities.ListRealmRevisionsOptions) ([]entities.RealmRevision, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.RealmRevision
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ListRealmRevisionsOptions) []entities.RealmRevision); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ListRealmRevisionsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmRevisionRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmRevisionRepository creates a new instance of RealmRevisionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmRevisionRepository(t mockConstructorTestingTNewRealmRevisionRepository) *RealmRevisionRepository {
+	mock := &RealmRevisionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,125 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// RealmSlugRepository is an autogenerated mock type for the RealmSlugRepository type
+type RealmSlugRepository struct {
+	mock.Mock
+}
+
+// CreateRealmSlug provides a mock function with given fields: ctx, slug
+func (_m *RealmSlugRepository) CreateRealmSlug(ctx context.Context, slug entities.RealmSlug) error {
+	ret := _m.Called(ctx, slug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmSlug) error); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRealmSlug provides a mock function with given fields: ctx, slug
+func (_m *RealmSlugRepository) DeleteRealmSlug(ctx context.Context, slug string) (int64, error) {
+	ret := _m.Called(ctx, slug)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealmSlugs provides a mock function with given fields: ctx, realmID
+func (_m *RealmSlugRepository) DeleteRealmSlugs(ctx context.Context, realmID uuid.UUID) error {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpireRealmSlug provides a mock function with given fields: ctx, slug, expiresAt
+func (_m *RealmSlugRepository) ExpireRealmSlug(ctx context.Context, slug string, expiresAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, slug, expiresAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) int64); ok {
+		r0 = rf(ctx, slug, expiresAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, slug, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmSlug provides a mock function with given fields: ctx, slug
+func (_m *RealmSlugRepository) GetRealmSlug(ctx context.Context, slug string) (entities.RealmSlug, error) {
+	ret := _m.Called(ctx, slug)
+
+	var r0 entities.RealmSlug
+	if rf, ok := ret.Get(0).(func(context.Context, string) entities.RealmSlug); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(entities.RealmSlug)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmSlugRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmSlugRepository creates a new instance of RealmSlugRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmSlugRepository(t mockConstructorTestingTNewRealmSlugRepository) *RealmSlugRepository {
+	mock := &RealmSlugRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,51 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RealmStatsRepository is an autogenerated mock type for the RealmStatsRepository type
+type RealmStatsRepository struct {
+	mock.Mock
+}
+
+// GetRealmStats provides a mock function with given fields: ctx, options
+func (_m *RealmStatsRepository) GetRealmStats(ctx context.Context, options entities.RealmStatsOptions) (entities.RealmStats, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 entities.RealmStats
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmStatsOptions) entities.RealmStats); ok {
+		r0 = rf(ctx, options)
+	} else {
+		r0 = ret.Get(0).(entities.RealmStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.RealmStatsOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmStatsRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmStatsRepository creates a new instance of RealmStatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmStatsRepository(t mockConstructorTestingTNewRealmStatsRepository) *RealmStatsRepository {
+	mock := &RealmStatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
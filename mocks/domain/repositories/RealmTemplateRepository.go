@@ -0,0 +1,113 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// RealmTemplateRepository is an autogenerated mock type for the RealmTemplateRepository type
+type RealmTemplateRepository struct {
+	mock.Mock
+}
+
+// CreateRealmTemplate provides a mock function with given fields: ctx, template
+func (_m *RealmTemplateRepository) CreateRealmTemplate(ctx context.Context, template entities.RealmTemplate) error {
+	ret := _m.Called(ctx, template)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.RealmTemplate) error); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRealmTemplate provides a mock function with given fields: ctx, templateID, deletedAt
+func (_m *RealmTemplateRepository) DeleteRealmTemplate(ctx context.Context, templateID uuid.UUID, deletedAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, templateID, deletedAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) int64); ok {
+		r0 = rf(ctx, templateID, deletedAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, templateID, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmTemplate provides a mock function with given fields: ctx, templateID, version
+func (_m *RealmTemplateRepository) GetRealmTemplate(ctx context.Context, templateID uuid.UUID, version int64) (entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, templateID, version)
+
+	var r0 entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) entities.RealmTemplate); ok {
+		r0 = rf(ctx, templateID, version)
+	} else {
+		r0 = ret.Get(0).(entities.RealmTemplate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, templateID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmTemplates provides a mock function with given fields: ctx, options
+func (_m *RealmTemplateRepository) ListRealmTemplates(ctx context.Context, options entities.ListRealmTemplatesOptions) ([]entities.RealmTemplate, error) {
+	ret := _m.Called(ctx, options)
+
+	var r0 []entities.RealmTemplate
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ListRealmTemplatesOptions) []entities.RealmTemplate); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RealmTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ListRealmTemplatesOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRealmTemplateRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmTemplateRepository creates a new instance of RealmTemplateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmTemplateRepository(t mockConstructorTestingTNewRealmTemplateRepository) *RealmTemplateRepository {
+	mock := &RealmTemplateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ReleaseApprovalRepository is an autogenerated mock type for the ReleaseApprovalRepository type
+type ReleaseApprovalRepository struct {
+	mock.Mock
+}
+
+// DeleteReleaseApproval provides a mock function with given fields: ctx, realmID
+func (_m *ReleaseApprovalRepository) DeleteReleaseApproval(ctx context.Context, realmID uuid.UUID) error {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReleaseApproval provides a mock function with given fields: ctx, realmID
+func (_m *ReleaseApprovalRepository) GetReleaseApproval(ctx context.Context, realmID uuid.UUID) (entities.ReleaseApproval, error) {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 entities.ReleaseApproval
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) entities.ReleaseApproval); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseApproval)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, realmID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveReleaseApproval provides a mock function with given fields: ctx, approval
+func (_m *ReleaseApprovalRepository) SaveReleaseApproval(ctx context.Context, approval entities.ReleaseApproval) error {
+	ret := _m.Called(ctx, approval)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ReleaseApproval) error); ok {
+		r0 = rf(ctx, approval)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveReleaseApprovalDecision provides a mock function with given fields: ctx, decision
+func (_m *ReleaseApprovalRepository) SaveReleaseApprovalDecision(ctx context.Context, decision entities.ReleaseApprovalDecision) error {
+	ret := _m.Called(ctx, decision)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ReleaseApprovalDecision) error); ok {
+		r0 = rf(ctx, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewReleaseApprovalRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReleaseApprovalRepository creates a new instance of ReleaseApprovalRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReleaseApprovalRepository(t mockConstructorTestingTNewReleaseApprovalRepository) *ReleaseApprovalRepository {
+	mock := &ReleaseApprovalRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
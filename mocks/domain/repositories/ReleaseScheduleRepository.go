@@ -0,0 +1,155 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entities "github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// ReleaseScheduleRepository is an autogenerated mock type for the ReleaseScheduleRepository type
+type ReleaseScheduleRepository struct {
+	mock.Mock
+}
+
+// ClaimDueReleaseSchedule provides a mock function with given fields: ctx, realmID, dueAt
+func (_m *ReleaseScheduleRepository) ClaimDueReleaseSchedule(ctx context.Context, realmID uuid.UUID, dueAt time.Time) (entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, realmID, dueAt)
+
+	var r0 entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, realmID, dueAt)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, realmID, dueAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReleaseSchedule provides a mock function with given fields: ctx, realmID
+func (_m *ReleaseScheduleRepository) DeleteReleaseSchedule(ctx context.Context, realmID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, realmID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReleaseSchedule provides a mock function with given fields: ctx, realmID
+func (_m *ReleaseScheduleRepository) GetReleaseSchedule(ctx context.Context, realmID uuid.UUID) (entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		r0 = ret.Get(0).(entities.ReleaseSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, realmID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDueReleaseSchedules provides a mock function with given fields: ctx, dueAt, limit
+func (_m *ReleaseScheduleRepository) ListDueReleaseSchedules(ctx context.Context, dueAt time.Time, limit int) ([]entities.ReleaseSchedule, error) {
+	ret := _m.Called(ctx, dueAt, limit)
+
+	var r0 []entities.ReleaseSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []entities.ReleaseSchedule); ok {
+		r0 = rf(ctx, dueAt, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ReleaseSchedule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, dueAt, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveReleaseSchedule provides a mock function with given fields: ctx, schedule
+func (_m *ReleaseScheduleRepository) SaveReleaseSchedule(ctx context.Context, schedule entities.ReleaseSchedule) error {
+	ret := _m.Called(ctx, schedule)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ReleaseSchedule) error); ok {
+		r0 = rf(ctx, schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateReleaseSchedule provides a mock function with given fields: ctx, schedule
+func (_m *ReleaseScheduleRepository) UpdateReleaseSchedule(ctx context.Context, schedule entities.ReleaseSchedule) (int64, error) {
+	ret := _m.Called(ctx, schedule)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, entities.ReleaseSchedule) int64); ok {
+		r0 = rf(ctx, schedule)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, entities.ReleaseSchedule) error); ok {
+		r1 = rf(ctx, schedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewReleaseScheduleRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReleaseScheduleRepository creates a new instance of ReleaseScheduleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReleaseScheduleRepository(t mockConstructorTestingTNewReleaseScheduleRepository) *ReleaseScheduleRepository {
+	mock := &ReleaseScheduleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -72,22 +72,256 @@ func (EnumStatus) EnumDescriptor() ([]byte, []int) {
 	return file_realm_mgr_v1_common_proto_rawDescGZIP(), []int{0}
 }
 
+type EnumSortDirection int32
+
+const (
+	EnumSortDirection_ENUM_SORT_DIRECTION_UNSPECIFIED EnumSortDirection = 0
+	EnumSortDirection_ENUM_SORT_DIRECTION_ASC         EnumSortDirection = 1
+	EnumSortDirection_ENUM_SORT_DIRECTION_DESC        EnumSortDirection = 2
+)
+
+// Enum value maps for EnumSortDirection.
+var (
+	EnumSortDirection_name = map[int32]string{
+		0: "ENUM_SORT_DIRECTION_UNSPECIFIED",
+		1: "ENUM_SORT_DIRECTION_ASC",
+		2: "ENUM_SORT_DIRECTION_DESC",
+	}
+	EnumSortDirection_value = map[string]int32{
+		"ENUM_SORT_DIRECTION_UNSPECIFIED": 0,
+		"ENUM_SORT_DIRECTION_ASC":         1,
+		"ENUM_SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x EnumSortDirection) Enum() *EnumSortDirection {
+	p := new(EnumSortDirection)
+	*p = x
+	return p
+}
+
+func (x EnumSortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumSortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_realm_mgr_v1_common_proto_enumTypes[1].Descriptor()
+}
+
+func (EnumSortDirection) Type() protoreflect.EnumType {
+	return &file_realm_mgr_v1_common_proto_enumTypes[1]
+}
+
+func (x EnumSortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumSortDirection.Descriptor instead.
+func (EnumSortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+type EnumReleaseScheduleState int32
+
+const (
+	EnumReleaseScheduleState_ENUM_RELEASE_SCHEDULE_STATE_UNSPECIFIED EnumReleaseScheduleState = 0
+	EnumReleaseScheduleState_ENUM_RELEASE_SCHEDULE_STATE_PENDING     EnumReleaseScheduleState = 1
+	EnumReleaseScheduleState_ENUM_RELEASE_SCHEDULE_STATE_FAILED      EnumReleaseScheduleState = 2
+)
+
+// Enum value maps for EnumReleaseScheduleState.
+var (
+	EnumReleaseScheduleState_name = map[int32]string{
+		0: "ENUM_RELEASE_SCHEDULE_STATE_UNSPECIFIED",
+		1: "ENUM_RELEASE_SCHEDULE_STATE_PENDING",
+		2: "ENUM_RELEASE_SCHEDULE_STATE_FAILED",
+	}
+	EnumReleaseScheduleState_value = map[string]int32{
+		"ENUM_RELEASE_SCHEDULE_STATE_UNSPECIFIED": 0,
+		"ENUM_RELEASE_SCHEDULE_STATE_PENDING":     1,
+		"ENUM_RELEASE_SCHEDULE_STATE_FAILED":      2,
+	}
+)
+
+func (x EnumReleaseScheduleState) Enum() *EnumReleaseScheduleState {
+	p := new(EnumReleaseScheduleState)
+	*p = x
+	return p
+}
+
+func (x EnumReleaseScheduleState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumReleaseScheduleState) Descriptor() protoreflect.EnumDescriptor {
+	return file_realm_mgr_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (EnumReleaseScheduleState) Type() protoreflect.EnumType {
+	return &file_realm_mgr_v1_common_proto_enumTypes[2]
+}
+
+func (x EnumReleaseScheduleState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumReleaseScheduleState.Descriptor instead.
+func (EnumReleaseScheduleState) EnumDescriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+type EnumApprovalDecision int32
+
+const (
+	EnumApprovalDecision_ENUM_APPROVAL_DECISION_UNSPECIFIED EnumApprovalDecision = 0
+	EnumApprovalDecision_ENUM_APPROVAL_DECISION_APPROVE     EnumApprovalDecision = 1
+	EnumApprovalDecision_ENUM_APPROVAL_DECISION_REJECT      EnumApprovalDecision = 2
+)
+
+// Enum value maps for EnumApprovalDecision.
+var (
+	EnumApprovalDecision_name = map[int32]string{
+		0: "ENUM_APPROVAL_DECISION_UNSPECIFIED",
+		1: "ENUM_APPROVAL_DECISION_APPROVE",
+		2: "ENUM_APPROVAL_DECISION_REJECT",
+	}
+	EnumApprovalDecision_value = map[string]int32{
+		"ENUM_APPROVAL_DECISION_UNSPECIFIED": 0,
+		"ENUM_APPROVAL_DECISION_APPROVE":     1,
+		"ENUM_APPROVAL_DECISION_REJECT":      2,
+	}
+)
+
+func (x EnumApprovalDecision) Enum() *EnumApprovalDecision {
+	p := new(EnumApprovalDecision)
+	*p = x
+	return p
+}
+
+func (x EnumApprovalDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumApprovalDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_realm_mgr_v1_common_proto_enumTypes[3].Descriptor()
+}
+
+func (EnumApprovalDecision) Type() protoreflect.EnumType {
+	return &file_realm_mgr_v1_common_proto_enumTypes[3]
+}
+
+func (x EnumApprovalDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumApprovalDecision.Descriptor instead.
+func (EnumApprovalDecision) EnumDescriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+// Error of a single item of a batch operation
+type BatchError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gRPC status code of the error
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Human readable description of the error
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BatchError) Reset() {
+	*x = BatchError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_common_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchError) ProtoMessage() {}
+
+func (x *BatchError) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_common_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchError.ProtoReflect.Descriptor instead.
+func (*BatchError) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchError) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_realm_mgr_v1_common_proto protoreflect.FileDescriptor
 
 var file_realm_mgr_v1_common_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x72, 0x65, 0x61,
-	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2a, 0x72, 0x0a, 0x0a, 0x45, 0x6e, 0x75,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
-	0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46,
-	0x54, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x1b, 0x5a,
-	0x19, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65,
-	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x3a, 0x0a, 0x0a, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x72, 0x0a, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x55, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44,
+	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x73, 0x0a, 0x11, 0x45, 0x6e, 0x75,
+	0x6d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x0a, 0x1f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x98,
+	0x01, 0x0a, 0x18, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x45,
+	0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x4e, 0x55, 0x4d,
+	0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53,
+	0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x85, 0x01, 0x0a, 0x14, 0x45, 0x6e,
+	0x75, 0x6d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f,
+	0x56, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4e,
+	0x55, 0x4d, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x43, 0x49,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12, 0x21,
+	0x0a, 0x1d, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f,
+	0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10,
+	0x02, 0x42, 0x1b, 0x5a, 0x19, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76,
+	0x31, 0x3b, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x5f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -102,9 +336,14 @@ func file_realm_mgr_v1_common_proto_rawDescGZIP() []byte {
 	return file_realm_mgr_v1_common_proto_rawDescData
 }
 
-var file_realm_mgr_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_realm_mgr_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_realm_mgr_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_realm_mgr_v1_common_proto_goTypes = []interface{}{
-	(EnumStatus)(0), // 0: realm_mgr.v1.EnumStatus
+	(EnumStatus)(0),               // 0: realm_mgr.v1.EnumStatus
+	(EnumSortDirection)(0),        // 1: realm_mgr.v1.EnumSortDirection
+	(EnumReleaseScheduleState)(0), // 2: realm_mgr.v1.EnumReleaseScheduleState
+	(EnumApprovalDecision)(0),     // 3: realm_mgr.v1.EnumApprovalDecision
+	(*BatchError)(nil),            // 4: realm_mgr.v1.BatchError
 }
 var file_realm_mgr_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -119,19 +358,34 @@ func file_realm_mgr_v1_common_proto_init() {
 	if File_realm_mgr_v1_common_proto != nil {
 		return
 	}
+	if !protoimpl.UnsafeEnabled {
+		file_realm_mgr_v1_common_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_realm_mgr_v1_common_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   0,
+			NumEnums:      4,
+			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_realm_mgr_v1_common_proto_goTypes,
 		DependencyIndexes: file_realm_mgr_v1_common_proto_depIdxs,
 		EnumInfos:         file_realm_mgr_v1_common_proto_enumTypes,
+		MessageInfos:      file_realm_mgr_v1_common_proto_msgTypes,
 	}.Build()
 	File_realm_mgr_v1_common_proto = out.File
 	file_realm_mgr_v1_common_proto_rawDesc = nil
@@ -34,3 +34,106 @@ var (
 	_ = anypb.Any{}
 	_ = sort.Sort
 )
+
+// Validate checks the field values on BatchError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchErrorMultiError, or
+// nil if none found.
+func (m *BatchError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BatchErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchErrorMultiError is an error wrapping multiple validation errors
+// returned by BatchError.ValidateAll() if the designated constraints aren't met.
+type BatchErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchErrorMultiError) AllErrors() []error { return m }
+
+// BatchErrorValidationError is the validation error returned by
+// BatchError.Validate if the designated constraints aren't met.
+type BatchErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchErrorValidationError) ErrorName() string { return "BatchErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchErrorValidationError{}
@@ -17,6 +17,156 @@ type RealmManagerServiceClient struct {
 	mock.Mock
 }
 
+// ApproveRelease provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) ApproveRelease(ctx context.Context, in *realm_mgr_v1.ApproveReleaseRequest, opts ...grpc.CallOption) (*realm_mgr_v1.ApproveReleaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.ApproveReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ApproveReleaseRequest, ...grpc.CallOption) *realm_mgr_v1.ApproveReleaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ApproveReleaseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ApproveReleaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchGetRealms provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) BatchGetRealms(ctx context.Context, in *realm_mgr_v1.BatchGetRealmsRequest, opts ...grpc.CallOption) (*realm_mgr_v1.BatchGetRealmsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.BatchGetRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.BatchGetRealmsRequest, ...grpc.CallOption) *realm_mgr_v1.BatchGetRealmsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.BatchGetRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.BatchGetRealmsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchMutateRealms provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) BatchMutateRealms(ctx context.Context, in *realm_mgr_v1.BatchMutateRealmsRequest, opts ...grpc.CallOption) (*realm_mgr_v1.BatchMutateRealmsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.BatchMutateRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.BatchMutateRealmsRequest, ...grpc.CallOption) *realm_mgr_v1.BatchMutateRealmsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.BatchMutateRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.BatchMutateRealmsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelScheduledRelease provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) CancelScheduledRelease(ctx context.Context, in *realm_mgr_v1.CancelScheduledReleaseRequest, opts ...grpc.CallOption) (*realm_mgr_v1.CancelScheduledReleaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.CancelScheduledReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CancelScheduledReleaseRequest, ...grpc.CallOption) *realm_mgr_v1.CancelScheduledReleaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CancelScheduledReleaseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CancelScheduledReleaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloneRealm provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) CloneRealm(ctx context.Context, in *realm_mgr_v1.CloneRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.CloneRealmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.CloneRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CloneRealmRequest, ...grpc.CallOption) *realm_mgr_v1.CloneRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CloneRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CloneRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateRealm provides a mock function with given fields: ctx, in, opts
 func (_m *RealmManagerServiceClient) CreateRealm(ctx context.Context, in *realm_mgr_v1.CreateRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.CreateRealmResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -28,17 +178,557 @@ func (_m *RealmManagerServiceClient) CreateRealm(ctx context.Context, in *realm_
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *realm_mgr_v1.CreateRealmResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CreateRealmRequest, ...grpc.CallOption) *realm_mgr_v1.CreateRealmResponse); ok {
+	var r0 *realm_mgr_v1.CreateRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CreateRealmRequest, ...grpc.CallOption) *realm_mgr_v1.CreateRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CreateRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CreateRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRealmFromTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) CreateRealmFromTemplate(ctx context.Context, in *realm_mgr_v1.CreateRealmFromTemplateRequest, opts ...grpc.CallOption) (*realm_mgr_v1.CreateRealmFromTemplateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.CreateRealmFromTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CreateRealmFromTemplateRequest, ...grpc.CallOption) *realm_mgr_v1.CreateRealmFromTemplateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CreateRealmFromTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CreateRealmFromTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRealmTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) CreateRealmTemplate(ctx context.Context, in *realm_mgr_v1.CreateRealmTemplateRequest, opts ...grpc.CallOption) (*realm_mgr_v1.CreateRealmTemplateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.CreateRealmTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CreateRealmTemplateRequest, ...grpc.CallOption) *realm_mgr_v1.CreateRealmTemplateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CreateRealmTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CreateRealmTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealm provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) DeleteRealm(ctx context.Context, in *realm_mgr_v1.DeleteRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.DeleteRealmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.DeleteRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DeleteRealmRequest, ...grpc.CallOption) *realm_mgr_v1.DeleteRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DeleteRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DeleteRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealmTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) DeleteRealmTemplate(ctx context.Context, in *realm_mgr_v1.DeleteRealmTemplateRequest, opts ...grpc.CallOption) (*realm_mgr_v1.DeleteRealmTemplateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.DeleteRealmTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DeleteRealmTemplateRequest, ...grpc.CallOption) *realm_mgr_v1.DeleteRealmTemplateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DeleteRealmTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DeleteRealmTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiffRealm provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) DiffRealm(ctx context.Context, in *realm_mgr_v1.DiffRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.DiffRealmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.DiffRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DiffRealmRequest, ...grpc.CallOption) *realm_mgr_v1.DiffRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DiffRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DiffRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableRealm provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) DisableRealm(ctx context.Context, in *realm_mgr_v1.DisableRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.DisableRealmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.DisableRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DisableRealmRequest, ...grpc.CallOption) *realm_mgr_v1.DisableRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DisableRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DisableRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscardDraft provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) DiscardDraft(ctx context.Context, in *realm_mgr_v1.DiscardDraftRequest, opts ...grpc.CallOption) (*realm_mgr_v1.DiscardDraftResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.DiscardDraftResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DiscardDraftRequest, ...grpc.CallOption) *realm_mgr_v1.DiscardDraftResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DiscardDraftResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DiscardDraftRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableRealm provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) EnableRealm(ctx context.Context, in *realm_mgr_v1.EnableRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.EnableRealmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.EnableRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.EnableRealmRequest, ...grpc.CallOption) *realm_mgr_v1.EnableRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.EnableRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.EnableRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRealms provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) ExportRealms(ctx context.Context, in *realm_mgr_v1.ExportRealmsRequest, opts ...grpc.CallOption) (realm_mgr_v1.RealmManagerService_ExportRealmsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 realm_mgr_v1.RealmManagerService_ExportRealmsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ExportRealmsRequest, ...grpc.CallOption) realm_mgr_v1.RealmManagerService_ExportRealmsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(realm_mgr_v1.RealmManagerService_ExportRealmsClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ExportRealmsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealm provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) GetRealm(ctx context.Context, in *realm_mgr_v1.GetRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.GetRealmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.GetRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmRequest, ...grpc.CallOption) *realm_mgr_v1.GetRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmAncestors provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) GetRealmAncestors(ctx context.Context, in *realm_mgr_v1.GetRealmAncestorsRequest, opts ...grpc.CallOption) (*realm_mgr_v1.GetRealmAncestorsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.GetRealmAncestorsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmAncestorsRequest, ...grpc.CallOption) *realm_mgr_v1.GetRealmAncestorsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmAncestorsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmAncestorsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmBySlug provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) GetRealmBySlug(ctx context.Context, in *realm_mgr_v1.GetRealmBySlugRequest, opts ...grpc.CallOption) (*realm_mgr_v1.GetRealmBySlugResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.GetRealmBySlugResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmBySlugRequest, ...grpc.CallOption) *realm_mgr_v1.GetRealmBySlugResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmBySlugResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmBySlugRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmRevision provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) GetRealmRevision(ctx context.Context, in *realm_mgr_v1.GetRealmRevisionRequest, opts ...grpc.CallOption) (*realm_mgr_v1.GetRealmRevisionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.GetRealmRevisionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmRevisionRequest, ...grpc.CallOption) *realm_mgr_v1.GetRealmRevisionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmRevisionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmRevisionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmStats provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) GetRealmStats(ctx context.Context, in *realm_mgr_v1.GetRealmStatsRequest, opts ...grpc.CallOption) (*realm_mgr_v1.GetRealmStatsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.GetRealmStatsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmStatsRequest, ...grpc.CallOption) *realm_mgr_v1.GetRealmStatsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmStatsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmStatsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) GetRealmTemplate(ctx context.Context, in *realm_mgr_v1.GetRealmTemplateRequest, opts ...grpc.CallOption) (*realm_mgr_v1.GetRealmTemplateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.GetRealmTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmTemplateRequest, ...grpc.CallOption) *realm_mgr_v1.GetRealmTemplateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRealms provides a mock function with given fields: ctx, opts
+func (_m *RealmManagerServiceClient) ImportRealms(ctx context.Context, opts ...grpc.CallOption) (realm_mgr_v1.RealmManagerService_ImportRealmsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 realm_mgr_v1.RealmManagerService_ImportRealmsClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) realm_mgr_v1.RealmManagerService_ImportRealmsClient); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(realm_mgr_v1.RealmManagerService_ImportRealmsClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChildRealms provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) ListChildRealms(ctx context.Context, in *realm_mgr_v1.ListChildRealmsRequest, opts ...grpc.CallOption) (*realm_mgr_v1.ListChildRealmsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.ListChildRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ListChildRealmsRequest, ...grpc.CallOption) *realm_mgr_v1.ListChildRealmsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ListChildRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ListChildRealmsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmRevisions provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) ListRealmRevisions(ctx context.Context, in *realm_mgr_v1.ListRealmRevisionsRequest, opts ...grpc.CallOption) (*realm_mgr_v1.ListRealmRevisionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.ListRealmRevisionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ListRealmRevisionsRequest, ...grpc.CallOption) *realm_mgr_v1.ListRealmRevisionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*realm_mgr_v1.CreateRealmResponse)
+			r0 = ret.Get(0).(*realm_mgr_v1.ListRealmRevisionsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CreateRealmRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ListRealmRevisionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -47,8 +737,8 @@ func (_m *RealmManagerServiceClient) CreateRealm(ctx context.Context, in *realm_
 	return r0, r1
 }
 
-// GetRealm provides a mock function with given fields: ctx, in, opts
-func (_m *RealmManagerServiceClient) GetRealm(ctx context.Context, in *realm_mgr_v1.GetRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.GetRealmResponse, error) {
+// ListRealmTemplates provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) ListRealmTemplates(ctx context.Context, in *realm_mgr_v1.ListRealmTemplatesRequest, opts ...grpc.CallOption) (*realm_mgr_v1.ListRealmTemplatesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -58,17 +748,77 @@ func (_m *RealmManagerServiceClient) GetRealm(ctx context.Context, in *realm_mgr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *realm_mgr_v1.GetRealmResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmRequest, ...grpc.CallOption) *realm_mgr_v1.GetRealmResponse); ok {
+	var r0 *realm_mgr_v1.ListRealmTemplatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ListRealmTemplatesRequest, ...grpc.CallOption) *realm_mgr_v1.ListRealmTemplatesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmResponse)
+			r0 = ret.Get(0).(*realm_mgr_v1.ListRealmTemplatesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ListRealmTemplatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealms provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) ListRealms(ctx context.Context, in *realm_mgr_v1.ListRealmsRequest, opts ...grpc.CallOption) (*realm_mgr_v1.ListRealmsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.ListRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ListRealmsRequest, ...grpc.CallOption) *realm_mgr_v1.ListRealmsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ListRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ListRealmsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectRelease provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) RejectRelease(ctx context.Context, in *realm_mgr_v1.RejectReleaseRequest, opts ...grpc.CallOption) (*realm_mgr_v1.RejectReleaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.RejectReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RejectReleaseRequest, ...grpc.CallOption) *realm_mgr_v1.RejectReleaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RejectReleaseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RejectReleaseRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -107,6 +857,186 @@ func (_m *RealmManagerServiceClient) ReleaseRealm(ctx context.Context, in *realm
 	return r0, r1
 }
 
+// RenameRealmSlug provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) RenameRealmSlug(ctx context.Context, in *realm_mgr_v1.RenameRealmSlugRequest, opts ...grpc.CallOption) (*realm_mgr_v1.RenameRealmSlugResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.RenameRealmSlugResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RenameRealmSlugRequest, ...grpc.CallOption) *realm_mgr_v1.RenameRealmSlugResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RenameRealmSlugResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RenameRealmSlugRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestReleaseApproval provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) RequestReleaseApproval(ctx context.Context, in *realm_mgr_v1.RequestReleaseApprovalRequest, opts ...grpc.CallOption) (*realm_mgr_v1.RequestReleaseApprovalResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.RequestReleaseApprovalResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RequestReleaseApprovalRequest, ...grpc.CallOption) *realm_mgr_v1.RequestReleaseApprovalResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RequestReleaseApprovalResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RequestReleaseApprovalRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreRealm provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) RestoreRealm(ctx context.Context, in *realm_mgr_v1.RestoreRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.RestoreRealmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.RestoreRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RestoreRealmRequest, ...grpc.CallOption) *realm_mgr_v1.RestoreRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RestoreRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RestoreRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackRealm provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) RollbackRealm(ctx context.Context, in *realm_mgr_v1.RollbackRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.RollbackRealmResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.RollbackRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RollbackRealmRequest, ...grpc.CallOption) *realm_mgr_v1.RollbackRealmResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RollbackRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RollbackRealmRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleRelease provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) ScheduleRelease(ctx context.Context, in *realm_mgr_v1.ScheduleReleaseRequest, opts ...grpc.CallOption) (*realm_mgr_v1.ScheduleReleaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.ScheduleReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ScheduleReleaseRequest, ...grpc.CallOption) *realm_mgr_v1.ScheduleReleaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ScheduleReleaseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ScheduleReleaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRealms provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) SearchRealms(ctx context.Context, in *realm_mgr_v1.SearchRealmsRequest, opts ...grpc.CallOption) (*realm_mgr_v1.SearchRealmsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.SearchRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.SearchRealmsRequest, ...grpc.CallOption) *realm_mgr_v1.SearchRealmsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.SearchRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.SearchRealmsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRealm provides a mock function with given fields: ctx, in, opts
 func (_m *RealmManagerServiceClient) UpdateRealm(ctx context.Context, in *realm_mgr_v1.UpdateRealmRequest, opts ...grpc.CallOption) (*realm_mgr_v1.UpdateRealmResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -137,6 +1067,66 @@ func (_m *RealmManagerServiceClient) UpdateRealm(ctx context.Context, in *realm_
 	return r0, r1
 }
 
+// UpdateRealmTemplate provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) UpdateRealmTemplate(ctx context.Context, in *realm_mgr_v1.UpdateRealmTemplateRequest, opts ...grpc.CallOption) (*realm_mgr_v1.UpdateRealmTemplateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realm_mgr_v1.UpdateRealmTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.UpdateRealmTemplateRequest, ...grpc.CallOption) *realm_mgr_v1.UpdateRealmTemplateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.UpdateRealmTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.UpdateRealmTemplateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchRealms provides a mock function with given fields: ctx, in, opts
+func (_m *RealmManagerServiceClient) WatchRealms(ctx context.Context, in *realm_mgr_v1.WatchRealmsRequest, opts ...grpc.CallOption) (realm_mgr_v1.RealmManagerService_WatchRealmsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 realm_mgr_v1.RealmManagerService_WatchRealmsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.WatchRealmsRequest, ...grpc.CallOption) realm_mgr_v1.RealmManagerService_WatchRealmsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(realm_mgr_v1.RealmManagerService_WatchRealmsClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.WatchRealmsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRealmManagerServiceClient interface {
 	mock.TestingT
 	Cleanup(func())
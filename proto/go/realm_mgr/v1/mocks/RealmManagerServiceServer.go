@@ -14,21 +14,426 @@ type RealmManagerServiceServer struct {
 	mock.Mock
 }
 
+// ApproveRelease provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) ApproveRelease(_a0 context.Context, _a1 *realm_mgr_v1.ApproveReleaseRequest) (*realm_mgr_v1.ApproveReleaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.ApproveReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ApproveReleaseRequest) *realm_mgr_v1.ApproveReleaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ApproveReleaseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ApproveReleaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchGetRealms provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) BatchGetRealms(_a0 context.Context, _a1 *realm_mgr_v1.BatchGetRealmsRequest) (*realm_mgr_v1.BatchGetRealmsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.BatchGetRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.BatchGetRealmsRequest) *realm_mgr_v1.BatchGetRealmsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.BatchGetRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.BatchGetRealmsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchMutateRealms provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) BatchMutateRealms(_a0 context.Context, _a1 *realm_mgr_v1.BatchMutateRealmsRequest) (*realm_mgr_v1.BatchMutateRealmsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.BatchMutateRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.BatchMutateRealmsRequest) *realm_mgr_v1.BatchMutateRealmsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.BatchMutateRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.BatchMutateRealmsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelScheduledRelease provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) CancelScheduledRelease(_a0 context.Context, _a1 *realm_mgr_v1.CancelScheduledReleaseRequest) (*realm_mgr_v1.CancelScheduledReleaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.CancelScheduledReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CancelScheduledReleaseRequest) *realm_mgr_v1.CancelScheduledReleaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CancelScheduledReleaseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CancelScheduledReleaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloneRealm provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) CloneRealm(_a0 context.Context, _a1 *realm_mgr_v1.CloneRealmRequest) (*realm_mgr_v1.CloneRealmResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.CloneRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CloneRealmRequest) *realm_mgr_v1.CloneRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CloneRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CloneRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateRealm provides a mock function with given fields: _a0, _a1
 func (_m *RealmManagerServiceServer) CreateRealm(_a0 context.Context, _a1 *realm_mgr_v1.CreateRealmRequest) (*realm_mgr_v1.CreateRealmResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *realm_mgr_v1.CreateRealmResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CreateRealmRequest) *realm_mgr_v1.CreateRealmResponse); ok {
+	var r0 *realm_mgr_v1.CreateRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CreateRealmRequest) *realm_mgr_v1.CreateRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CreateRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CreateRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRealmFromTemplate provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) CreateRealmFromTemplate(_a0 context.Context, _a1 *realm_mgr_v1.CreateRealmFromTemplateRequest) (*realm_mgr_v1.CreateRealmFromTemplateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.CreateRealmFromTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CreateRealmFromTemplateRequest) *realm_mgr_v1.CreateRealmFromTemplateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CreateRealmFromTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CreateRealmFromTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRealmTemplate provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) CreateRealmTemplate(_a0 context.Context, _a1 *realm_mgr_v1.CreateRealmTemplateRequest) (*realm_mgr_v1.CreateRealmTemplateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.CreateRealmTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.CreateRealmTemplateRequest) *realm_mgr_v1.CreateRealmTemplateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.CreateRealmTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CreateRealmTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealm provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) DeleteRealm(_a0 context.Context, _a1 *realm_mgr_v1.DeleteRealmRequest) (*realm_mgr_v1.DeleteRealmResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.DeleteRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DeleteRealmRequest) *realm_mgr_v1.DeleteRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DeleteRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DeleteRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRealmTemplate provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) DeleteRealmTemplate(_a0 context.Context, _a1 *realm_mgr_v1.DeleteRealmTemplateRequest) (*realm_mgr_v1.DeleteRealmTemplateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.DeleteRealmTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DeleteRealmTemplateRequest) *realm_mgr_v1.DeleteRealmTemplateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DeleteRealmTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DeleteRealmTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiffRealm provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) DiffRealm(_a0 context.Context, _a1 *realm_mgr_v1.DiffRealmRequest) (*realm_mgr_v1.DiffRealmResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.DiffRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DiffRealmRequest) *realm_mgr_v1.DiffRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DiffRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DiffRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableRealm provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) DisableRealm(_a0 context.Context, _a1 *realm_mgr_v1.DisableRealmRequest) (*realm_mgr_v1.DisableRealmResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.DisableRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DisableRealmRequest) *realm_mgr_v1.DisableRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DisableRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DisableRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscardDraft provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) DiscardDraft(_a0 context.Context, _a1 *realm_mgr_v1.DiscardDraftRequest) (*realm_mgr_v1.DiscardDraftResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.DiscardDraftResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.DiscardDraftRequest) *realm_mgr_v1.DiscardDraftResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.DiscardDraftResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.DiscardDraftRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableRealm provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) EnableRealm(_a0 context.Context, _a1 *realm_mgr_v1.EnableRealmRequest) (*realm_mgr_v1.EnableRealmResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.EnableRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.EnableRealmRequest) *realm_mgr_v1.EnableRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.EnableRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.EnableRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRealms provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) ExportRealms(_a0 *realm_mgr_v1.ExportRealmsRequest, _a1 realm_mgr_v1.RealmManagerService_ExportRealmsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*realm_mgr_v1.ExportRealmsRequest, realm_mgr_v1.RealmManagerService_ExportRealmsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRealm provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) GetRealm(_a0 context.Context, _a1 *realm_mgr_v1.GetRealmRequest) (*realm_mgr_v1.GetRealmResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.GetRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmRequest) *realm_mgr_v1.GetRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmAncestors provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) GetRealmAncestors(_a0 context.Context, _a1 *realm_mgr_v1.GetRealmAncestorsRequest) (*realm_mgr_v1.GetRealmAncestorsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.GetRealmAncestorsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmAncestorsRequest) *realm_mgr_v1.GetRealmAncestorsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmAncestorsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmAncestorsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmBySlug provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) GetRealmBySlug(_a0 context.Context, _a1 *realm_mgr_v1.GetRealmBySlugRequest) (*realm_mgr_v1.GetRealmBySlugResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.GetRealmBySlugResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmBySlugRequest) *realm_mgr_v1.GetRealmBySlugResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmBySlugResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmBySlugRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmRevision provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) GetRealmRevision(_a0 context.Context, _a1 *realm_mgr_v1.GetRealmRevisionRequest) (*realm_mgr_v1.GetRealmRevisionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.GetRealmRevisionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmRevisionRequest) *realm_mgr_v1.GetRealmRevisionResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*realm_mgr_v1.CreateRealmResponse)
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmRevisionResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.CreateRealmRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmRevisionRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -37,21 +442,173 @@ func (_m *RealmManagerServiceServer) CreateRealm(_a0 context.Context, _a1 *realm
 	return r0, r1
 }
 
-// GetRealm provides a mock function with given fields: _a0, _a1
-func (_m *RealmManagerServiceServer) GetRealm(_a0 context.Context, _a1 *realm_mgr_v1.GetRealmRequest) (*realm_mgr_v1.GetRealmResponse, error) {
+// GetRealmStats provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) GetRealmStats(_a0 context.Context, _a1 *realm_mgr_v1.GetRealmStatsRequest) (*realm_mgr_v1.GetRealmStatsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *realm_mgr_v1.GetRealmResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmRequest) *realm_mgr_v1.GetRealmResponse); ok {
+	var r0 *realm_mgr_v1.GetRealmStatsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmStatsRequest) *realm_mgr_v1.GetRealmStatsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmResponse)
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmStatsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmStatsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRealmTemplate provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) GetRealmTemplate(_a0 context.Context, _a1 *realm_mgr_v1.GetRealmTemplateRequest) (*realm_mgr_v1.GetRealmTemplateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.GetRealmTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.GetRealmTemplateRequest) *realm_mgr_v1.GetRealmTemplateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.GetRealmTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.GetRealmTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportRealms provides a mock function with given fields: _a0
+func (_m *RealmManagerServiceServer) ImportRealms(_a0 realm_mgr_v1.RealmManagerService_ImportRealmsServer) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(realm_mgr_v1.RealmManagerService_ImportRealmsServer) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListChildRealms provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) ListChildRealms(_a0 context.Context, _a1 *realm_mgr_v1.ListChildRealmsRequest) (*realm_mgr_v1.ListChildRealmsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.ListChildRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ListChildRealmsRequest) *realm_mgr_v1.ListChildRealmsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ListChildRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ListChildRealmsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmRevisions provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) ListRealmRevisions(_a0 context.Context, _a1 *realm_mgr_v1.ListRealmRevisionsRequest) (*realm_mgr_v1.ListRealmRevisionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.ListRealmRevisionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ListRealmRevisionsRequest) *realm_mgr_v1.ListRealmRevisionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ListRealmRevisionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ListRealmRevisionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealmTemplates provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) ListRealmTemplates(_a0 context.Context, _a1 *realm_mgr_v1.ListRealmTemplatesRequest) (*realm_mgr_v1.ListRealmTemplatesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.ListRealmTemplatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ListRealmTemplatesRequest) *realm_mgr_v1.ListRealmTemplatesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ListRealmTemplatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ListRealmTemplatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRealms provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) ListRealms(_a0 context.Context, _a1 *realm_mgr_v1.ListRealmsRequest) (*realm_mgr_v1.ListRealmsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.ListRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ListRealmsRequest) *realm_mgr_v1.ListRealmsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ListRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ListRealmsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectRelease provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) RejectRelease(_a0 context.Context, _a1 *realm_mgr_v1.RejectReleaseRequest) (*realm_mgr_v1.RejectReleaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.RejectReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RejectReleaseRequest) *realm_mgr_v1.RejectReleaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RejectReleaseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RejectReleaseRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -83,6 +640,144 @@ func (_m *RealmManagerServiceServer) ReleaseRealm(_a0 context.Context, _a1 *real
 	return r0, r1
 }
 
+// RenameRealmSlug provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) RenameRealmSlug(_a0 context.Context, _a1 *realm_mgr_v1.RenameRealmSlugRequest) (*realm_mgr_v1.RenameRealmSlugResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.RenameRealmSlugResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RenameRealmSlugRequest) *realm_mgr_v1.RenameRealmSlugResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RenameRealmSlugResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RenameRealmSlugRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestReleaseApproval provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) RequestReleaseApproval(_a0 context.Context, _a1 *realm_mgr_v1.RequestReleaseApprovalRequest) (*realm_mgr_v1.RequestReleaseApprovalResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.RequestReleaseApprovalResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RequestReleaseApprovalRequest) *realm_mgr_v1.RequestReleaseApprovalResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RequestReleaseApprovalResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RequestReleaseApprovalRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreRealm provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) RestoreRealm(_a0 context.Context, _a1 *realm_mgr_v1.RestoreRealmRequest) (*realm_mgr_v1.RestoreRealmResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.RestoreRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RestoreRealmRequest) *realm_mgr_v1.RestoreRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RestoreRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RestoreRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackRealm provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) RollbackRealm(_a0 context.Context, _a1 *realm_mgr_v1.RollbackRealmRequest) (*realm_mgr_v1.RollbackRealmResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.RollbackRealmResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.RollbackRealmRequest) *realm_mgr_v1.RollbackRealmResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.RollbackRealmResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.RollbackRealmRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleRelease provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) ScheduleRelease(_a0 context.Context, _a1 *realm_mgr_v1.ScheduleReleaseRequest) (*realm_mgr_v1.ScheduleReleaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.ScheduleReleaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.ScheduleReleaseRequest) *realm_mgr_v1.ScheduleReleaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ScheduleReleaseResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.ScheduleReleaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRealms provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) SearchRealms(_a0 context.Context, _a1 *realm_mgr_v1.SearchRealmsRequest) (*realm_mgr_v1.SearchRealmsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.SearchRealmsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.SearchRealmsRequest) *realm_mgr_v1.SearchRealmsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.SearchRealmsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.SearchRealmsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateRealm provides a mock function with given fields: _a0, _a1
 func (_m *RealmManagerServiceServer) UpdateRealm(_a0 context.Context, _a1 *realm_mgr_v1.UpdateRealmRequest) (*realm_mgr_v1.UpdateRealmResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -106,6 +801,43 @@ func (_m *RealmManagerServiceServer) UpdateRealm(_a0 context.Context, _a1 *realm
 	return r0, r1
 }
 
+// UpdateRealmTemplate provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) UpdateRealmTemplate(_a0 context.Context, _a1 *realm_mgr_v1.UpdateRealmTemplateRequest) (*realm_mgr_v1.UpdateRealmTemplateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *realm_mgr_v1.UpdateRealmTemplateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *realm_mgr_v1.UpdateRealmTemplateRequest) *realm_mgr_v1.UpdateRealmTemplateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.UpdateRealmTemplateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *realm_mgr_v1.UpdateRealmTemplateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchRealms provides a mock function with given fields: _a0, _a1
+func (_m *RealmManagerServiceServer) WatchRealms(_a0 *realm_mgr_v1.WatchRealmsRequest, _a1 realm_mgr_v1.RealmManagerService_WatchRealmsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*realm_mgr_v1.WatchRealmsRequest, realm_mgr_v1.RealmManagerService_WatchRealmsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // mustEmbedUnimplementedRealmManagerServiceServer provides a mock function with given fields:
 func (_m *RealmManagerServiceServer) mustEmbedUnimplementedRealmManagerServiceServer() {
 	_m.Called()
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	metadata "google.golang.org/grpc/metadata"
+
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+// RealmManagerService_ExportRealmsServer is an autogenerated mock type for the RealmManagerService_ExportRealmsServer type
+type RealmManagerService_ExportRealmsServer struct {
+	mock.Mock
+}
+
+// Context provides a mock function with given fields:
+func (_m *RealmManagerService_ExportRealmsServer) Context() context.Context {
+	ret := _m.Called()
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// RecvMsg provides a mock function with given fields: m
+func (_m *RealmManagerService_ExportRealmsServer) RecvMsg(m interface{}) error {
+	ret := _m.Called(m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Send provides a mock function with given fields: _a0
+func (_m *RealmManagerService_ExportRealmsServer) Send(_a0 *realm_mgr_v1.ExportRealmsResponse) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*realm_mgr_v1.ExportRealmsResponse) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendHeader provides a mock function with given fields: _a0
+func (_m *RealmManagerService_ExportRealmsServer) SendHeader(_a0 metadata.MD) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(metadata.MD) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendMsg provides a mock function with given fields: m
+func (_m *RealmManagerService_ExportRealmsServer) SendMsg(m interface{}) error {
+	ret := _m.Called(m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetHeader provides a mock function with given fields: _a0
+func (_m *RealmManagerService_ExportRealmsServer) SetHeader(_a0 metadata.MD) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(metadata.MD) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTrailer provides a mock function with given fields: _a0
+func (_m *RealmManagerService_ExportRealmsServer) SetTrailer(_a0 metadata.MD) {
+	_m.Called(_a0)
+}
+
+type mockConstructorTestingTNewRealmManagerService_ExportRealmsServer interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmManagerService_ExportRealmsServer creates a new instance of RealmManagerService_ExportRealmsServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmManagerService_ExportRealmsServer(t mockConstructorTestingTNewRealmManagerService_ExportRealmsServer) *RealmManagerService_ExportRealmsServer {
+	mock := &RealmManagerService_ExportRealmsServer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
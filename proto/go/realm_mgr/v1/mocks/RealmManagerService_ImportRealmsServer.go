@@ -0,0 +1,146 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	metadata "google.golang.org/grpc/metadata"
+
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+)
+
+// RealmManagerService_ImportRealmsServer is an autogenerated mock type for the RealmManagerService_ImportRealmsServer type
+type RealmManagerService_ImportRealmsServer struct {
+	mock.Mock
+}
+
+// Context provides a mock function with given fields:
+func (_m *RealmManagerService_ImportRealmsServer) Context() context.Context {
+	ret := _m.Called()
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// Recv provides a mock function with given fields:
+func (_m *RealmManagerService_ImportRealmsServer) Recv() (*realm_mgr_v1.ImportRealmsRequest, error) {
+	ret := _m.Called()
+
+	var r0 *realm_mgr_v1.ImportRealmsRequest
+	if rf, ok := ret.Get(0).(func() *realm_mgr_v1.ImportRealmsRequest); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realm_mgr_v1.ImportRealmsRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecvMsg provides a mock function with given fields: m
+func (_m *RealmManagerService_ImportRealmsServer) RecvMsg(m interface{}) error {
+	ret := _m.Called(m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendAndClose provides a mock function with given fields: _a0
+func (_m *RealmManagerService_ImportRealmsServer) SendAndClose(_a0 *realm_mgr_v1.ImportRealmsResponse) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*realm_mgr_v1.ImportRealmsResponse) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendHeader provides a mock function with given fields: _a0
+func (_m *RealmManagerService_ImportRealmsServer) SendHeader(_a0 metadata.MD) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(metadata.MD) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendMsg provides a mock function with given fields: m
+func (_m *RealmManagerService_ImportRealmsServer) SendMsg(m interface{}) error {
+	ret := _m.Called(m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetHeader provides a mock function with given fields: _a0
+func (_m *RealmManagerService_ImportRealmsServer) SetHeader(_a0 metadata.MD) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(metadata.MD) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTrailer provides a mock function with given fields: _a0
+func (_m *RealmManagerService_ImportRealmsServer) SetTrailer(_a0 metadata.MD) {
+	_m.Called(_a0)
+}
+
+type mockConstructorTestingTNewRealmManagerService_ImportRealmsServer interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRealmManagerService_ImportRealmsServer creates a new instance of RealmManagerService_ImportRealmsServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRealmManagerService_ImportRealmsServer(t mockConstructorTestingTNewRealmManagerService_ImportRealmsServer) *RealmManagerService_ImportRealmsServer {
+	mock := &RealmManagerService_ImportRealmsServer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,30 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// isBatchGetRealmsResult_Result is an autogenerated mock type for the isBatchGetRealmsResult_Result type
+type isBatchGetRealmsResult_Result struct {
+	mock.Mock
+}
+
+// isBatchGetRealmsResult_Result provides a mock function with given fields:
+func (_m *isBatchGetRealmsResult_Result) isBatchGetRealmsResult_Result() {
+	_m.Called()
+}
+
+type mockConstructorTestingTnewIsBatchGetRealmsResult_Result interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newIsBatchGetRealmsResult_Result creates a new instance of isBatchGetRealmsResult_Result. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newIsBatchGetRealmsResult_Result(t mockConstructorTestingTnewIsBatchGetRealmsResult_Result) *isBatchGetRealmsResult_Result {
+	mock := &isBatchGetRealmsResult_Result{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
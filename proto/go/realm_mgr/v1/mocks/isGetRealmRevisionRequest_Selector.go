@@ -0,0 +1,30 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// isGetRealmRevisionRequest_Selector is an autogenerated mock type for the isGetRealmRevisionRequest_Selector type
+type isGetRealmRevisionRequest_Selector struct {
+	mock.Mock
+}
+
+// isGetRealmRevisionRequest_Selector provides a mock function with given fields:
+func (_m *isGetRealmRevisionRequest_Selector) isGetRealmRevisionRequest_Selector() {
+	_m.Called()
+}
+
+type mockConstructorTestingTnewIsGetRealmRevisionRequest_Selector interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newIsGetRealmRevisionRequest_Selector creates a new instance of isGetRealmRevisionRequest_Selector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newIsGetRealmRevisionRequest_Selector(t mockConstructorTestingTnewIsGetRealmRevisionRequest_Selector) *isGetRealmRevisionRequest_Selector {
+	mock := &isGetRealmRevisionRequest_Selector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
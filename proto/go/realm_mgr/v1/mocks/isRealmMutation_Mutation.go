@@ -0,0 +1,30 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// isRealmMutation_Mutation is an autogenerated mock type for the isRealmMutation_Mutation type
+type isRealmMutation_Mutation struct {
+	mock.Mock
+}
+
+// isRealmMutation_Mutation provides a mock function with given fields:
+func (_m *isRealmMutation_Mutation) isRealmMutation_Mutation() {
+	_m.Called()
+}
+
+type mockConstructorTestingTnewIsRealmMutation_Mutation interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newIsRealmMutation_Mutation creates a new instance of isRealmMutation_Mutation. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newIsRealmMutation_Mutation(t mockConstructorTestingTnewIsRealmMutation_Mutation) *isRealmMutation_Mutation {
+	mock := &isRealmMutation_Mutation{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
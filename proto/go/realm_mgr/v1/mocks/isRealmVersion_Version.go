@@ -0,0 +1,30 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// isRealmVersion_Version is an autogenerated mock type for the isRealmVersion_Version type
+type isRealmVersion_Version struct {
+	mock.Mock
+}
+
+// isRealmVersion_Version provides a mock function with given fields:
+func (_m *isRealmVersion_Version) isRealmVersion_Version() {
+	_m.Called()
+}
+
+type mockConstructorTestingTnewIsRealmVersion_Version interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// newIsRealmVersion_Version creates a new instance of isRealmVersion_Version. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func newIsRealmVersion_Version(t mockConstructorTestingTnewIsRealmVersion_Version) *isRealmVersion_Version {
+	mock := &isRealmVersion_Version{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
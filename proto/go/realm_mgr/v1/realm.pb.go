@@ -10,6 +10,8 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +24,286 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EnumRealmView int32
+
+const (
+	EnumRealmView_ENUM_REALM_VIEW_UNSPECIFIED EnumRealmView = 0
+	// Realm as stored
+	EnumRealmView_ENUM_REALM_VIEW_BASIC EnumRealmView = 1
+	// Realm with the labels and attributes inherited from its ancestors applied
+	EnumRealmView_ENUM_REALM_VIEW_EFFECTIVE EnumRealmView = 2
+)
+
+// Enum value maps for EnumRealmView.
+var (
+	EnumRealmView_name = map[int32]string{
+		0: "ENUM_REALM_VIEW_UNSPECIFIED",
+		1: "ENUM_REALM_VIEW_BASIC",
+		2: "ENUM_REALM_VIEW_EFFECTIVE",
+	}
+	EnumRealmView_value = map[string]int32{
+		"ENUM_REALM_VIEW_UNSPECIFIED": 0,
+		"ENUM_REALM_VIEW_BASIC":       1,
+		"ENUM_REALM_VIEW_EFFECTIVE":   2,
+	}
+)
+
+func (x EnumRealmView) Enum() *EnumRealmView {
+	p := new(EnumRealmView)
+	*p = x
+	return p
+}
+
+func (x EnumRealmView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumRealmView) Descriptor() protoreflect.EnumDescriptor {
+	return file_realm_mgr_v1_realm_proto_enumTypes[0].Descriptor()
+}
+
+func (EnumRealmView) Type() protoreflect.EnumType {
+	return &file_realm_mgr_v1_realm_proto_enumTypes[0]
+}
+
+func (x EnumRealmView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumRealmView.Descriptor instead.
+func (EnumRealmView) EnumDescriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{0}
+}
+
+type EnumRealmSortField int32
+
+const (
+	EnumRealmSortField_ENUM_REALM_SORT_FIELD_UNSPECIFIED EnumRealmSortField = 0
+	EnumRealmSortField_ENUM_REALM_SORT_FIELD_NAME        EnumRealmSortField = 1
+	EnumRealmSortField_ENUM_REALM_SORT_FIELD_CREATED_AT  EnumRealmSortField = 2
+	EnumRealmSortField_ENUM_REALM_SORT_FIELD_UPDATED_AT  EnumRealmSortField = 3
+)
+
+// Enum value maps for EnumRealmSortField.
+var (
+	EnumRealmSortField_name = map[int32]string{
+		0: "ENUM_REALM_SORT_FIELD_UNSPECIFIED",
+		1: "ENUM_REALM_SORT_FIELD_NAME",
+		2: "ENUM_REALM_SORT_FIELD_CREATED_AT",
+		3: "ENUM_REALM_SORT_FIELD_UPDATED_AT",
+	}
+	EnumRealmSortField_value = map[string]int32{
+		"ENUM_REALM_SORT_FIELD_UNSPECIFIED": 0,
+		"ENUM_REALM_SORT_FIELD_NAME":        1,
+		"ENUM_REALM_SORT_FIELD_CREATED_AT":  2,
+		"ENUM_REALM_SORT_FIELD_UPDATED_AT":  3,
+	}
+)
+
+func (x EnumRealmSortField) Enum() *EnumRealmSortField {
+	p := new(EnumRealmSortField)
+	*p = x
+	return p
+}
+
+func (x EnumRealmSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumRealmSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_realm_mgr_v1_realm_proto_enumTypes[1].Descriptor()
+}
+
+func (EnumRealmSortField) Type() protoreflect.EnumType {
+	return &file_realm_mgr_v1_realm_proto_enumTypes[1]
+}
+
+func (x EnumRealmSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumRealmSortField.Descriptor instead.
+func (EnumRealmSortField) EnumDescriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{1}
+}
+
+type EnumRealmEventType int32
+
+const (
+	EnumRealmEventType_ENUM_REALM_EVENT_TYPE_UNSPECIFIED EnumRealmEventType = 0
+	// Realm was created as a draft
+	EnumRealmEventType_ENUM_REALM_EVENT_TYPE_CREATED EnumRealmEventType = 1
+	// Realm was changed without being released, disabled or deleted
+	EnumRealmEventType_ENUM_REALM_EVENT_TYPE_UPDATED EnumRealmEventType = 2
+	// Draft of the realm was released
+	EnumRealmEventType_ENUM_REALM_EVENT_TYPE_RELEASED EnumRealmEventType = 3
+	// Realm was disabled
+	EnumRealmEventType_ENUM_REALM_EVENT_TYPE_DISABLED EnumRealmEventType = 4
+	// Realm, or the draft of the realm, was deleted
+	EnumRealmEventType_ENUM_REALM_EVENT_TYPE_DELETED EnumRealmEventType = 5
+)
+
+// Enum value maps for EnumRealmEventType.
+var (
+	EnumRealmEventType_name = map[int32]string{
+		0: "ENUM_REALM_EVENT_TYPE_UNSPECIFIED",
+		1: "ENUM_REALM_EVENT_TYPE_CREATED",
+		2: "ENUM_REALM_EVENT_TYPE_UPDATED",
+		3: "ENUM_REALM_EVENT_TYPE_RELEASED",
+		4: "ENUM_REALM_EVENT_TYPE_DISABLED",
+		5: "ENUM_REALM_EVENT_TYPE_DELETED",
+	}
+	EnumRealmEventType_value = map[string]int32{
+		"ENUM_REALM_EVENT_TYPE_UNSPECIFIED": 0,
+		"ENUM_REALM_EVENT_TYPE_CREATED":     1,
+		"ENUM_REALM_EVENT_TYPE_UPDATED":     2,
+		"ENUM_REALM_EVENT_TYPE_RELEASED":    3,
+		"ENUM_REALM_EVENT_TYPE_DISABLED":    4,
+		"ENUM_REALM_EVENT_TYPE_DELETED":     5,
+	}
+)
+
+func (x EnumRealmEventType) Enum() *EnumRealmEventType {
+	p := new(EnumRealmEventType)
+	*p = x
+	return p
+}
+
+func (x EnumRealmEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumRealmEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_realm_mgr_v1_realm_proto_enumTypes[2].Descriptor()
+}
+
+func (EnumRealmEventType) Type() protoreflect.EnumType {
+	return &file_realm_mgr_v1_realm_proto_enumTypes[2]
+}
+
+func (x EnumRealmEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumRealmEventType.Descriptor instead.
+func (EnumRealmEventType) EnumDescriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{2}
+}
+
+type EnumImportConflictPolicy int32
+
+const (
+	EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_UNSPECIFIED EnumImportConflictPolicy = 0
+	// Stop the import at the first realm that already exists, the records imported along with it are rolled back
+	EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_FAIL EnumImportConflictPolicy = 1
+	// Leave realms that already exist as they are
+	EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_SKIP EnumImportConflictPolicy = 2
+	// Replace all copies of realms that already exist
+	EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_OVERWRITE EnumImportConflictPolicy = 3
+)
+
+// Enum value maps for EnumImportConflictPolicy.
+var (
+	EnumImportConflictPolicy_name = map[int32]string{
+		0: "ENUM_IMPORT_CONFLICT_POLICY_UNSPECIFIED",
+		1: "ENUM_IMPORT_CONFLICT_POLICY_FAIL",
+		2: "ENUM_IMPORT_CONFLICT_POLICY_SKIP",
+		3: "ENUM_IMPORT_CONFLICT_POLICY_OVERWRITE",
+	}
+	EnumImportConflictPolicy_value = map[string]int32{
+		"ENUM_IMPORT_CONFLICT_POLICY_UNSPECIFIED": 0,
+		"ENUM_IMPORT_CONFLICT_POLICY_FAIL":        1,
+		"ENUM_IMPORT_CONFLICT_POLICY_SKIP":        2,
+		"ENUM_IMPORT_CONFLICT_POLICY_OVERWRITE":   3,
+	}
+)
+
+func (x EnumImportConflictPolicy) Enum() *EnumImportConflictPolicy {
+	p := new(EnumImportConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x EnumImportConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumImportConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_realm_mgr_v1_realm_proto_enumTypes[3].Descriptor()
+}
+
+func (EnumImportConflictPolicy) Type() protoreflect.EnumType {
+	return &file_realm_mgr_v1_realm_proto_enumTypes[3]
+}
+
+func (x EnumImportConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumImportConflictPolicy.Descriptor instead.
+func (EnumImportConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{3}
+}
+
+type EnumRealmImportOutcome int32
+
+const (
+	EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_UNSPECIFIED EnumRealmImportOutcome = 0
+	// Realm did not exist and was created
+	EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_CREATED EnumRealmImportOutcome = 1
+	// Realm already existed and was overwritten
+	EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_UPDATED EnumRealmImportOutcome = 2
+	// Realm already existed and was left as it is
+	EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_SKIPPED EnumRealmImportOutcome = 3
+	// Realm was not imported, see the error of the result
+	EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_REJECTED EnumRealmImportOutcome = 4
+)
+
+// Enum value maps for EnumRealmImportOutcome.
+var (
+	EnumRealmImportOutcome_name = map[int32]string{
+		0: "ENUM_REALM_IMPORT_OUTCOME_UNSPECIFIED",
+		1: "ENUM_REALM_IMPORT_OUTCOME_CREATED",
+		2: "ENUM_REALM_IMPORT_OUTCOME_UPDATED",
+		3: "ENUM_REALM_IMPORT_OUTCOME_SKIPPED",
+		4: "ENUM_REALM_IMPORT_OUTCOME_REJECTED",
+	}
+	EnumRealmImportOutcome_value = map[string]int32{
+		"ENUM_REALM_IMPORT_OUTCOME_UNSPECIFIED": 0,
+		"ENUM_REALM_IMPORT_OUTCOME_CREATED":     1,
+		"ENUM_REALM_IMPORT_OUTCOME_UPDATED":     2,
+		"ENUM_REALM_IMPORT_OUTCOME_SKIPPED":     3,
+		"ENUM_REALM_IMPORT_OUTCOME_REJECTED":    4,
+	}
+)
+
+func (x EnumRealmImportOutcome) Enum() *EnumRealmImportOutcome {
+	p := new(EnumRealmImportOutcome)
+	*p = x
+	return p
+}
+
+func (x EnumRealmImportOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumRealmImportOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_realm_mgr_v1_realm_proto_enumTypes[4].Descriptor()
+}
+
+func (EnumRealmImportOutcome) Type() protoreflect.EnumType {
+	return &file_realm_mgr_v1_realm_proto_enumTypes[4]
+}
+
+func (x EnumRealmImportOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumRealmImportOutcome.Descriptor instead.
+func (EnumRealmImportOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{4}
+}
+
 type Realm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -39,6 +321,25 @@ type Realm struct {
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Updated at timestamp of the realm
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Opaque version of the realm, changes whenever the realm is modified
+	Etag string `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Scheduled release of a draft realm, ignored on updates
+	ReleaseSchedule *ReleaseSchedule `protobuf:"bytes,8,opt,name=release_schedule,json=releaseSchedule,proto3" json:"release_schedule,omitempty"`
+	// Key/value labels used to group and select realms
+	Labels map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Structured metadata, every top-level attribute is validated against the JSON schema
+	// registered for it
+	Attributes *structpb.Struct `protobuf:"bytes,10,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// UUID identifier of the parent realm labels and attributes are inherited from, empty for root realms
+	ParentId string `protobuf:"bytes,11,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// UUID identifier of the realm this realm was cloned from, empty for realms created from scratch, ignored on updates
+	ClonedFrom string `protobuf:"bytes,12,opt,name=cloned_from,json=clonedFrom,proto3" json:"cloned_from,omitempty"`
+	// UUID identifier of the template the realm was created from, empty for realms not created from a template, ignored on updates
+	TemplateId string `protobuf:"bytes,13,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Version of the template the realm was created from, ignored on updates
+	TemplateVersion int64 `protobuf:"varint,14,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	// Unique human-readable identifier of the realm, ignored on updates
+	Slug string `protobuf:"bytes,15,opt,name=slug,proto3" json:"slug,omitempty"`
 }
 
 func (x *Realm) Reset() {
@@ -115,6 +416,162 @@ func (x *Realm) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Realm) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Realm) GetReleaseSchedule() *ReleaseSchedule {
+	if x != nil {
+		return x.ReleaseSchedule
+	}
+	return nil
+}
+
+func (x *Realm) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Realm) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Realm) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Realm) GetClonedFrom() string {
+	if x != nil {
+		return x.ClonedFrom
+	}
+	return ""
+}
+
+func (x *Realm) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Realm) GetTemplateVersion() int64 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *Realm) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type ReleaseSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Point in time the draft is to be released at
+	ReleaseAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	// Identity of the caller who scheduled the release
+	ScheduledBy string `protobuf:"bytes,2,opt,name=scheduled_by,json=scheduledBy,proto3" json:"scheduled_by,omitempty"`
+	// State of the scheduled release
+	State EnumReleaseScheduleState `protobuf:"varint,3,opt,name=state,proto3,enum=realm_mgr.v1.EnumReleaseScheduleState" json:"state,omitempty"`
+	// Number of failed release attempts
+	Attempts uint32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Point in time of the next release attempt
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	// Error of the last failed release attempt
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *ReleaseSchedule) Reset() {
+	*x = ReleaseSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSchedule) ProtoMessage() {}
+
+func (x *ReleaseSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSchedule.ProtoReflect.Descriptor instead.
+func (*ReleaseSchedule) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReleaseSchedule) GetReleaseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return nil
+}
+
+func (x *ReleaseSchedule) GetScheduledBy() string {
+	if x != nil {
+		return x.ScheduledBy
+	}
+	return ""
+}
+
+func (x *ReleaseSchedule) GetState() EnumReleaseScheduleState {
+	if x != nil {
+		return x.State
+	}
+	return EnumReleaseScheduleState_ENUM_RELEASE_SCHEDULE_STATE_UNSPECIFIED
+}
+
+func (x *ReleaseSchedule) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ReleaseSchedule) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *ReleaseSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
 type GetRealmRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -124,12 +581,14 @@ type GetRealmRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Realm status to be returned
 	Status EnumStatus `protobuf:"varint,2,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
+	// View of the realm to be returned, defaults to basic
+	View EnumRealmView `protobuf:"varint,3,opt,name=view,proto3,enum=realm_mgr.v1.EnumRealmView" json:"view,omitempty"`
 }
 
 func (x *GetRealmRequest) Reset() {
 	*x = GetRealmRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_realm_mgr_v1_realm_proto_msgTypes[1]
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -142,7 +601,7 @@ func (x *GetRealmRequest) String() string {
 func (*GetRealmRequest) ProtoMessage() {}
 
 func (x *GetRealmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_realm_mgr_v1_realm_proto_msgTypes[1]
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +614,7 @@ func (x *GetRealmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRealmRequest.ProtoReflect.Descriptor instead.
 func (*GetRealmRequest) Descriptor() ([]byte, []int) {
-	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{1}
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetRealmRequest) GetId() string {
@@ -172,6 +631,13 @@ func (x *GetRealmRequest) GetStatus() EnumStatus {
 	return EnumStatus_ENUM_STATUS_UNSPECIFIED
 }
 
+func (x *GetRealmRequest) GetView() EnumRealmView {
+	if x != nil {
+		return x.View
+	}
+	return EnumRealmView_ENUM_REALM_VIEW_UNSPECIFIED
+}
+
 type GetRealmResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -183,7 +649,7 @@ type GetRealmResponse struct {
 func (x *GetRealmResponse) Reset() {
 	*x = GetRealmResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_realm_mgr_v1_realm_proto_msgTypes[2]
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -196,7 +662,7 @@ func (x *GetRealmResponse) String() string {
 func (*GetRealmResponse) ProtoMessage() {}
 
 func (x *GetRealmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realm_mgr_v1_realm_proto_msgTypes[2]
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,7 +675,7 @@ func (x *GetRealmResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRealmResponse.ProtoReflect.Descriptor instead.
 func (*GetRealmResponse) Descriptor() ([]byte, []int) {
-	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{2}
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetRealmResponse) GetRealm() *Realm {
@@ -219,34 +685,36 @@ func (x *GetRealmResponse) GetRealm() *Realm {
 	return nil
 }
 
-type CreateRealmRequest struct {
+type GetRealmBySlugRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of the realm to be created
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Description of the realm to be created
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Current slug of the realm, or a former slug that still redirects to the realm
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Realm status to be returned
+	Status EnumStatus `protobuf:"varint,2,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
+	// View of the realm to be returned, defaults to basic
+	View EnumRealmView `protobuf:"varint,3,opt,name=view,proto3,enum=realm_mgr.v1.EnumRealmView" json:"view,omitempty"`
 }
 
-func (x *CreateRealmRequest) Reset() {
-	*x = CreateRealmRequest{}
+func (x *GetRealmBySlugRequest) Reset() {
+	*x = GetRealmBySlugRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_realm_mgr_v1_realm_proto_msgTypes[3]
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRealmRequest) String() string {
+func (x *GetRealmBySlugRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRealmRequest) ProtoMessage() {}
+func (*GetRealmBySlugRequest) ProtoMessage() {}
 
-func (x *CreateRealmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_realm_mgr_v1_realm_proto_msgTypes[3]
+func (x *GetRealmBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,50 +725,59 @@ func (x *CreateRealmRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRealmRequest.ProtoReflect.Descriptor instead.
-func (*CreateRealmRequest) Descriptor() ([]byte, []int) {
-	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetRealmBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetRealmBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateRealmRequest) GetName() string {
+func (x *GetRealmBySlugRequest) GetSlug() string {
 	if x != nil {
-		return x.Name
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *CreateRealmRequest) GetDescription() string {
+func (x *GetRealmBySlugRequest) GetStatus() EnumStatus {
 	if x != nil {
-		return x.Description
+		return x.Status
 	}
-	return ""
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
 }
 
-type CreateRealmResponse struct {
+func (x *GetRealmBySlugRequest) GetView() EnumRealmView {
+	if x != nil {
+		return x.View
+	}
+	return EnumRealmView_ENUM_REALM_VIEW_UNSPECIFIED
+}
+
+type GetRealmBySlugResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Set when the requested slug is a former slug of the realm, the current slug is held by the realm
+	Redirected bool `protobuf:"varint,2,opt,name=redirected,proto3" json:"redirected,omitempty"`
 }
 
-func (x *CreateRealmResponse) Reset() {
-	*x = CreateRealmResponse{}
+func (x *GetRealmBySlugResponse) Reset() {
+	*x = GetRealmBySlugResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_realm_mgr_v1_realm_proto_msgTypes[4]
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRealmResponse) String() string {
+func (x *GetRealmBySlugResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRealmResponse) ProtoMessage() {}
+func (*GetRealmBySlugResponse) ProtoMessage() {}
 
-func (x *CreateRealmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realm_mgr_v1_realm_proto_msgTypes[4]
+func (x *GetRealmBySlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,44 +788,53 @@ func (x *CreateRealmResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRealmResponse.ProtoReflect.Descriptor instead.
-func (*CreateRealmResponse) Descriptor() ([]byte, []int) {
-	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetRealmBySlugResponse.ProtoReflect.Descriptor instead.
+func (*GetRealmBySlugResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateRealmResponse) GetRealm() *Realm {
+func (x *GetRealmBySlugResponse) GetRealm() *Realm {
 	if x != nil {
 		return x.Realm
 	}
 	return nil
 }
 
-type ReleaseRealmRequest struct {
+func (x *GetRealmBySlugResponse) GetRedirected() bool {
+	if x != nil {
+		return x.Redirected
+	}
+	return false
+}
+
+type GetRealmAncestorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// UUID identifier of the realm
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Status of the realm the ancestors are looked up for
+	Status EnumStatus `protobuf:"varint,2,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
 }
 
-func (x *ReleaseRealmRequest) Reset() {
-	*x = ReleaseRealmRequest{}
+func (x *GetRealmAncestorsRequest) Reset() {
+	*x = GetRealmAncestorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_realm_mgr_v1_realm_proto_msgTypes[5]
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReleaseRealmRequest) String() string {
+func (x *GetRealmAncestorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseRealmRequest) ProtoMessage() {}
+func (*GetRealmAncestorsRequest) ProtoMessage() {}
 
-func (x *ReleaseRealmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_realm_mgr_v1_realm_proto_msgTypes[5]
+func (x *GetRealmAncestorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,43 +845,51 @@ func (x *ReleaseRealmRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseRealmRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseRealmRequest) Descriptor() ([]byte, []int) {
-	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetRealmAncestorsRequest.ProtoReflect.Descriptor instead.
+func (*GetRealmAncestorsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ReleaseRealmRequest) GetId() string {
+func (x *GetRealmAncestorsRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ReleaseRealmResponse struct {
+func (x *GetRealmAncestorsRequest) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+type GetRealmAncestorsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Ancestors of the realm from its direct parent up to the root
+	Ancestors []*Realm `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
 }
 
-func (x *ReleaseRealmResponse) Reset() {
-	*x = ReleaseRealmResponse{}
+func (x *GetRealmAncestorsResponse) Reset() {
+	*x = GetRealmAncestorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_realm_mgr_v1_realm_proto_msgTypes[6]
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReleaseRealmResponse) String() string {
+func (x *GetRealmAncestorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseRealmResponse) ProtoMessage() {}
+func (*GetRealmAncestorsResponse) ProtoMessage() {}
 
-func (x *ReleaseRealmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realm_mgr_v1_realm_proto_msgTypes[6]
+func (x *GetRealmAncestorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,43 +900,46 @@ func (x *ReleaseRealmResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseRealmResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseRealmResponse) Descriptor() ([]byte, []int) {
-	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetRealmAncestorsResponse.ProtoReflect.Descriptor instead.
+func (*GetRealmAncestorsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ReleaseRealmResponse) GetRealm() *Realm {
+func (x *GetRealmAncestorsResponse) GetAncestors() []*Realm {
 	if x != nil {
-		return x.Realm
+		return x.Ancestors
 	}
 	return nil
 }
 
-type UpdateRealmRequest struct {
+type BatchGetRealmsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// UUID identifiers of the realms, the maximum number of identifiers is configured by the service
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Realm status to be returned
+	Status EnumStatus `protobuf:"varint,2,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
 }
 
-func (x *UpdateRealmRequest) Reset() {
-	*x = UpdateRealmRequest{}
+func (x *BatchGetRealmsRequest) Reset() {
+	*x = BatchGetRealmsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_realm_mgr_v1_realm_proto_msgTypes[7]
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateRealmRequest) String() string {
+func (x *BatchGetRealmsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRealmRequest) ProtoMessage() {}
+func (*BatchGetRealmsRequest) ProtoMessage() {}
 
-func (x *UpdateRealmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_realm_mgr_v1_realm_proto_msgTypes[7]
+func (x *BatchGetRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,43 +950,55 @@ func (x *UpdateRealmRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRealmRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRealmRequest) Descriptor() ([]byte, []int) {
-	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BatchGetRealmsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateRealmRequest) GetRealm() *Realm {
+func (x *BatchGetRealmsRequest) GetIds() []string {
 	if x != nil {
-		return x.Realm
+		return x.Ids
 	}
 	return nil
 }
 
-type UpdateRealmResponse struct {
+func (x *BatchGetRealmsRequest) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+type BatchGetRealmsResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// UUID identifier of the requested realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Result:
+	//	*BatchGetRealmsResult_Realm
+	//	*BatchGetRealmsResult_Error
+	Result isBatchGetRealmsResult_Result `protobuf_oneof:"result"`
 }
 
-func (x *UpdateRealmResponse) Reset() {
-	*x = UpdateRealmResponse{}
+func (x *BatchGetRealmsResult) Reset() {
+	*x = BatchGetRealmsResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_realm_mgr_v1_realm_proto_msgTypes[8]
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateRealmResponse) String() string {
+func (x *BatchGetRealmsResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRealmResponse) ProtoMessage() {}
+func (*BatchGetRealmsResult) ProtoMessage() {}
 
-func (x *UpdateRealmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_realm_mgr_v1_realm_proto_msgTypes[8]
+func (x *BatchGetRealmsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,196 +1009,6186 @@ func (x *UpdateRealmResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRealmResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRealmResponse) Descriptor() ([]byte, []int) {
-	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BatchGetRealmsResult.ProtoReflect.Descriptor instead.
+func (*BatchGetRealmsResult) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateRealmResponse) GetRealm() *Realm {
+func (x *BatchGetRealmsResult) GetId() string {
 	if x != nil {
-		return x.Realm
+		return x.Id
+	}
+	return ""
+}
+
+func (m *BatchGetRealmsResult) GetResult() isBatchGetRealmsResult_Result {
+	if m != nil {
+		return m.Result
 	}
 	return nil
 }
 
-var File_realm_mgr_v1_realm_proto protoreflect.FileDescriptor
+func (x *BatchGetRealmsResult) GetRealm() *Realm {
+	if x, ok := x.GetResult().(*BatchGetRealmsResult_Realm); ok {
+		return x.Realm
+	}
+	return nil
+}
 
-var file_realm_mgr_v1_realm_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x72, 0x65, 0x61, 0x6c,
-	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
-	0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x02, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x18,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
-	0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
-	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0x5d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3d,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x53, 0x0a,
-	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61,
-	0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
-	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x22, 0x2f, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01,
-	0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
-	0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c,
-	0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x3f, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
-	0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61,
-	0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x40, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x42, 0x1b, 0x5a, 0x19, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x61, 0x6c,
-	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *BatchGetRealmsResult) GetError() *BatchError {
+	if x, ok := x.GetResult().(*BatchGetRealmsResult_Error); ok {
+		return x.Error
+	}
+	return nil
 }
 
-var (
-	file_realm_mgr_v1_realm_proto_rawDescOnce sync.Once
-	file_realm_mgr_v1_realm_proto_rawDescData = file_realm_mgr_v1_realm_proto_rawDesc
-)
+type isBatchGetRealmsResult_Result interface {
+	isBatchGetRealmsResult_Result()
+}
 
-func file_realm_mgr_v1_realm_proto_rawDescGZIP() []byte {
-	file_realm_mgr_v1_realm_proto_rawDescOnce.Do(func() {
-		file_realm_mgr_v1_realm_proto_rawDescData = protoimpl.X.CompressGZIP(file_realm_mgr_v1_realm_proto_rawDescData)
-	})
-	return file_realm_mgr_v1_realm_proto_rawDescData
+type BatchGetRealmsResult_Realm struct {
+	// Realm found for the identifier
+	Realm *Realm `protobuf:"bytes,2,opt,name=realm,proto3,oneof"`
 }
 
-var file_realm_mgr_v1_realm_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_realm_mgr_v1_realm_proto_goTypes = []interface{}{
-	(*Realm)(nil),                 // 0: realm_mgr.v1.Realm
-	(*GetRealmRequest)(nil),       // 1: realm_mgr.v1.GetRealmRequest
-	(*GetRealmResponse)(nil),      // 2: realm_mgr.v1.GetRealmResponse
-	(*CreateRealmRequest)(nil),    // 3: realm_mgr.v1.CreateRealmRequest
-	(*CreateRealmResponse)(nil),   // 4: realm_mgr.v1.CreateRealmResponse
-	(*ReleaseRealmRequest)(nil),   // 5: realm_mgr.v1.ReleaseRealmRequest
-	(*ReleaseRealmResponse)(nil),  // 6: realm_mgr.v1.ReleaseRealmResponse
-	(*UpdateRealmRequest)(nil),    // 7: realm_mgr.v1.UpdateRealmRequest
-	(*UpdateRealmResponse)(nil),   // 8: realm_mgr.v1.UpdateRealmResponse
-	(EnumStatus)(0),               // 9: realm_mgr.v1.EnumStatus
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+type BatchGetRealmsResult_Error struct {
+	// Error explaining why the realm could not be returned
+	Error *BatchError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
 }
-var file_realm_mgr_v1_realm_proto_depIdxs = []int32{
-	9,  // 0: realm_mgr.v1.Realm.status:type_name -> realm_mgr.v1.EnumStatus
-	10, // 1: realm_mgr.v1.Realm.created_at:type_name -> google.protobuf.Timestamp
-	10, // 2: realm_mgr.v1.Realm.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 3: realm_mgr.v1.GetRealmRequest.status:type_name -> realm_mgr.v1.EnumStatus
-	0,  // 4: realm_mgr.v1.GetRealmResponse.realm:type_name -> realm_mgr.v1.Realm
-	0,  // 5: realm_mgr.v1.CreateRealmResponse.realm:type_name -> realm_mgr.v1.Realm
-	0,  // 6: realm_mgr.v1.ReleaseRealmResponse.realm:type_name -> realm_mgr.v1.Realm
-	0,  // 7: realm_mgr.v1.UpdateRealmRequest.realm:type_name -> realm_mgr.v1.Realm
-	0,  // 8: realm_mgr.v1.UpdateRealmResponse.realm:type_name -> realm_mgr.v1.Realm
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+func (*BatchGetRealmsResult_Realm) isBatchGetRealmsResult_Result() {}
+
+func (*BatchGetRealmsResult_Error) isBatchGetRealmsResult_Result() {}
+
+type BatchGetRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Results in the order of the requested identifiers
+	Results []*BatchGetRealmsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func init() { file_realm_mgr_v1_realm_proto_init() }
-func file_realm_mgr_v1_realm_proto_init() {
-	if File_realm_mgr_v1_realm_proto != nil {
-		return
+func (x *BatchGetRealmsResponse) Reset() {
+	*x = BatchGetRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	file_realm_mgr_v1_common_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_realm_mgr_v1_realm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Realm); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_realm_mgr_v1_realm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRealmRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_realm_mgr_v1_realm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRealmResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *BatchGetRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRealmsResponse) ProtoMessage() {}
+
+func (x *BatchGetRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_realm_mgr_v1_realm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRealmRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetRealmsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchGetRealmsResponse) GetResults() []*BatchGetRealmsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListRealmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Realm status to be listed, defaults to active
+	Status EnumStatus `protobuf:"varint,1,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
+	// Maximum number of realms to be returned, defaults to 25
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned by a previous call to continue listing from
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Field realms are sorted by, defaults to name
+	SortField EnumRealmSortField `protobuf:"varint,4,opt,name=sort_field,json=sortField,proto3,enum=realm_mgr.v1.EnumRealmSortField" json:"sort_field,omitempty"`
+	// Sort direction, defaults to ascending
+	SortDirection EnumSortDirection `protobuf:"varint,5,opt,name=sort_direction,json=sortDirection,proto3,enum=realm_mgr.v1.EnumSortDirection" json:"sort_direction,omitempty"`
+	// Label selector realms must match, e.g. "env=prod,region in (eu,us),!legacy"
+	LabelSelector string `protobuf:"bytes,6,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+}
+
+func (x *ListRealmsRequest) Reset() {
+	*x = ListRealmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRealmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRealmsRequest) ProtoMessage() {}
+
+func (x *ListRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_realm_mgr_v1_realm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRealmResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRealmsRequest.ProtoReflect.Descriptor instead.
+func (*ListRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListRealmsRequest) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+func (x *ListRealmsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRealmsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRealmsRequest) GetSortField() EnumRealmSortField {
+	if x != nil {
+		return x.SortField
+	}
+	return EnumRealmSortField_ENUM_REALM_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListRealmsRequest) GetSortDirection() EnumSortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return EnumSortDirection_ENUM_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *ListRealmsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type ListRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realms []*Realm `protobuf:"bytes,1,rep,name=realms,proto3" json:"realms,omitempty"`
+	// Token to retrieve the next page, empty when there are no more realms
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListRealmsResponse) Reset() {
+	*x = ListRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRealmsResponse) ProtoMessage() {}
+
+func (x *ListRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRealmsResponse.ProtoReflect.Descriptor instead.
+func (*ListRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListRealmsResponse) GetRealms() []*Realm {
+	if x != nil {
+		return x.Realms
+	}
+	return nil
+}
+
+func (x *ListRealmsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchRealmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Search query in web search syntax, e.g. `payments -legacy` or `"order processing"`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Realm status to be searched, defaults to active
+	Status EnumStatus `protobuf:"varint,2,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
+	// Maximum number of results to be returned, defaults to 25
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned by a previous call to continue searching from
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchRealmsRequest) Reset() {
+	*x = SearchRealmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRealmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRealmsRequest) ProtoMessage() {}
+
+func (x *SearchRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRealmsRequest.ProtoReflect.Descriptor instead.
+func (*SearchRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchRealmsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRealmsRequest) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+func (x *SearchRealmsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRealmsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type RealmSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Relevance of the realm to the query, matches in names rank above matches in descriptions
+	Rank float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// HTML escaped name of the realm with the matched terms wrapped in <mark> tags
+	NameHighlight string `protobuf:"bytes,3,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight,omitempty"`
+	// HTML escaped fragments of the description around the matched terms, which are wrapped in <mark> tags
+	DescriptionHighlight string `protobuf:"bytes,4,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`
+}
+
+func (x *RealmSearchResult) Reset() {
+	*x = RealmSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmSearchResult) ProtoMessage() {}
+
+func (x *RealmSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmSearchResult.ProtoReflect.Descriptor instead.
+func (*RealmSearchResult) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RealmSearchResult) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+func (x *RealmSearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RealmSearchResult) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *RealmSearchResult) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+type SearchRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Matching realms, most relevant first
+	Results []*RealmSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Token to retrieve the next page, empty when there are no more results
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SearchRealmsResponse) Reset() {
+	*x = SearchRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRealmsResponse) ProtoMessage() {}
+
+func (x *SearchRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRealmsResponse.ProtoReflect.Descriptor instead.
+func (*SearchRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchRealmsResponse) GetResults() []*RealmSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchRealmsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListChildRealmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the parent realm
+	ParentId string `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Status of the child realms to be listed, defaults to active
+	Status EnumStatus `protobuf:"varint,2,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
+	// Maximum number of realms to be returned, defaults to 25
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned by a previous call to continue listing from
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListChildRealmsRequest) Reset() {
+	*x = ListChildRealmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChildRealmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildRealmsRequest) ProtoMessage() {}
+
+func (x *ListChildRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildRealmsRequest.ProtoReflect.Descriptor instead.
+func (*ListChildRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListChildRealmsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListChildRealmsRequest) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+func (x *ListChildRealmsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChildRealmsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListChildRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Direct children of the realm sorted by name
+	Realms []*Realm `protobuf:"bytes,1,rep,name=realms,proto3" json:"realms,omitempty"`
+	// Token to retrieve the next page, empty when there are no more realms
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListChildRealmsResponse) Reset() {
+	*x = ListChildRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChildRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildRealmsResponse) ProtoMessage() {}
+
+func (x *ListChildRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildRealmsResponse.ProtoReflect.Descriptor instead.
+func (*ListChildRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListChildRealmsResponse) GetRealms() []*Realm {
+	if x != nil {
+		return x.Realms
+	}
+	return nil
+}
+
+func (x *ListChildRealmsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetRealmStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Label selector realms must match to be counted, e.g. "env=prod,region in (eu,us),!legacy"
+	LabelSelector string `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// Only realms created at or after this point in time are counted
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Only realms created before this point in time are counted
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// Number of days without updates after which drafts count as stale, defaults to 30
+	StaleDraftDays uint32 `protobuf:"varint,4,opt,name=stale_draft_days,json=staleDraftDays,proto3" json:"stale_draft_days,omitempty"`
+}
+
+func (x *GetRealmStatsRequest) Reset() {
+	*x = GetRealmStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealmStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealmStatsRequest) ProtoMessage() {}
+
+func (x *GetRealmStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealmStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRealmStatsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRealmStatsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *GetRealmStatsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *GetRealmStatsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *GetRealmStatsRequest) GetStaleDraftDays() uint32 {
+	if x != nil {
+		return x.StaleDraftDays
+	}
+	return 0
+}
+
+type RealmDailyCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Midnight UTC of the day
+	Day *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	// Number of realms created on the day
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RealmDailyCount) Reset() {
+	*x = RealmDailyCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmDailyCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmDailyCount) ProtoMessage() {}
+
+func (x *RealmDailyCount) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmDailyCount.ProtoReflect.Descriptor instead.
+func (*RealmDailyCount) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RealmDailyCount) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *RealmDailyCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetRealmStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of realms with an active copy
+	ActiveCount uint32 `protobuf:"varint,1,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
+	// Number of realms with a draft copy, released realms with pending changes are counted as active as well
+	DraftCount uint32 `protobuf:"varint,2,opt,name=draft_count,json=draftCount,proto3" json:"draft_count,omitempty"`
+	// Number of realms with a disabled copy
+	DisabledCount uint32 `protobuf:"varint,3,opt,name=disabled_count,json=disabledCount,proto3" json:"disabled_count,omitempty"`
+	// Number of deleted realms that were not purged yet
+	DeletedCount uint32 `protobuf:"varint,4,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	// Number of drafts that were not updated for the requested number of days
+	StaleDraftCount uint32 `protobuf:"varint,5,opt,name=stale_draft_count,json=staleDraftCount,proto3" json:"stale_draft_count,omitempty"`
+	// Realms created per day within the requested creation range, or within the last 30 days when the range has no
+	// start. Days without any realm created are left out. The range cannot span more than 366 days.
+	CreatedPerDay []*RealmDailyCount `protobuf:"bytes,6,rep,name=created_per_day,json=createdPerDay,proto3" json:"created_per_day,omitempty"`
+	// Point in time the stats were computed at, stats are cached by the service for a short time
+	GeneratedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+}
+
+func (x *GetRealmStatsResponse) Reset() {
+	*x = GetRealmStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealmStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealmStatsResponse) ProtoMessage() {}
+
+func (x *GetRealmStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealmStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetRealmStatsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRealmStatsResponse) GetActiveCount() uint32 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+func (x *GetRealmStatsResponse) GetDraftCount() uint32 {
+	if x != nil {
+		return x.DraftCount
+	}
+	return 0
+}
+
+func (x *GetRealmStatsResponse) GetDisabledCount() uint32 {
+	if x != nil {
+		return x.DisabledCount
+	}
+	return 0
+}
+
+func (x *GetRealmStatsResponse) GetDeletedCount() uint32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *GetRealmStatsResponse) GetStaleDraftCount() uint32 {
+	if x != nil {
+		return x.StaleDraftCount
+	}
+	return 0
+}
+
+func (x *GetRealmStatsResponse) GetCreatedPerDay() []*RealmDailyCount {
+	if x != nil {
+		return x.CreatedPerDay
+	}
+	return nil
+}
+
+func (x *GetRealmStatsResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type RealmEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm that changed
+	RealmId string `protobuf:"bytes,1,opt,name=realm_id,json=realmId,proto3" json:"realm_id,omitempty"`
+	// Kind of change made to the realm
+	Type EnumRealmEventType `protobuf:"varint,2,opt,name=type,proto3,enum=realm_mgr.v1.EnumRealmEventType" json:"type,omitempty"`
+	// Status of the realm the change was made to, unspecified when the whole realm was deleted
+	Status EnumStatus `protobuf:"varint,3,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
+	// Point in time the change was made at
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *RealmEvent) Reset() {
+	*x = RealmEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmEvent) ProtoMessage() {}
+
+func (x *RealmEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmEvent.ProtoReflect.Descriptor instead.
+func (*RealmEvent) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RealmEvent) GetRealmId() string {
+	if x != nil {
+		return x.RealmId
+	}
+	return ""
+}
+
+func (x *RealmEvent) GetType() EnumRealmEventType {
+	if x != nil {
+		return x.Type
+	}
+	return EnumRealmEventType_ENUM_REALM_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *RealmEvent) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+func (x *RealmEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type WatchRealmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm to be watched, all realms are watched when empty
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Status of the realms to be watched, realms in any status are watched when unspecified.
+	// Deletes of whole realms are sent regardless of the status.
+	Status EnumStatus `protobuf:"varint,2,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus" json:"status,omitempty"`
+	// Opaque token of a previously received response to resume watching after, only changes
+	// made from now on are watched when empty
+	SequenceToken string `protobuf:"bytes,3,opt,name=sequence_token,json=sequenceToken,proto3" json:"sequence_token,omitempty"`
+}
+
+func (x *WatchRealmsRequest) Reset() {
+	*x = WatchRealmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRealmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRealmsRequest) ProtoMessage() {}
+
+func (x *WatchRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRealmsRequest.ProtoReflect.Descriptor instead.
+func (*WatchRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WatchRealmsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchRealmsRequest) GetStatus() EnumStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+func (x *WatchRealmsRequest) GetSequenceToken() string {
+	if x != nil {
+		return x.SequenceToken
+	}
+	return ""
+}
+
+type WatchRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Change made to a realm, empty in the first response of a watch
+	Event *RealmEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Token to resume watching after this response
+	SequenceToken string `protobuf:"bytes,2,opt,name=sequence_token,json=sequenceToken,proto3" json:"sequence_token,omitempty"`
+}
+
+func (x *WatchRealmsResponse) Reset() {
+	*x = WatchRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRealmsResponse) ProtoMessage() {}
+
+func (x *WatchRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRealmsResponse.ProtoReflect.Descriptor instead.
+func (*WatchRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchRealmsResponse) GetEvent() *RealmEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchRealmsResponse) GetSequenceToken() string {
+	if x != nil {
+		return x.SequenceToken
+	}
+	return ""
+}
+
+type CreateRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the realm to be created
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the realm to be created
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Labels of the realm to be created
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Attributes of the realm to be created
+	Attributes *structpb.Struct `protobuf:"bytes,5,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// UUID identifier of the parent realm, the realm is created as a root realm when empty
+	ParentId string `protobuf:"bytes,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Slug of the realm to be created, derived from the name when empty
+	Slug string `protobuf:"bytes,7,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+func (x *CreateRealmRequest) Reset() {
+	*x = CreateRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRealmRequest) ProtoMessage() {}
+
+func (x *CreateRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRealmRequest.ProtoReflect.Descriptor instead.
+func (*CreateRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateRealmRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRealmRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *CreateRealmRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateRealmRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateRealmRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateRealmRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CreateRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *CreateRealmResponse) Reset() {
+	*x = CreateRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRealmResponse) ProtoMessage() {}
+
+func (x *CreateRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRealmResponse.ProtoReflect.Descriptor instead.
+func (*CreateRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateRealmResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type RenameRealmSlugRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New slug of the realm, the former slug redirects to the realm for the period configured by the service
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *RenameRealmSlugRequest) Reset() {
+	*x = RenameRealmSlugRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameRealmSlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRealmSlugRequest) ProtoMessage() {}
+
+func (x *RenameRealmSlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRealmSlugRequest.ProtoReflect.Descriptor instead.
+func (*RenameRealmSlugRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RenameRealmSlugRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameRealmSlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RenameRealmSlugRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RenameRealmSlugResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *RenameRealmSlugResponse) Reset() {
+	*x = RenameRealmSlugResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameRealmSlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRealmSlugResponse) ProtoMessage() {}
+
+func (x *RenameRealmSlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRealmSlugResponse.ProtoReflect.Descriptor instead.
+func (*RenameRealmSlugResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RenameRealmSlugResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type CloneRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm to be cloned
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Status of the source realm to be cloned, either active or draft, defaults to active
+	SourceStatus EnumStatus `protobuf:"varint,2,opt,name=source_status,json=sourceStatus,proto3,enum=realm_mgr.v1.EnumStatus" json:"source_status,omitempty"`
+	// Name of the cloned realm
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *CloneRealmRequest) Reset() {
+	*x = CloneRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneRealmRequest) ProtoMessage() {}
+
+func (x *CloneRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneRealmRequest.ProtoReflect.Descriptor instead.
+func (*CloneRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CloneRealmRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *CloneRealmRequest) GetSourceStatus() EnumStatus {
+	if x != nil {
+		return x.SourceStatus
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+func (x *CloneRealmRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type CloneRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *CloneRealmResponse) Reset() {
+	*x = CloneRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneRealmResponse) ProtoMessage() {}
+
+func (x *CloneRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneRealmResponse.ProtoReflect.Descriptor instead.
+func (*CloneRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CloneRealmResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type ReleaseRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Etag of the draft to be released, the release is aborted when the draft has changed since
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *ReleaseRealmRequest) Reset() {
+	*x = ReleaseRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRealmRequest) ProtoMessage() {}
+
+func (x *ReleaseRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRealmRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReleaseRealmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReleaseRealmRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *ReleaseRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type ReleaseRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *ReleaseRealmResponse) Reset() {
+	*x = ReleaseRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRealmResponse) ProtoMessage() {}
+
+func (x *ReleaseRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRealmResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReleaseRealmResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type UpdateRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Realm fields to be updated. When empty, name and description are updated along with the
+	// labels, attributes and parent_id that are set in the realm
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Etag of the realm the update is based on, the update is aborted when the realm has changed since
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *UpdateRealmRequest) Reset() {
+	*x = UpdateRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRealmRequest) ProtoMessage() {}
+
+func (x *UpdateRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRealmRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateRealmRequest) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+func (x *UpdateRealmRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateRealmRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type UpdateRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *UpdateRealmResponse) Reset() {
+	*x = UpdateRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRealmResponse) ProtoMessage() {}
+
+func (x *UpdateRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRealmResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateRealmResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type CreateRealmMutation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the realm
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the realm
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Labels of the realm
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Attributes of the realm
+	Attributes *structpb.Struct `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// UUID identifier of the parent realm
+	ParentId string `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Slug of the realm, derived from the name when empty
+	Slug string `protobuf:"bytes,6,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+func (x *CreateRealmMutation) Reset() {
+	*x = CreateRealmMutation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRealmMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRealmMutation) ProtoMessage() {}
+
+func (x *CreateRealmMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRealmMutation.ProtoReflect.Descriptor instead.
+func (*CreateRealmMutation) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateRealmMutation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRealmMutation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRealmMutation) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateRealmMutation) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateRealmMutation) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateRealmMutation) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type UpdateRealmMutation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Realm fields to be updated. When empty, name and description are updated along with the
+	// labels, attributes and parent_id that are set in the realm
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Etag of the realm the update is based on
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *UpdateRealmMutation) Reset() {
+	*x = UpdateRealmMutation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRealmMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRealmMutation) ProtoMessage() {}
+
+func (x *UpdateRealmMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRealmMutation.ProtoReflect.Descriptor instead.
+func (*UpdateRealmMutation) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateRealmMutation) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+func (x *UpdateRealmMutation) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateRealmMutation) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ReleaseRealmMutation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Etag of the draft to be released
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *ReleaseRealmMutation) Reset() {
+	*x = ReleaseRealmMutation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseRealmMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRealmMutation) ProtoMessage() {}
+
+func (x *ReleaseRealmMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRealmMutation.ProtoReflect.Descriptor instead.
+func (*ReleaseRealmMutation) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReleaseRealmMutation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReleaseRealmMutation) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DisableRealmMutation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reason for disabling the realm
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Disable the active descendants of the realm as well
+	Cascade bool `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+}
+
+func (x *DisableRealmMutation) Reset() {
+	*x = DisableRealmMutation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableRealmMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableRealmMutation) ProtoMessage() {}
+
+func (x *DisableRealmMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableRealmMutation.ProtoReflect.Descriptor instead.
+func (*DisableRealmMutation) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DisableRealmMutation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DisableRealmMutation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DisableRealmMutation) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type RealmMutation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Mutation:
+	//	*RealmMutation_Create
+	//	*RealmMutation_Update
+	//	*RealmMutation_Release
+	//	*RealmMutation_Disable
+	Mutation isRealmMutation_Mutation `protobuf_oneof:"mutation"`
+}
+
+func (x *RealmMutation) Reset() {
+	*x = RealmMutation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmMutation) ProtoMessage() {}
+
+func (x *RealmMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmMutation.ProtoReflect.Descriptor instead.
+func (*RealmMutation) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{38}
+}
+
+func (m *RealmMutation) GetMutation() isRealmMutation_Mutation {
+	if m != nil {
+		return m.Mutation
+	}
+	return nil
+}
+
+func (x *RealmMutation) GetCreate() *CreateRealmMutation {
+	if x, ok := x.GetMutation().(*RealmMutation_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *RealmMutation) GetUpdate() *UpdateRealmMutation {
+	if x, ok := x.GetMutation().(*RealmMutation_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *RealmMutation) GetRelease() *ReleaseRealmMutation {
+	if x, ok := x.GetMutation().(*RealmMutation_Release); ok {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *RealmMutation) GetDisable() *DisableRealmMutation {
+	if x, ok := x.GetMutation().(*RealmMutation_Disable); ok {
+		return x.Disable
+	}
+	return nil
+}
+
+type isRealmMutation_Mutation interface {
+	isRealmMutation_Mutation()
+}
+
+type RealmMutation_Create struct {
+	Create *CreateRealmMutation `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type RealmMutation_Update struct {
+	Update *UpdateRealmMutation `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+type RealmMutation_Release struct {
+	Release *ReleaseRealmMutation `protobuf:"bytes,3,opt,name=release,proto3,oneof"`
+}
+
+type RealmMutation_Disable struct {
+	Disable *DisableRealmMutation `protobuf:"bytes,4,opt,name=disable,proto3,oneof"`
+}
+
+func (*RealmMutation_Create) isRealmMutation_Mutation() {}
+
+func (*RealmMutation_Update) isRealmMutation_Mutation() {}
+
+func (*RealmMutation_Release) isRealmMutation_Mutation() {}
+
+func (*RealmMutation_Disable) isRealmMutation_Mutation() {}
+
+type BatchMutateRealmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Mutations applied in order within a single transaction
+	Mutations []*RealmMutation `protobuf:"bytes,1,rep,name=mutations,proto3" json:"mutations,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *BatchMutateRealmsRequest) Reset() {
+	*x = BatchMutateRealmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchMutateRealmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMutateRealmsRequest) ProtoMessage() {}
+
+func (x *BatchMutateRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMutateRealmsRequest.ProtoReflect.Descriptor instead.
+func (*BatchMutateRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchMutateRealmsRequest) GetMutations() []*RealmMutation {
+	if x != nil {
+		return x.Mutations
+	}
+	return nil
+}
+
+func (x *BatchMutateRealmsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type BatchMutateRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Mutated realms in the order of the mutations, empty when a mutation failed
+	Realms []*Realm `protobuf:"bytes,1,rep,name=realms,proto3" json:"realms,omitempty"`
+	// Index of the first failed mutation, only meaningful when error is set
+	FailedIndex uint32 `protobuf:"varint,2,opt,name=failed_index,json=failedIndex,proto3" json:"failed_index,omitempty"`
+	// Error of the first failed mutation, none of the mutations were applied when set
+	Error *BatchError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchMutateRealmsResponse) Reset() {
+	*x = BatchMutateRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchMutateRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMutateRealmsResponse) ProtoMessage() {}
+
+func (x *BatchMutateRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMutateRealmsResponse.ProtoReflect.Descriptor instead.
+func (*BatchMutateRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchMutateRealmsResponse) GetRealms() []*Realm {
+	if x != nil {
+		return x.Realms
+	}
+	return nil
+}
+
+func (x *BatchMutateRealmsResponse) GetFailedIndex() uint32 {
+	if x != nil {
+		return x.FailedIndex
+	}
+	return 0
+}
+
+func (x *BatchMutateRealmsResponse) GetError() *BatchError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Copies of a single realm moved between deployments by exports and imports
+type RealmExportRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the record format, imports reject records of versions they do not support
+	FormatVersion uint32 `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	// Released copy of the realm, either active or disabled, empty for realms that were never released
+	Released *Realm `protobuf:"bytes,2,opt,name=released,proto3" json:"released,omitempty"`
+	// Draft copy of the realm, empty when the realm has no draft or drafts were not exported
+	Draft *Realm `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft,omitempty"`
+}
+
+func (x *RealmExportRecord) Reset() {
+	*x = RealmExportRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmExportRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmExportRecord) ProtoMessage() {}
+
+func (x *RealmExportRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmExportRecord.ProtoReflect.Descriptor instead.
+func (*RealmExportRecord) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RealmExportRecord) GetFormatVersion() uint32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *RealmExportRecord) GetReleased() *Realm {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+func (x *RealmExportRecord) GetDraft() *Realm {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type ExportRealmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Export the drafts of realms as well, including realms that were never released
+	IncludeDrafts bool `protobuf:"varint,1,opt,name=include_drafts,json=includeDrafts,proto3" json:"include_drafts,omitempty"`
+}
+
+func (x *ExportRealmsRequest) Reset() {
+	*x = ExportRealmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRealmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRealmsRequest) ProtoMessage() {}
+
+func (x *ExportRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRealmsRequest.ProtoReflect.Descriptor instead.
+func (*ExportRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportRealmsRequest) GetIncludeDrafts() bool {
+	if x != nil {
+		return x.IncludeDrafts
+	}
+	return false
+}
+
+type ExportRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *RealmExportRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *ExportRealmsResponse) Reset() {
+	*x = ExportRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRealmsResponse) ProtoMessage() {}
+
+func (x *ExportRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRealmsResponse.ProtoReflect.Descriptor instead.
+func (*ExportRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportRealmsResponse) GetRecord() *RealmExportRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ImportRealmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Realm to be imported
+	Record *RealmExportRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// Handling of realms that already exist in any status, defaults to fail. Only read from the first request of the stream.
+	ConflictPolicy EnumImportConflictPolicy `protobuf:"varint,2,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=realm_mgr.v1.EnumImportConflictPolicy" json:"conflict_policy,omitempty"`
+	// Validate the request and return its outcome without applying any changes. Only read from the first request of the stream.
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *ImportRealmsRequest) Reset() {
+	*x = ImportRealmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRealmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRealmsRequest) ProtoMessage() {}
+
+func (x *ImportRealmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRealmsRequest.ProtoReflect.Descriptor instead.
+func (*ImportRealmsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImportRealmsRequest) GetRecord() *RealmExportRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ImportRealmsRequest) GetConflictPolicy() EnumImportConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_UNSPECIFIED
+}
+
+func (x *ImportRealmsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RealmImportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the imported realm, empty when the record did not hold one
+	RealmId string `protobuf:"bytes,1,opt,name=realm_id,json=realmId,proto3" json:"realm_id,omitempty"`
+	// What the import did with the realm
+	Outcome EnumRealmImportOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=realm_mgr.v1.EnumRealmImportOutcome" json:"outcome,omitempty"`
+	// Error explaining why the realm was rejected
+	Error *BatchError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RealmImportResult) Reset() {
+	*x = RealmImportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmImportResult) ProtoMessage() {}
+
+func (x *RealmImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmImportResult.ProtoReflect.Descriptor instead.
+func (*RealmImportResult) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RealmImportResult) GetRealmId() string {
+	if x != nil {
+		return x.RealmId
+	}
+	return ""
+}
+
+func (x *RealmImportResult) GetOutcome() EnumRealmImportOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_UNSPECIFIED
+}
+
+func (x *RealmImportResult) GetError() *BatchError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ImportRealmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Results in the order the records were sent
+	Results []*RealmImportResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Number of realms created
+	CreatedCount uint32 `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	// Number of realms overwritten
+	UpdatedCount uint32 `protobuf:"varint,3,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	// Number of realms left as they were
+	SkippedCount uint32 `protobuf:"varint,4,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	// Number of records rejected
+	RejectedCount uint32 `protobuf:"varint,5,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	// Set when the import stopped at a realm that already exists, the records following it were not read
+	Aborted bool `protobuf:"varint,6,opt,name=aborted,proto3" json:"aborted,omitempty"`
+}
+
+func (x *ImportRealmsResponse) Reset() {
+	*x = ImportRealmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRealmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRealmsResponse) ProtoMessage() {}
+
+func (x *ImportRealmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRealmsResponse.ProtoReflect.Descriptor instead.
+func (*ImportRealmsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportRealmsResponse) GetResults() []*RealmImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportRealmsResponse) GetCreatedCount() uint32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportRealmsResponse) GetUpdatedCount() uint32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ImportRealmsResponse) GetSkippedCount() uint32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportRealmsResponse) GetRejectedCount() uint32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *ImportRealmsResponse) GetAborted() bool {
+	if x != nil {
+		return x.Aborted
+	}
+	return false
+}
+
+type DisableRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reason for disabling the realm
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Disable the active descendants of the realm as well, realms with active children cannot be disabled otherwise
+	Cascade bool `protobuf:"varint,4,opt,name=cascade,proto3" json:"cascade,omitempty"`
+}
+
+func (x *DisableRealmRequest) Reset() {
+	*x = DisableRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableRealmRequest) ProtoMessage() {}
+
+func (x *DisableRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableRealmRequest.ProtoReflect.Descriptor instead.
+func (*DisableRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DisableRealmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DisableRealmRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DisableRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *DisableRealmRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type DisableRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *DisableRealmResponse) Reset() {
+	*x = DisableRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableRealmResponse) ProtoMessage() {}
+
+func (x *DisableRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableRealmResponse.ProtoReflect.Descriptor instead.
+func (*DisableRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DisableRealmResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type EnableRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reason for enabling the realm
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *EnableRealmRequest) Reset() {
+	*x = EnableRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableRealmRequest) ProtoMessage() {}
+
+func (x *EnableRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableRealmRequest.ProtoReflect.Descriptor instead.
+func (*EnableRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EnableRealmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EnableRealmRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EnableRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type EnableRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *EnableRealmResponse) Reset() {
+	*x = EnableRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableRealmResponse) ProtoMessage() {}
+
+func (x *EnableRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableRealmResponse.ProtoReflect.Descriptor instead.
+func (*EnableRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EnableRealmResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type DeleteRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Purge the realm instead of marking it as deleted
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Reason for deleting the realm
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Delete the descendants of the realm as well, realms with children cannot be deleted otherwise
+	Cascade bool `protobuf:"varint,5,opt,name=cascade,proto3" json:"cascade,omitempty"`
+}
+
+func (x *DeleteRealmRequest) Reset() {
+	*x = DeleteRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRealmRequest) ProtoMessage() {}
+
+func (x *DeleteRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRealmRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteRealmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteRealmRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteRealmRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeleteRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *DeleteRealmRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type DeleteRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRealmResponse) Reset() {
+	*x = DeleteRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRealmResponse) ProtoMessage() {}
+
+func (x *DeleteRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRealmResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{52}
+}
+
+type RestoreRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reason for restoring the realm
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *RestoreRealmRequest) Reset() {
+	*x = RestoreRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRealmRequest) ProtoMessage() {}
+
+func (x *RestoreRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRealmRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RestoreRealmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreRealmRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RestoreRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RestoreRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *RestoreRealmResponse) Reset() {
+	*x = RestoreRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRealmResponse) ProtoMessage() {}
+
+func (x *RestoreRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRealmResponse.ProtoReflect.Descriptor instead.
+func (*RestoreRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RestoreRealmResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type DiscardDraftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Allow discarding the draft of a realm that was never released, which deletes the realm
+	DeleteRealm bool `protobuf:"varint,2,opt,name=delete_realm,json=deleteRealm,proto3" json:"delete_realm,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *DiscardDraftRequest) Reset() {
+	*x = DiscardDraftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDraftRequest) ProtoMessage() {}
+
+func (x *DiscardDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDraftRequest.ProtoReflect.Descriptor instead.
+func (*DiscardDraftRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DiscardDraftRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiscardDraftRequest) GetDeleteRealm() bool {
+	if x != nil {
+		return x.DeleteRealm
+	}
+	return false
+}
+
+func (x *DiscardDraftRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type DiscardDraftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DiscardDraftResponse) Reset() {
+	*x = DiscardDraftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardDraftResponse) ProtoMessage() {}
+
+func (x *DiscardDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardDraftResponse.ProtoReflect.Descriptor instead.
+func (*DiscardDraftResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{56}
+}
+
+// RealmRevision is numbered by the etag the realm was released with. Every change of the draft
+// advances the etag as well, so revision numbers increase with each release but are not sequential.
+type RealmRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Snapshot of the realm as it was released, its etag is the number of the revision
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Identity of the caller who released the realm
+	ReleasedBy string `protobuf:"bytes,2,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
+	// Released at timestamp of the revision
+	ReleasedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+}
+
+func (x *RealmRevision) Reset() {
+	*x = RealmRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmRevision) ProtoMessage() {}
+
+func (x *RealmRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmRevision.ProtoReflect.Descriptor instead.
+func (*RealmRevision) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RealmRevision) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+func (x *RealmRevision) GetReleasedBy() string {
+	if x != nil {
+		return x.ReleasedBy
+	}
+	return ""
+}
+
+func (x *RealmRevision) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+type ListRealmRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of revisions to be returned, defaults to 25
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned by a previous call to continue listing from
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListRealmRevisionsRequest) Reset() {
+	*x = ListRealmRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRealmRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRealmRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRealmRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRealmRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRealmRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListRealmRevisionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListRealmRevisionsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRealmRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRealmRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Revisions of the realm, newest first
+	Revisions []*RealmRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Token to retrieve the next page, empty when there are no more revisions
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListRealmRevisionsResponse) Reset() {
+	*x = ListRealmRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRealmRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRealmRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRealmRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRealmRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRealmRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListRealmRevisionsResponse) GetRevisions() []*RealmRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListRealmRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetRealmRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Selector:
+	//	*GetRealmRevisionRequest_Revision
+	//	*GetRealmRevisionRequest_AsOf
+	Selector isGetRealmRevisionRequest_Selector `protobuf_oneof:"selector"`
+}
+
+func (x *GetRealmRevisionRequest) Reset() {
+	*x = GetRealmRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealmRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealmRevisionRequest) ProtoMessage() {}
+
+func (x *GetRealmRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealmRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRealmRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetRealmRevisionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (m *GetRealmRevisionRequest) GetSelector() isGetRealmRevisionRequest_Selector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+func (x *GetRealmRevisionRequest) GetRevision() int64 {
+	if x, ok := x.GetSelector().(*GetRealmRevisionRequest_Revision); ok {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetRealmRevisionRequest) GetAsOf() *timestamppb.Timestamp {
+	if x, ok := x.GetSelector().(*GetRealmRevisionRequest_AsOf); ok {
+		return x.AsOf
+	}
+	return nil
+}
+
+type isGetRealmRevisionRequest_Selector interface {
+	isGetRealmRevisionRequest_Selector()
+}
+
+type GetRealmRevisionRequest_Revision struct {
+	// Number of the revision to be returned, which is the etag the realm was released with
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3,oneof"`
+}
+
+type GetRealmRevisionRequest_AsOf struct {
+	// Return the revision that was released at the given point in time
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3,oneof"`
+}
+
+func (*GetRealmRevisionRequest_Revision) isGetRealmRevisionRequest_Selector() {}
+
+func (*GetRealmRevisionRequest_AsOf) isGetRealmRevisionRequest_Selector() {}
+
+type GetRealmRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision *RealmRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GetRealmRevisionResponse) Reset() {
+	*x = GetRealmRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealmRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealmRevisionResponse) ProtoMessage() {}
+
+func (x *GetRealmRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealmRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetRealmRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetRealmRevisionResponse) GetRevision() *RealmRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type RollbackRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Number of the released revision to be restored into the draft, which is the etag the realm
+	// was released with
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Reason for rolling back the realm
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *RollbackRealmRequest) Reset() {
+	*x = RollbackRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackRealmRequest) ProtoMessage() {}
+
+func (x *RollbackRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackRealmRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RollbackRealmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RollbackRealmRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RollbackRealmRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RollbackRealmRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RollbackRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Draft of the realm holding the content of the revision
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *RollbackRealmResponse) Reset() {
+	*x = RollbackRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackRealmResponse) ProtoMessage() {}
+
+func (x *RollbackRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackRealmResponse.ProtoReflect.Descriptor instead.
+func (*RollbackRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RollbackRealmResponse) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+type RealmVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Version:
+	//	*RealmVersion_Status
+	//	*RealmVersion_Revision
+	Version isRealmVersion_Version `protobuf_oneof:"version"`
+}
+
+func (x *RealmVersion) Reset() {
+	*x = RealmVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmVersion) ProtoMessage() {}
+
+func (x *RealmVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmVersion.ProtoReflect.Descriptor instead.
+func (*RealmVersion) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{64}
+}
+
+func (m *RealmVersion) GetVersion() isRealmVersion_Version {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (x *RealmVersion) GetStatus() EnumStatus {
+	if x, ok := x.GetVersion().(*RealmVersion_Status); ok {
+		return x.Status
+	}
+	return EnumStatus_ENUM_STATUS_UNSPECIFIED
+}
+
+func (x *RealmVersion) GetRevision() int64 {
+	if x, ok := x.GetVersion().(*RealmVersion_Revision); ok {
+		return x.Revision
+	}
+	return 0
+}
+
+type isRealmVersion_Version interface {
+	isRealmVersion_Version()
+}
+
+type RealmVersion_Status struct {
+	// Current realm in the given status, either draft or active
+	Status EnumStatus `protobuf:"varint,1,opt,name=status,proto3,enum=realm_mgr.v1.EnumStatus,oneof"`
+}
+
+type RealmVersion_Revision struct {
+	// Number of a released revision of the realm, which is the etag the realm was released with
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3,oneof"`
+}
+
+func (*RealmVersion_Status) isRealmVersion_Version() {}
+
+func (*RealmVersion_Revision) isRealmVersion_Version() {}
+
+type RealmFieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the changed field, as used in update masks
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Value of the field in the version compared from
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// Value of the field in the version compared to
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (x *RealmFieldChange) Reset() {
+	*x = RealmFieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealmFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealmFieldChange) ProtoMessage() {}
+
+func (x *RealmFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealmFieldChange.ProtoReflect.Descriptor instead.
+func (*RealmFieldChange) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RealmFieldChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RealmFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *RealmFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type DiffRealmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the realm to compare from, defaults to the active realm
+	From *RealmVersion `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Version of the realm to compare to, defaults to the draft realm
+	To *RealmVersion `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffRealmRequest) Reset() {
+	*x = DiffRealmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffRealmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRealmRequest) ProtoMessage() {}
+
+func (x *DiffRealmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRealmRequest.ProtoReflect.Descriptor instead.
+func (*DiffRealmRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DiffRealmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiffRealmRequest) GetFrom() *RealmVersion {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffRealmRequest) GetTo() *RealmVersion {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DiffRealmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Changed fields ordered by path, empty when both versions are equal
+	Changes []*RealmFieldChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *DiffRealmResponse) Reset() {
+	*x = DiffRealmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffRealmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRealmResponse) ProtoMessage() {}
+
+func (x *DiffRealmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRealmResponse.ProtoReflect.Descriptor instead.
+func (*DiffRealmResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DiffRealmResponse) GetChanges() []*RealmFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ScheduleReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Point in time the draft of the realm is to be released at
+	ReleaseAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *ScheduleReleaseRequest) Reset() {
+	*x = ScheduleReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleReleaseRequest) ProtoMessage() {}
+
+func (x *ScheduleReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleReleaseRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ScheduleReleaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleReleaseRequest) GetReleaseAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return nil
+}
+
+func (x *ScheduleReleaseRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type ScheduleReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReleaseSchedule *ReleaseSchedule `protobuf:"bytes,1,opt,name=release_schedule,json=releaseSchedule,proto3" json:"release_schedule,omitempty"`
+}
+
+func (x *ScheduleReleaseResponse) Reset() {
+	*x = ScheduleReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleReleaseResponse) ProtoMessage() {}
+
+func (x *ScheduleReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleReleaseResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ScheduleReleaseResponse) GetReleaseSchedule() *ReleaseSchedule {
+	if x != nil {
+		return x.ReleaseSchedule
+	}
+	return nil
+}
+
+type CancelScheduledReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *CancelScheduledReleaseRequest) Reset() {
+	*x = CancelScheduledReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledReleaseRequest) ProtoMessage() {}
+
+func (x *CancelScheduledReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledReleaseRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CancelScheduledReleaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelScheduledReleaseRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type CancelScheduledReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelScheduledReleaseResponse) Reset() {
+	*x = CancelScheduledReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledReleaseResponse) ProtoMessage() {}
+
+func (x *CancelScheduledReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledReleaseResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{71}
+}
+
+type ReleaseApprovalDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identity of the reviewer
+	Reviewer string `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	// Decision of the reviewer
+	Decision EnumApprovalDecision `protobuf:"varint,2,opt,name=decision,proto3,enum=realm_mgr.v1.EnumApprovalDecision" json:"decision,omitempty"`
+	// Comment of the reviewer
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Point in time the decision was made
+	DecidedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+}
+
+func (x *ReleaseApprovalDecision) Reset() {
+	*x = ReleaseApprovalDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseApprovalDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseApprovalDecision) ProtoMessage() {}
+
+func (x *ReleaseApprovalDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseApprovalDecision.ProtoReflect.Descriptor instead.
+func (*ReleaseApprovalDecision) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReleaseApprovalDecision) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ReleaseApprovalDecision) GetDecision() EnumApprovalDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return EnumApprovalDecision_ENUM_APPROVAL_DECISION_UNSPECIFIED
+}
+
+func (x *ReleaseApprovalDecision) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ReleaseApprovalDecision) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+type ReleaseApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Snapshot of the draft the approval was requested for
+	Realm *Realm `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	// Identity of the caller who requested the approval
+	RequestedBy string `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// Point in time the approval was requested
+	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// Decisions of the reviewers on the draft snapshot
+	Decisions []*ReleaseApprovalDecision `protobuf:"bytes,4,rep,name=decisions,proto3" json:"decisions,omitempty"`
+}
+
+func (x *ReleaseApproval) Reset() {
+	*x = ReleaseApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseApproval) ProtoMessage() {}
+
+func (x *ReleaseApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseApproval.ProtoReflect.Descriptor instead.
+func (*ReleaseApproval) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReleaseApproval) GetRealm() *Realm {
+	if x != nil {
+		return x.Realm
+	}
+	return nil
+}
+
+func (x *ReleaseApproval) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ReleaseApproval) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *ReleaseApproval) GetDecisions() []*ReleaseApprovalDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+type RequestReleaseApprovalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *RequestReleaseApprovalRequest) Reset() {
+	*x = RequestReleaseApprovalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestReleaseApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReleaseApprovalRequest) ProtoMessage() {}
+
+func (x *RequestReleaseApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReleaseApprovalRequest.ProtoReflect.Descriptor instead.
+func (*RequestReleaseApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RequestReleaseApprovalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RequestReleaseApprovalRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RequestReleaseApprovalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approval *ReleaseApproval `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+}
+
+func (x *RequestReleaseApprovalResponse) Reset() {
+	*x = RequestReleaseApprovalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestReleaseApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReleaseApprovalResponse) ProtoMessage() {}
+
+func (x *RequestReleaseApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReleaseApprovalResponse.ProtoReflect.Descriptor instead.
+func (*RequestReleaseApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RequestReleaseApprovalResponse) GetApproval() *ReleaseApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+type ApproveReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Etag of the draft snapshot being approved, the approval is aborted when it does not match
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Optional comment of the reviewer
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *ApproveReleaseRequest) Reset() {
+	*x = ApproveReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReleaseRequest) ProtoMessage() {}
+
+func (x *ApproveReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReleaseRequest.ProtoReflect.Descriptor instead.
+func (*ApproveReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ApproveReleaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveReleaseRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *ApproveReleaseRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ApproveReleaseRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type ApproveReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approval *ReleaseApproval `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+}
+
+func (x *ApproveReleaseResponse) Reset() {
+	*x = ApproveReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveReleaseResponse) ProtoMessage() {}
+
+func (x *ApproveReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveReleaseResponse.ProtoReflect.Descriptor instead.
+func (*ApproveReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ApproveReleaseResponse) GetApproval() *ReleaseApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+type RejectReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID identifier of the realm
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Etag of the draft snapshot being rejected, the rejection is aborted when it does not match
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Reason of the rejection
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Validate the request and return its outcome without applying any changes
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *RejectReleaseRequest) Reset() {
+	*x = RejectReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectReleaseRequest) ProtoMessage() {}
+
+func (x *RejectReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectReleaseRequest.ProtoReflect.Descriptor instead.
+func (*RejectReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RejectReleaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectReleaseRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *RejectReleaseRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RejectReleaseRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RejectReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approval *ReleaseApproval `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+}
+
+func (x *RejectReleaseResponse) Reset() {
+	*x = RejectReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_realm_mgr_v1_realm_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectReleaseResponse) ProtoMessage() {}
+
+func (x *RejectReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realm_mgr_v1_realm_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectReleaseResponse.ProtoReflect.Descriptor instead.
+func (*RejectReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_realm_mgr_v1_realm_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RejectReleaseResponse) GetApproval() *ReleaseApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+var File_realm_mgr_v1_realm_proto protoreflect.FileDescriptor
+
+var file_realm_mgr_v1_realm_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x05, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x48, 0x0a, 0x10, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x37, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06,
+	0xb0, 0x01, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xac, 0x02, 0x0a,
+	0x0f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3c,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3d, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x99, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x3f, 0x52, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x56, 0x69, 0x65,
+	0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x22, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x66, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x4e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x09, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x73, 0x22, 0x6c, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xfa, 0x42, 0x0c, 0x92,
+	0x01, 0x09, 0x08, 0x01, 0x22, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x48,
+	0x00, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x56, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xba, 0x02, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x6f, 0x72,
+	0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52,
+	0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x33, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x79, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xb6, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18,
+	0x64, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x06, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf5, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x32,
+	0x0a, 0x10, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18,
+	0xc2, 0x1c, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x44, 0x61,
+	0x79, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
+	0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x45, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64,
+	0x61, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x64, 0x12,
+	0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xb0, 0x01,
+	0x01, 0xd0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x6c, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xf9, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42,
+	0x08, 0x72, 0x06, 0xb0, 0x01, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x3f, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x76, 0x0a,
+	0x16, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x6c, 0x75, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x3f, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x44, 0x0a, 0x17, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0xbb, 0x01, 0x0a, 0x11,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x08,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x3f, 0x0a, 0x12, 0x43, 0x6c, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x68, 0x0a, 0x13, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12,
+	0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x41, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0xb5, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22,
+	0x40, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x22, 0xd6, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08,
+	0x72, 0x06, 0xb0, 0x01, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x3f, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x1a,
+	0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74,
+	0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x6e,
+	0x0a, 0x14, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xf4, 0x03, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x22, 0x9a,
+	0x02, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3b, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0f, 0x0a, 0x08, 0x6d, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x86, 0x01, 0x0a, 0x18,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x6d, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04,
+	0x08, 0x01, 0x10, 0x64, 0x52, 0x09, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x3c, 0x0a, 0x13, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x14, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0xce, 0x01, 0x0a, 0x13, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x4f, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x81, 0x02, 0x0a,
+	0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x22, 0x92, 0x01, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xf4, 0x03, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61,
+	0x73, 0x63, 0x61, 0x64, 0x65, 0x22, 0x41, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x77, 0x0a, 0x12, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0xf4, 0x03, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0x40, 0x0a, 0x13, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x22, 0xa5, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x18, 0xf4, 0x03, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x76, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x41, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x77, 0x0a,
+	0x13, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72,
+	0x64, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98,
+	0x01, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3b, 0x0a, 0x0b,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7a, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9e, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x42, 0x0f, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x53, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a,
+	0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x23, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x42, 0x0a, 0x15, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22,
+	0x80, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82,
+	0x01, 0x04, 0x18, 0x01, 0x18, 0x02, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x25, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c,
+	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x6f, 0x22,
+	0x4d, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x9c,
+	0x01, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x63, 0x0a,
+	0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x22, 0x5e, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x08,
+	0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xe3, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5e, 0x0a, 0x1d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x5b, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x22, 0x8e, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x53, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61,
+	0x67, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xf4, 0x03, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x52, 0x0a, 0x15,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x2a, 0x6a, 0x0a, 0x0d, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x56, 0x69, 0x65,
+	0x77, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d,
+	0x5f, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x1d, 0x0a,
+	0x19, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x56, 0x49, 0x45, 0x57,
+	0x5f, 0x45, 0x46, 0x46, 0x45, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x2a, 0xa7, 0x01, 0x0a,
+	0x12, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c,
+	0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x4e,
+	0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x4e,
+	0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x02,
+	0x12, 0x24, 0x0a, 0x20, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x5f, 0x41, 0x54, 0x10, 0x03, 0x2a, 0xec, 0x01, 0x0a, 0x12, 0x45, 0x6e, 0x75, 0x6d, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x21, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41,
+	0x4c, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
+	0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4e,
+	0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x22,
+	0x0a, 0x1e, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xbe, 0x01, 0x0a, 0x18, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x2b, 0x0a, 0x27, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x24, 0x0a, 0x20, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x45,
+	0x4e, 0x55, 0x4d, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c,
+	0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x2a, 0xe0, 0x01, 0x0a, 0x16, 0x45, 0x6e, 0x75, 0x6d, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21,
+	0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c,
+	0x4d, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x4e,
+	0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x5f,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x42, 0x1b, 0x5a, 0x19, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_realm_mgr_v1_realm_proto_rawDescOnce sync.Once
+	file_realm_mgr_v1_realm_proto_rawDescData = file_realm_mgr_v1_realm_proto_rawDesc
+)
+
+func file_realm_mgr_v1_realm_proto_rawDescGZIP() []byte {
+	file_realm_mgr_v1_realm_proto_rawDescOnce.Do(func() {
+		file_realm_mgr_v1_realm_proto_rawDescData = protoimpl.X.CompressGZIP(file_realm_mgr_v1_realm_proto_rawDescData)
+	})
+	return file_realm_mgr_v1_realm_proto_rawDescData
+}
+
+var file_realm_mgr_v1_realm_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_realm_mgr_v1_realm_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
+var file_realm_mgr_v1_realm_proto_goTypes = []interface{}{
+	(EnumRealmView)(0),                     // 0: realm_mgr.v1.EnumRealmView
+	(EnumRealmSortField)(0),                // 1: realm_mgr.v1.EnumRealmSortField
+	(EnumRealmEventType)(0),                // 2: realm_mgr.v1.EnumRealmEventType
+	(EnumImportConflictPolicy)(0),          // 3: realm_mgr.v1.EnumImportConflictPolicy
+	(EnumRealmImportOutcome)(0),            // 4: realm_mgr.v1.EnumRealmImportOutcome
+	(*Realm)(nil),                          // 5: realm_mgr.v1.Realm
+	(*ReleaseSchedule)(nil),                // 6: realm_mgr.v1.ReleaseSchedule
+	(*GetRealmRequest)(nil),                // 7: realm_mgr.v1.GetRealmRequest
+	(*GetRealmResponse)(nil),               // 8: realm_mgr.v1.GetRealmResponse
+	(*GetRealmBySlugRequest)(nil),          // 9: realm_mgr.v1.GetRealmBySlugRequest
+	(*GetRealmBySlugResponse)(nil),         // 10: realm_mgr.v1.GetRealmBySlugResponse
+	(*GetRealmAncestorsRequest)(nil),       // 11: realm_mgr.v1.GetRealmAncestorsRequest
+	(*GetRealmAncestorsResponse)(nil),      // 12: realm_mgr.v1.GetRealmAncestorsResponse
+	(*BatchGetRealmsRequest)(nil),          // 13: realm_mgr.v1.BatchGetRealmsRequest
+	(*BatchGetRealmsResult)(nil),           // 14: realm_mgr.v1.BatchGetRealmsResult
+	(*BatchGetRealmsResponse)(nil),         // 15: realm_mgr.v1.BatchGetRealmsResponse
+	(*ListRealmsRequest)(nil),              // 16: realm_mgr.v1.ListRealmsRequest
+	(*ListRealmsResponse)(nil),             // 17: realm_mgr.v1.ListRealmsResponse
+	(*SearchRealmsRequest)(nil),            // 18: realm_mgr.v1.SearchRealmsRequest
+	(*RealmSearchResult)(nil),              // 19: realm_mgr.v1.RealmSearchResult
+	(*SearchRealmsResponse)(nil),           // 20: realm_mgr.v1.SearchRealmsResponse
+	(*ListChildRealmsRequest)(nil),         // 21: realm_mgr.v1.ListChildRealmsRequest
+	(*ListChildRealmsResponse)(nil),        // 22: realm_mgr.v1.ListChildRealmsResponse
+	(*GetRealmStatsRequest)(nil),           // 23: realm_mgr.v1.GetRealmStatsRequest
+	(*RealmDailyCount)(nil),                // 24: realm_mgr.v1.RealmDailyCount
+	(*GetRealmStatsResponse)(nil),          // 25: realm_mgr.v1.GetRealmStatsResponse
+	(*RealmEvent)(nil),                     // 26: realm_mgr.v1.RealmEvent
+	(*WatchRealmsRequest)(nil),             // 27: realm_mgr.v1.WatchRealmsRequest
+	(*WatchRealmsResponse)(nil),            // 28: realm_mgr.v1.WatchRealmsResponse
+	(*CreateRealmRequest)(nil),             // 29: realm_mgr.v1.CreateRealmRequest
+	(*CreateRealmResponse)(nil),            // 30: realm_mgr.v1.CreateRealmResponse
+	(*RenameRealmSlugRequest)(nil),         // 31: realm_mgr.v1.RenameRealmSlugRequest
+	(*RenameRealmSlugResponse)(nil),        // 32: realm_mgr.v1.RenameRealmSlugResponse
+	(*CloneRealmRequest)(nil),              // 33: realm_mgr.v1.CloneRealmRequest
+	(*CloneRealmResponse)(nil),             // 34: realm_mgr.v1.CloneRealmResponse
+	(*ReleaseRealmRequest)(nil),            // 35: realm_mgr.v1.ReleaseRealmRequest
+	(*ReleaseRealmResponse)(nil),           // 36: realm_mgr.v1.ReleaseRealmResponse
+	(*UpdateRealmRequest)(nil),             // 37: realm_mgr.v1.UpdateRealmRequest
+	(*UpdateRealmResponse)(nil),            // 38: realm_mgr.v1.UpdateRealmResponse
+	(*CreateRealmMutation)(nil),            // 39: realm_mgr.v1.CreateRealmMutation
+	(*UpdateRealmMutation)(nil),            // 40: realm_mgr.v1.UpdateRealmMutation
+	(*ReleaseRealmMutation)(nil),           // 41: realm_mgr.v1.ReleaseRealmMutation
+	(*DisableRealmMutation)(nil),           // 42: realm_mgr.v1.DisableRealmMutation
+	(*RealmMutation)(nil),                  // 43: realm_mgr.v1.RealmMutation
+	(*BatchMutateRealmsRequest)(nil),       // 44: realm_mgr.v1.BatchMutateRealmsRequest
+	(*BatchMutateRealmsResponse)(nil),      // 45: realm_mgr.v1.BatchMutateRealmsResponse
+	(*RealmExportRecord)(nil),              // 46: realm_mgr.v1.RealmExportRecord
+	(*ExportRealmsRequest)(nil),            // 47: realm_mgr.v1.ExportRealmsRequest
+	(*ExportRealmsResponse)(nil),           // 48: realm_mgr.v1.ExportRealmsResponse
+	(*ImportRealmsRequest)(nil),            // 49: realm_mgr.v1.ImportRealmsRequest
+	(*RealmImportResult)(nil),              // 50: realm_mgr.v1.RealmImportResult
+	(*ImportRealmsResponse)(nil),           // 51: realm_mgr.v1.ImportRealmsResponse
+	(*DisableRealmRequest)(nil),            // 52: realm_mgr.v1.DisableRealmRequest
+	(*DisableRealmResponse)(nil),           // 53: realm_mgr.v1.DisableRealmResponse
+	(*EnableRealmRequest)(nil),             // 54: realm_mgr.v1.EnableRealmRequest
+	(*EnableRealmResponse)(nil),            // 55: realm_mgr.v1.EnableRealmResponse
+	(*DeleteRealmRequest)(nil),             // 56: realm_mgr.v1.DeleteRealmRequest
+	(*DeleteRealmResponse)(nil),            // 57: realm_mgr.v1.DeleteRealmResponse
+	(*RestoreRealmRequest)(nil),            // 58: realm_mgr.v1.RestoreRealmRequest
+	(*RestoreRealmResponse)(nil),           // 59: realm_mgr.v1.RestoreRealmResponse
+	(*DiscardDraftRequest)(nil),            // 60: realm_mgr.v1.DiscardDraftRequest
+	(*DiscardDraftResponse)(nil),           // 61: realm_mgr.v1.DiscardDraftResponse
+	(*RealmRevision)(nil),                  // 62: realm_mgr.v1.RealmRevision
+	(*ListRealmRevisionsRequest)(nil),      // 63: realm_mgr.v1.ListRealmRevisionsRequest
+	(*ListRealmRevisionsResponse)(nil),     // 64: realm_mgr.v1.ListRealmRevisionsResponse
+	(*GetRealmRevisionRequest)(nil),        // 65: realm_mgr.v1.GetRealmRevisionRequest
+	(*GetRealmRevisionResponse)(nil),       // 66: realm_mgr.v1.GetRealmRevisionResponse
+	(*RollbackRealmRequest)(nil),           // 67: realm_mgr.v1.RollbackRealmRequest
+	(*RollbackRealmResponse)(nil),          // 68: realm_mgr.v1.RollbackRealmResponse
+	(*RealmVersion)(nil),                   // 69: realm_mgr.v1.RealmVersion
+	(*RealmFieldChange)(nil),               // 70: realm_mgr.v1.RealmFieldChange
+	(*DiffRealmRequest)(nil),               // 71: realm_mgr.v1.DiffRealmRequest
+	(*DiffRealmResponse)(nil),              // 72: realm_mgr.v1.DiffRealmResponse
+	(*ScheduleReleaseRequest)(nil),         // 73: realm_mgr.v1.ScheduleReleaseRequest
+	(*ScheduleReleaseResponse)(nil),        // 74: realm_mgr.v1.ScheduleReleaseResponse
+	(*CancelScheduledReleaseRequest)(nil),  // 75: realm_mgr.v1.CancelScheduledReleaseRequest
+	(*CancelScheduledReleaseResponse)(nil), // 76: realm_mgr.v1.CancelScheduledReleaseResponse
+	(*ReleaseApprovalDecision)(nil),        // 77: realm_mgr.v1.ReleaseApprovalDecision
+	(*ReleaseApproval)(nil),                // 78: realm_mgr.v1.ReleaseApproval
+	(*RequestReleaseApprovalRequest)(nil),  // 79: realm_mgr.v1.RequestReleaseApprovalRequest
+	(*RequestReleaseApprovalResponse)(nil), // 80: realm_mgr.v1.RequestReleaseApprovalResponse
+	(*ApproveReleaseRequest)(nil),          // 81: realm_mgr.v1.ApproveReleaseRequest
+	(*ApproveReleaseResponse)(nil),         // 82: realm_mgr.v1.ApproveReleaseResponse
+	(*RejectReleaseRequest)(nil),           // 83: realm_mgr.v1.RejectReleaseRequest
+	(*RejectReleaseResponse)(nil),          // 84: realm_mgr.v1.RejectReleaseResponse
+	nil,                                    // 85: realm_mgr.v1.Realm.LabelsEntry
+	nil,                                    // 86: realm_mgr.v1.CreateRealmRequest.LabelsEntry
+	nil,                                    // 87: realm_mgr.v1.CreateRealmMutation.LabelsEntry
+	(EnumStatus)(0),                        // 88: realm_mgr.v1.EnumStatus
+	(*timestamppb.Timestamp)(nil),          // 89: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                // 90: google.protobuf.Struct
+	(EnumReleaseScheduleState)(0),          // 91: realm_mgr.v1.EnumReleaseScheduleState
+	(*BatchError)(nil),                     // 92: realm_mgr.v1.BatchError
+	(EnumSortDirection)(0),                 // 93: realm_mgr.v1.EnumSortDirection
+	(*fieldmaskpb.FieldMask)(nil),          // 94: google.protobuf.FieldMask
+	(EnumApprovalDecision)(0),              // 95: realm_mgr.v1.EnumApprovalDecision
+}
+var file_realm_mgr_v1_realm_proto_depIdxs = []int32{
+	88, // 0: realm_mgr.v1.Realm.status:type_name -> realm_mgr.v1.EnumStatus
+	89, // 1: realm_mgr.v1.Realm.created_at:type_name -> google.protobuf.Timestamp
+	89, // 2: realm_mgr.v1.Realm.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: realm_mgr.v1.Realm.release_schedule:type_name -> realm_mgr.v1.ReleaseSchedule
+	85, // 4: realm_mgr.v1.Realm.labels:type_name -> realm_mgr.v1.Realm.LabelsEntry
+	90, // 5: realm_mgr.v1.Realm.attributes:type_name -> google.protobuf.Struct
+	89, // 6: realm_mgr.v1.ReleaseSchedule.release_at:type_name -> google.protobuf.Timestamp
+	91, // 7: realm_mgr.v1.ReleaseSchedule.state:type_name -> realm_mgr.v1.EnumReleaseScheduleState
+	89, // 8: realm_mgr.v1.ReleaseSchedule.next_attempt_at:type_name -> google.protobuf.Timestamp
+	88, // 9: realm_mgr.v1.GetRealmRequest.status:type_name -> realm_mgr.v1.EnumStatus
+	0,  // 10: realm_mgr.v1.GetRealmRequest.view:type_name -> realm_mgr.v1.EnumRealmView
+	5,  // 11: realm_mgr.v1.GetRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	88, // 12: realm_mgr.v1.GetRealmBySlugRequest.status:type_name -> realm_mgr.v1.EnumStatus
+	0,  // 13: realm_mgr.v1.GetRealmBySlugRequest.view:type_name -> realm_mgr.v1.EnumRealmView
+	5,  // 14: realm_mgr.v1.GetRealmBySlugResponse.realm:type_name -> realm_mgr.v1.Realm
+	88, // 15: realm_mgr.v1.GetRealmAncestorsRequest.status:type_name -> realm_mgr.v1.EnumStatus
+	5,  // 16: realm_mgr.v1.GetRealmAncestorsResponse.ancestors:type_name -> realm_mgr.v1.Realm
+	88, // 17: realm_mgr.v1.BatchGetRealmsRequest.status:type_name -> realm_mgr.v1.EnumStatus
+	5,  // 18: realm_mgr.v1.BatchGetRealmsResult.realm:type_name -> realm_mgr.v1.Realm
+	92, // 19: realm_mgr.v1.BatchGetRealmsResult.error:type_name -> realm_mgr.v1.BatchError
+	14, // 20: realm_mgr.v1.BatchGetRealmsResponse.results:type_name -> realm_mgr.v1.BatchGetRealmsResult
+	88, // 21: realm_mgr.v1.ListRealmsRequest.status:type_name -> realm_mgr.v1.EnumStatus
+	1,  // 22: realm_mgr.v1.ListRealmsRequest.sort_field:type_name -> realm_mgr.v1.EnumRealmSortField
+	93, // 23: realm_mgr.v1.ListRealmsRequest.sort_direction:type_name -> realm_mgr.v1.EnumSortDirection
+	5,  // 24: realm_mgr.v1.ListRealmsResponse.realms:type_name -> realm_mgr.v1.Realm
+	88, // 25: realm_mgr.v1.SearchRealmsRequest.status:type_name -> realm_mgr.v1.EnumStatus
+	5,  // 26: realm_mgr.v1.RealmSearchResult.realm:type_name -> realm_mgr.v1.Realm
+	19, // 27: realm_mgr.v1.SearchRealmsResponse.results:type_name -> realm_mgr.v1.RealmSearchResult
+	88, // 28: realm_mgr.v1.ListChildRealmsRequest.status:type_name -> realm_mgr.v1.EnumStatus
+	5,  // 29: realm_mgr.v1.ListChildRealmsResponse.realms:type_name -> realm_mgr.v1.Realm
+	89, // 30: realm_mgr.v1.GetRealmStatsRequest.created_after:type_name -> google.protobuf.Timestamp
+	89, // 31: realm_mgr.v1.GetRealmStatsRequest.created_before:type_name -> google.protobuf.Timestamp
+	89, // 32: realm_mgr.v1.RealmDailyCount.day:type_name -> google.protobuf.Timestamp
+	24, // 33: realm_mgr.v1.GetRealmStatsResponse.created_per_day:type_name -> realm_mgr.v1.RealmDailyCount
+	89, // 34: realm_mgr.v1.GetRealmStatsResponse.generated_at:type_name -> google.protobuf.Timestamp
+	2,  // 35: realm_mgr.v1.RealmEvent.type:type_name -> realm_mgr.v1.EnumRealmEventType
+	88, // 36: realm_mgr.v1.RealmEvent.status:type_name -> realm_mgr.v1.EnumStatus
+	89, // 37: realm_mgr.v1.RealmEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	88, // 38: realm_mgr.v1.WatchRealmsRequest.status:type_name -> realm_mgr.v1.EnumStatus
+	26, // 39: realm_mgr.v1.WatchRealmsResponse.event:type_name -> realm_mgr.v1.RealmEvent
+	86, // 40: realm_mgr.v1.CreateRealmRequest.labels:type_name -> realm_mgr.v1.CreateRealmRequest.LabelsEntry
+	90, // 41: realm_mgr.v1.CreateRealmRequest.attributes:type_name -> google.protobuf.Struct
+	5,  // 42: realm_mgr.v1.CreateRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	5,  // 43: realm_mgr.v1.RenameRealmSlugResponse.realm:type_name -> realm_mgr.v1.Realm
+	88, // 44: realm_mgr.v1.CloneRealmRequest.source_status:type_name -> realm_mgr.v1.EnumStatus
+	5,  // 45: realm_mgr.v1.CloneRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	5,  // 46: realm_mgr.v1.ReleaseRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	5,  // 47: realm_mgr.v1.UpdateRealmRequest.realm:type_name -> realm_mgr.v1.Realm
+	94, // 48: realm_mgr.v1.UpdateRealmRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 49: realm_mgr.v1.UpdateRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	87, // 50: realm_mgr.v1.CreateRealmMutation.labels:type_name -> realm_mgr.v1.CreateRealmMutation.LabelsEntry
+	90, // 51: realm_mgr.v1.CreateRealmMutation.attributes:type_name -> google.protobuf.Struct
+	5,  // 52: realm_mgr.v1.UpdateRealmMutation.realm:type_name -> realm_mgr.v1.Realm
+	94, // 53: realm_mgr.v1.UpdateRealmMutation.update_mask:type_name -> google.protobuf.FieldMask
+	39, // 54: realm_mgr.v1.RealmMutation.create:type_name -> realm_mgr.v1.CreateRealmMutation
+	40, // 55: realm_mgr.v1.RealmMutation.update:type_name -> realm_mgr.v1.UpdateRealmMutation
+	41, // 56: realm_mgr.v1.RealmMutation.release:type_name -> realm_mgr.v1.ReleaseRealmMutation
+	42, // 57: realm_mgr.v1.RealmMutation.disable:type_name -> realm_mgr.v1.DisableRealmMutation
+	43, // 58: realm_mgr.v1.BatchMutateRealmsRequest.mutations:type_name -> realm_mgr.v1.RealmMutation
+	5,  // 59: realm_mgr.v1.BatchMutateRealmsResponse.realms:type_name -> realm_mgr.v1.Realm
+	92, // 60: realm_mgr.v1.BatchMutateRealmsResponse.error:type_name -> realm_mgr.v1.BatchError
+	5,  // 61: realm_mgr.v1.RealmExportRecord.released:type_name -> realm_mgr.v1.Realm
+	5,  // 62: realm_mgr.v1.RealmExportRecord.draft:type_name -> realm_mgr.v1.Realm
+	46, // 63: realm_mgr.v1.ExportRealmsResponse.record:type_name -> realm_mgr.v1.RealmExportRecord
+	46, // 64: realm_mgr.v1.ImportRealmsRequest.record:type_name -> realm_mgr.v1.RealmExportRecord
+	3,  // 65: realm_mgr.v1.ImportRealmsRequest.conflict_policy:type_name -> realm_mgr.v1.EnumImportConflictPolicy
+	4,  // 66: realm_mgr.v1.RealmImportResult.outcome:type_name -> realm_mgr.v1.EnumRealmImportOutcome
+	92, // 67: realm_mgr.v1.RealmImportResult.error:type_name -> realm_mgr.v1.BatchError
+	50, // 68: realm_mgr.v1.ImportRealmsResponse.results:type_name -> realm_mgr.v1.RealmImportResult
+	5,  // 69: realm_mgr.v1.DisableRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	5,  // 70: realm_mgr.v1.EnableRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	5,  // 71: realm_mgr.v1.RestoreRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	5,  // 72: realm_mgr.v1.RealmRevision.realm:type_name -> realm_mgr.v1.Realm
+	89, // 73: realm_mgr.v1.RealmRevision.released_at:type_name -> google.protobuf.Timestamp
+	62, // 74: realm_mgr.v1.ListRealmRevisionsResponse.revisions:type_name -> realm_mgr.v1.RealmRevision
+	89, // 75: realm_mgr.v1.GetRealmRevisionRequest.as_of:type_name -> google.protobuf.Timestamp
+	62, // 76: realm_mgr.v1.GetRealmRevisionResponse.revision:type_name -> realm_mgr.v1.RealmRevision
+	5,  // 77: realm_mgr.v1.RollbackRealmResponse.realm:type_name -> realm_mgr.v1.Realm
+	88, // 78: realm_mgr.v1.RealmVersion.status:type_name -> realm_mgr.v1.EnumStatus
+	69, // 79: realm_mgr.v1.DiffRealmRequest.from:type_name -> realm_mgr.v1.RealmVersion
+	69, // 80: realm_mgr.v1.DiffRealmRequest.to:type_name -> realm_mgr.v1.RealmVersion
+	70, // 81: realm_mgr.v1.DiffRealmResponse.changes:type_name -> realm_mgr.v1.RealmFieldChange
+	89, // 82: realm_mgr.v1.ScheduleReleaseRequest.release_at:type_name -> google.protobuf.Timestamp
+	6,  // 83: realm_mgr.v1.ScheduleReleaseResponse.release_schedule:type_name -> realm_mgr.v1.ReleaseSchedule
+	95, // 84: realm_mgr.v1.ReleaseApprovalDecision.decision:type_name -> realm_mgr.v1.EnumApprovalDecision
+	89, // 85: realm_mgr.v1.ReleaseApprovalDecision.decided_at:type_name -> google.protobuf.Timestamp
+	5,  // 86: realm_mgr.v1.ReleaseApproval.realm:type_name -> realm_mgr.v1.Realm
+	89, // 87: realm_mgr.v1.ReleaseApproval.requested_at:type_name -> google.protobuf.Timestamp
+	77, // 88: realm_mgr.v1.ReleaseApproval.decisions:type_name -> realm_mgr.v1.ReleaseApprovalDecision
+	78, // 89: realm_mgr.v1.RequestReleaseApprovalResponse.approval:type_name -> realm_mgr.v1.ReleaseApproval
+	78, // 90: realm_mgr.v1.ApproveReleaseResponse.approval:type_name -> realm_mgr.v1.ReleaseApproval
+	78, // 91: realm_mgr.v1.RejectReleaseResponse.approval:type_name -> realm_mgr.v1.ReleaseApproval
+	92, // [92:92] is the sub-list for method output_type
+	92, // [92:92] is the sub-list for method input_type
+	92, // [92:92] is the sub-list for extension type_name
+	92, // [92:92] is the sub-list for extension extendee
+	0,  // [0:92] is the sub-list for field type_name
+}
+
+func init() { file_realm_mgr_v1_realm_proto_init() }
+func file_realm_mgr_v1_realm_proto_init() {
+	if File_realm_mgr_v1_realm_proto != nil {
+		return
+	}
+	file_realm_mgr_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_realm_mgr_v1_realm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Realm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmBySlugRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmBySlugResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmAncestorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmAncestorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetRealmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetRealmsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetRealmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRealmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRealmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRealmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRealmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChildRealmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChildRealmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmDailyCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRealmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRealmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameRealmSlugRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameRealmSlugResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRealmMutation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRealmMutation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseRealmMutation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableRealmMutation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmMutation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMutateRealmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMutateRealmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmExportRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRealmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRealmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRealmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmImportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRealmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_realm_mgr_v1_realm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReleaseRealmRequest); i {
+		file_realm_mgr_v1_realm_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRealmResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -700,8 +7199,8 @@ func file_realm_mgr_v1_realm_proto_init() {
 				return nil
 			}
 		}
-		file_realm_mgr_v1_realm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReleaseRealmResponse); i {
+		file_realm_mgr_v1_realm_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreRealmRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -712,8 +7211,8 @@ func file_realm_mgr_v1_realm_proto_init() {
 				return nil
 			}
 		}
-		file_realm_mgr_v1_realm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRealmRequest); i {
+		file_realm_mgr_v1_realm_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreRealmResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -724,8 +7223,224 @@ func file_realm_mgr_v1_realm_proto_init() {
 				return nil
 			}
 		}
-		file_realm_mgr_v1_realm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRealmResponse); i {
+		file_realm_mgr_v1_realm_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRealmRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRealmRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRealmRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RealmFieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffRealmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffRealmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseApprovalDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseApproval); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -736,19 +7451,110 @@ func file_realm_mgr_v1_realm_proto_init() {
 				return nil
 			}
 		}
+		file_realm_mgr_v1_realm_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestReleaseApprovalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestReleaseApprovalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_realm_mgr_v1_realm_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_realm_mgr_v1_realm_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*BatchGetRealmsResult_Realm)(nil),
+		(*BatchGetRealmsResult_Error)(nil),
+	}
+	file_realm_mgr_v1_realm_proto_msgTypes[38].OneofWrappers = []interface{}{
+		(*RealmMutation_Create)(nil),
+		(*RealmMutation_Update)(nil),
+		(*RealmMutation_Release)(nil),
+		(*RealmMutation_Disable)(nil),
+	}
+	file_realm_mgr_v1_realm_proto_msgTypes[60].OneofWrappers = []interface{}{
+		(*GetRealmRevisionRequest_Revision)(nil),
+		(*GetRealmRevisionRequest_AsOf)(nil),
+	}
+	file_realm_mgr_v1_realm_proto_msgTypes[64].OneofWrappers = []interface{}{
+		(*RealmVersion_Status)(nil),
+		(*RealmVersion_Revision)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_realm_mgr_v1_realm_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      5,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_realm_mgr_v1_realm_proto_goTypes,
 		DependencyIndexes: file_realm_mgr_v1_realm_proto_depIdxs,
+		EnumInfos:         file_realm_mgr_v1_realm_proto_enumTypes,
 		MessageInfos:      file_realm_mgr_v1_realm_proto_msgTypes,
 	}.Build()
 	File_realm_mgr_v1_realm_proto = out.File
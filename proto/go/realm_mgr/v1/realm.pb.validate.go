@@ -71,16 +71,7 @@ func (m *Realm) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := RealmValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
 	// no validation rules for Description
 
@@ -144,6 +135,92 @@ func (m *Realm) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetReleaseSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmValidationError{
+					field:  "ReleaseSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmValidationError{
+					field:  "ReleaseSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmValidationError{
+				field:  "ReleaseSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetParentId() != "" {
+
+		if err := m._validateUuid(m.GetParentId()); err != nil {
+			err = RealmValidationError{
+				field:  "ParentId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ClonedFrom
+
+	// no validation rules for TemplateId
+
+	// no validation rules for TemplateVersion
+
+	// no validation rules for Slug
+
 	if len(errors) > 0 {
 		return RealmMultiError(errors)
 	}
@@ -229,6 +306,172 @@ var _ interface {
 	ErrorName() string
 } = RealmValidationError{}
 
+// Validate checks the field values on ReleaseSchedule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseSchedule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseSchedule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseScheduleMultiError, or nil if none found.
+func (m *ReleaseSchedule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseSchedule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReleaseAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReleaseScheduleValidationError{
+					field:  "ReleaseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReleaseScheduleValidationError{
+					field:  "ReleaseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReleaseScheduleValidationError{
+				field:  "ReleaseAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ScheduledBy
+
+	// no validation rules for State
+
+	// no validation rules for Attempts
+
+	if all {
+		switch v := interface{}(m.GetNextAttemptAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReleaseScheduleValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReleaseScheduleValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextAttemptAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReleaseScheduleValidationError{
+				field:  "NextAttemptAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastError
+
+	if len(errors) > 0 {
+		return ReleaseScheduleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseScheduleMultiError is an error wrapping multiple validation errors
+// returned by ReleaseSchedule.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseScheduleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseScheduleMultiError) AllErrors() []error { return m }
+
+// ReleaseScheduleValidationError is the validation error returned by
+// ReleaseSchedule.Validate if the designated constraints aren't met.
+type ReleaseScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseScheduleValidationError) ErrorName() string { return "ReleaseScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReleaseScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseScheduleValidationError{}
+
 // Validate checks the field values on GetRealmRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -265,6 +508,8 @@ func (m *GetRealmRequest) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for View
+
 	if len(errors) > 0 {
 		return GetRealmRequestMultiError(errors)
 	}
@@ -480,32 +725,32 @@ var _ interface {
 	ErrorName() string
 } = GetRealmResponseValidationError{}
 
-// Validate checks the field values on CreateRealmRequest with the rules
+// Validate checks the field values on GetRealmBySlugRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRealmRequest) Validate() error {
+func (m *GetRealmBySlugRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRealmRequest with the rules
+// ValidateAll checks the field values on GetRealmBySlugRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateRealmRequestMultiError, or nil if none found.
-func (m *CreateRealmRequest) ValidateAll() error {
+// GetRealmBySlugRequestMultiError, or nil if none found.
+func (m *GetRealmBySlugRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRealmRequest) validate(all bool) error {
+func (m *GetRealmBySlugRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CreateRealmRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
+	if l := utf8.RuneCountInString(m.GetSlug()); l < 1 || l > 63 {
+		err := GetRealmBySlugRequestValidationError{
+			field:  "Slug",
+			reason: "value length must be between 1 and 63 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -513,22 +758,24 @@ func (m *CreateRealmRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
+	// no validation rules for Status
+
+	// no validation rules for View
 
 	if len(errors) > 0 {
-		return CreateRealmRequestMultiError(errors)
+		return GetRealmBySlugRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRealmRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateRealmRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateRealmRequestMultiError []error
+// GetRealmBySlugRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRealmBySlugRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRealmBySlugRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRealmRequestMultiError) Error() string {
+func (m GetRealmBySlugRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -537,11 +784,11 @@ func (m CreateRealmRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRealmRequestMultiError) AllErrors() []error { return m }
+func (m GetRealmBySlugRequestMultiError) AllErrors() []error { return m }
 
-// CreateRealmRequestValidationError is the validation error returned by
-// CreateRealmRequest.Validate if the designated constraints aren't met.
-type CreateRealmRequestValidationError struct {
+// GetRealmBySlugRequestValidationError is the validation error returned by
+// GetRealmBySlugRequest.Validate if the designated constraints aren't met.
+type GetRealmBySlugRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -549,24 +796,24 @@ type CreateRealmRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRealmRequestValidationError) Field() string { return e.field }
+func (e GetRealmBySlugRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRealmRequestValidationError) Reason() string { return e.reason }
+func (e GetRealmBySlugRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRealmRequestValidationError) Cause() error { return e.cause }
+func (e GetRealmBySlugRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRealmRequestValidationError) Key() bool { return e.key }
+func (e GetRealmBySlugRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRealmRequestValidationError) ErrorName() string {
-	return "CreateRealmRequestValidationError"
+func (e GetRealmBySlugRequestValidationError) ErrorName() string {
+	return "GetRealmBySlugRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateRealmRequestValidationError) Error() string {
+func (e GetRealmBySlugRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -578,14 +825,14 @@ func (e CreateRealmRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRealmRequest.%s: %s%s",
+		"invalid %sGetRealmBySlugRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRealmRequestValidationError{}
+var _ error = GetRealmBySlugRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -593,24 +840,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRealmRequestValidationError{}
+} = GetRealmBySlugRequestValidationError{}
 
-// Validate checks the field values on CreateRealmResponse with the rules
+// Validate checks the field values on GetRealmBySlugResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRealmResponse) Validate() error {
+func (m *GetRealmBySlugResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRealmResponse with the rules
+// ValidateAll checks the field values on GetRealmBySlugResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateRealmResponseMultiError, or nil if none found.
-func (m *CreateRealmResponse) ValidateAll() error {
+// GetRealmBySlugResponseMultiError, or nil if none found.
+func (m *GetRealmBySlugResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRealmResponse) validate(all bool) error {
+func (m *GetRealmBySlugResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -621,7 +868,7 @@ func (m *CreateRealmResponse) validate(all bool) error {
 		switch v := interface{}(m.GetRealm()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRealmResponseValidationError{
+				errors = append(errors, GetRealmBySlugResponseValidationError{
 					field:  "Realm",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -629,7 +876,7 @@ func (m *CreateRealmResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRealmResponseValidationError{
+				errors = append(errors, GetRealmBySlugResponseValidationError{
 					field:  "Realm",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -638,7 +885,7 @@ func (m *CreateRealmResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateRealmResponseValidationError{
+			return GetRealmBySlugResponseValidationError{
 				field:  "Realm",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -646,20 +893,22 @@ func (m *CreateRealmResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Redirected
+
 	if len(errors) > 0 {
-		return CreateRealmResponseMultiError(errors)
+		return GetRealmBySlugResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRealmResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateRealmResponse.ValidateAll() if the designated
+// GetRealmBySlugResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRealmBySlugResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateRealmResponseMultiError []error
+type GetRealmBySlugResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRealmResponseMultiError) Error() string {
+func (m GetRealmBySlugResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -668,11 +917,11 @@ func (m CreateRealmResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRealmResponseMultiError) AllErrors() []error { return m }
+func (m GetRealmBySlugResponseMultiError) AllErrors() []error { return m }
 
-// CreateRealmResponseValidationError is the validation error returned by
-// CreateRealmResponse.Validate if the designated constraints aren't met.
-type CreateRealmResponseValidationError struct {
+// GetRealmBySlugResponseValidationError is the validation error returned by
+// GetRealmBySlugResponse.Validate if the designated constraints aren't met.
+type GetRealmBySlugResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -680,24 +929,24 @@ type CreateRealmResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRealmResponseValidationError) Field() string { return e.field }
+func (e GetRealmBySlugResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRealmResponseValidationError) Reason() string { return e.reason }
+func (e GetRealmBySlugResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRealmResponseValidationError) Cause() error { return e.cause }
+func (e GetRealmBySlugResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRealmResponseValidationError) Key() bool { return e.key }
+func (e GetRealmBySlugResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRealmResponseValidationError) ErrorName() string {
-	return "CreateRealmResponseValidationError"
+func (e GetRealmBySlugResponseValidationError) ErrorName() string {
+	return "GetRealmBySlugResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateRealmResponseValidationError) Error() string {
+func (e GetRealmBySlugResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -709,14 +958,2870 @@ func (e CreateRealmResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRealmResponse.%s: %s%s",
+		"invalid %sGetRealmBySlugResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRealmResponseValidationError{}
+var _ error = GetRealmBySlugResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRealmBySlugResponseValidationError{}
+
+// Validate checks the field values on GetRealmAncestorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRealmAncestorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRealmAncestorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRealmAncestorsRequestMultiError, or nil if none found.
+func (m *GetRealmAncestorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRealmAncestorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetRealmAncestorsRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return GetRealmAncestorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRealmAncestorsRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRealmAncestorsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRealmAncestorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRealmAncestorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRealmAncestorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRealmAncestorsRequestMultiError) AllErrors() []error { return m }
+
+// GetRealmAncestorsRequestValidationError is the validation error returned by
+// GetRealmAncestorsRequest.Validate if the designated constraints aren't met.
+type GetRealmAncestorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRealmAncestorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRealmAncestorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRealmAncestorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRealmAncestorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRealmAncestorsRequestValidationError) ErrorName() string {
+	return "GetRealmAncestorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRealmAncestorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRealmAncestorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRealmAncestorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRealmAncestorsRequestValidationError{}
+
+// Validate checks the field values on GetRealmAncestorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRealmAncestorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRealmAncestorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRealmAncestorsResponseMultiError, or nil if none found.
+func (m *GetRealmAncestorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRealmAncestorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAncestors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRealmAncestorsResponseValidationError{
+						field:  fmt.Sprintf("Ancestors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRealmAncestorsResponseValidationError{
+						field:  fmt.Sprintf("Ancestors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRealmAncestorsResponseValidationError{
+					field:  fmt.Sprintf("Ancestors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRealmAncestorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRealmAncestorsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRealmAncestorsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetRealmAncestorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRealmAncestorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRealmAncestorsResponseMultiError) AllErrors() []error { return m }
+
+// GetRealmAncestorsResponseValidationError is the validation error returned by
+// GetRealmAncestorsResponse.Validate if the designated constraints aren't met.
+type GetRealmAncestorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRealmAncestorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRealmAncestorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRealmAncestorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRealmAncestorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRealmAncestorsResponseValidationError) ErrorName() string {
+	return "GetRealmAncestorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRealmAncestorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRealmAncestorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRealmAncestorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRealmAncestorsResponseValidationError{}
+
+// Validate checks the field values on BatchGetRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetRealmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetRealmsRequestMultiError, or nil if none found.
+func (m *BatchGetRealmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetRealmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) < 1 {
+		err := BatchGetRealmsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = BatchGetRealmsRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return BatchGetRealmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BatchGetRealmsRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BatchGetRealmsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetRealmsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetRealmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetRealmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetRealmsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetRealmsRequestValidationError is the validation error returned by
+// BatchGetRealmsRequest.Validate if the designated constraints aren't met.
+type BatchGetRealmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetRealmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetRealmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetRealmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetRealmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetRealmsRequestValidationError) ErrorName() string {
+	return "BatchGetRealmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetRealmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetRealmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetRealmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetRealmsRequestValidationError{}
+
+// Validate checks the field values on BatchGetRealmsResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetRealmsResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetRealmsResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetRealmsResultMultiError, or nil if none found.
+func (m *BatchGetRealmsResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetRealmsResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	switch v := m.Result.(type) {
+	case *BatchGetRealmsResult_Realm:
+		if v == nil {
+			err := BatchGetRealmsResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRealm()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetRealmsResultValidationError{
+						field:  "Realm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetRealmsResultValidationError{
+						field:  "Realm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetRealmsResultValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchGetRealmsResult_Error:
+		if v == nil {
+			err := BatchGetRealmsResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetRealmsResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetRealmsResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetRealmsResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return BatchGetRealmsResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetRealmsResultMultiError is an error wrapping multiple validation
+// errors returned by BatchGetRealmsResult.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetRealmsResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetRealmsResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetRealmsResultMultiError) AllErrors() []error { return m }
+
+// BatchGetRealmsResultValidationError is the validation error returned by
+// BatchGetRealmsResult.Validate if the designated constraints aren't met.
+type BatchGetRealmsResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetRealmsResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetRealmsResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetRealmsResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetRealmsResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetRealmsResultValidationError) ErrorName() string {
+	return "BatchGetRealmsResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetRealmsResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetRealmsResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetRealmsResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetRealmsResultValidationError{}
+
+// Validate checks the field values on BatchGetRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetRealmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetRealmsResponseMultiError, or nil if none found.
+func (m *BatchGetRealmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetRealmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetRealmsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetRealmsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetRealmsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetRealmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetRealmsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetRealmsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetRealmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetRealmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetRealmsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetRealmsResponseValidationError is the validation error returned by
+// BatchGetRealmsResponse.Validate if the designated constraints aren't met.
+type BatchGetRealmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetRealmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetRealmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetRealmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetRealmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetRealmsResponseValidationError) ErrorName() string {
+	return "BatchGetRealmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetRealmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetRealmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetRealmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetRealmsResponseValidationError{}
+
+// Validate checks the field values on ListRealmsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRealmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRealmsRequestMultiError, or nil if none found.
+func (m *ListRealmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRealmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if m.GetPageSize() > 100 {
+		err := ListRealmsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for SortField
+
+	// no validation rules for SortDirection
+
+	// no validation rules for LabelSelector
+
+	if len(errors) > 0 {
+		return ListRealmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRealmsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRealmsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRealmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRealmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRealmsRequestMultiError) AllErrors() []error { return m }
+
+// ListRealmsRequestValidationError is the validation error returned by
+// ListRealmsRequest.Validate if the designated constraints aren't met.
+type ListRealmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRealmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRealmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRealmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRealmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRealmsRequestValidationError) ErrorName() string {
+	return "ListRealmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRealmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRealmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRealmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRealmsRequestValidationError{}
+
+// Validate checks the field values on ListRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRealmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRealmsResponseMultiError, or nil if none found.
+func (m *ListRealmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRealmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRealms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRealmsResponseValidationError{
+						field:  fmt.Sprintf("Realms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRealmsResponseValidationError{
+						field:  fmt.Sprintf("Realms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRealmsResponseValidationError{
+					field:  fmt.Sprintf("Realms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRealmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRealmsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRealmsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRealmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRealmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRealmsResponseMultiError) AllErrors() []error { return m }
+
+// ListRealmsResponseValidationError is the validation error returned by
+// ListRealmsResponse.Validate if the designated constraints aren't met.
+type ListRealmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRealmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRealmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRealmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRealmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRealmsResponseValidationError) ErrorName() string {
+	return "ListRealmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRealmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRealmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRealmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRealmsResponseValidationError{}
+
+// Validate checks the field values on SearchRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchRealmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchRealmsRequestMultiError, or nil if none found.
+func (m *SearchRealmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRealmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchRealmsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	if m.GetPageSize() > 100 {
+		err := SearchRealmsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchRealmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRealmsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchRealmsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchRealmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRealmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRealmsRequestMultiError) AllErrors() []error { return m }
+
+// SearchRealmsRequestValidationError is the validation error returned by
+// SearchRealmsRequest.Validate if the designated constraints aren't met.
+type SearchRealmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRealmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRealmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRealmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRealmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRealmsRequestValidationError) ErrorName() string {
+	return "SearchRealmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchRealmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRealmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRealmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRealmsRequestValidationError{}
+
+// Validate checks the field values on RealmSearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RealmSearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmSearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RealmSearchResultMultiError, or nil if none found.
+func (m *RealmSearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmSearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmSearchResultValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmSearchResultValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmSearchResultValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for NameHighlight
+
+	// no validation rules for DescriptionHighlight
+
+	if len(errors) > 0 {
+		return RealmSearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmSearchResultMultiError is an error wrapping multiple validation errors
+// returned by RealmSearchResult.ValidateAll() if the designated constraints
+// aren't met.
+type RealmSearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmSearchResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmSearchResultMultiError) AllErrors() []error { return m }
+
+// RealmSearchResultValidationError is the validation error returned by
+// RealmSearchResult.Validate if the designated constraints aren't met.
+type RealmSearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmSearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmSearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmSearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmSearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmSearchResultValidationError) ErrorName() string {
+	return "RealmSearchResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RealmSearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmSearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmSearchResultValidationError{}
+
+// Validate checks the field values on SearchRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchRealmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchRealmsResponseMultiError, or nil if none found.
+func (m *SearchRealmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRealmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchRealmsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchRealmsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchRealmsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchRealmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRealmsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchRealmsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchRealmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRealmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRealmsResponseMultiError) AllErrors() []error { return m }
+
+// SearchRealmsResponseValidationError is the validation error returned by
+// SearchRealmsResponse.Validate if the designated constraints aren't met.
+type SearchRealmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRealmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRealmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRealmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRealmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRealmsResponseValidationError) ErrorName() string {
+	return "SearchRealmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchRealmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRealmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRealmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRealmsResponseValidationError{}
+
+// Validate checks the field values on ListChildRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChildRealmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChildRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChildRealmsRequestMultiError, or nil if none found.
+func (m *ListChildRealmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChildRealmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetParentId()); err != nil {
+		err = ListChildRealmsRequestValidationError{
+			field:  "ParentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	if m.GetPageSize() > 100 {
+		err := ListChildRealmsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListChildRealmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListChildRealmsRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListChildRealmsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListChildRealmsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListChildRealmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChildRealmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChildRealmsRequestMultiError) AllErrors() []error { return m }
+
+// ListChildRealmsRequestValidationError is the validation error returned by
+// ListChildRealmsRequest.Validate if the designated constraints aren't met.
+type ListChildRealmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChildRealmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChildRealmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChildRealmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChildRealmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChildRealmsRequestValidationError) ErrorName() string {
+	return "ListChildRealmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChildRealmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChildRealmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChildRealmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChildRealmsRequestValidationError{}
+
+// Validate checks the field values on ListChildRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListChildRealmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChildRealmsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChildRealmsResponseMultiError, or nil if none found.
+func (m *ListChildRealmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChildRealmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRealms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListChildRealmsResponseValidationError{
+						field:  fmt.Sprintf("Realms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListChildRealmsResponseValidationError{
+						field:  fmt.Sprintf("Realms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListChildRealmsResponseValidationError{
+					field:  fmt.Sprintf("Realms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListChildRealmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChildRealmsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListChildRealmsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListChildRealmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChildRealmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChildRealmsResponseMultiError) AllErrors() []error { return m }
+
+// ListChildRealmsResponseValidationError is the validation error returned by
+// ListChildRealmsResponse.Validate if the designated constraints aren't met.
+type ListChildRealmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChildRealmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChildRealmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChildRealmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChildRealmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChildRealmsResponseValidationError) ErrorName() string {
+	return "ListChildRealmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChildRealmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChildRealmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChildRealmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChildRealmsResponseValidationError{}
+
+// Validate checks the field values on GetRealmStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRealmStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRealmStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRealmStatsRequestMultiError, or nil if none found.
+func (m *GetRealmStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRealmStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LabelSelector
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRealmStatsRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRealmStatsRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRealmStatsRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRealmStatsRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRealmStatsRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRealmStatsRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetStaleDraftDays() > 3650 {
+		err := GetRealmStatsRequestValidationError{
+			field:  "StaleDraftDays",
+			reason: "value must be less than or equal to 3650",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRealmStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRealmStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRealmStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRealmStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRealmStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRealmStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetRealmStatsRequestValidationError is the validation error returned by
+// GetRealmStatsRequest.Validate if the designated constraints aren't met.
+type GetRealmStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRealmStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRealmStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRealmStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRealmStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRealmStatsRequestValidationError) ErrorName() string {
+	return "GetRealmStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRealmStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRealmStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRealmStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRealmStatsRequestValidationError{}
+
+// Validate checks the field values on RealmDailyCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RealmDailyCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmDailyCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RealmDailyCountMultiError, or nil if none found.
+func (m *RealmDailyCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmDailyCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmDailyCountValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmDailyCountValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmDailyCountValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return RealmDailyCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmDailyCountMultiError is an error wrapping multiple validation errors
+// returned by RealmDailyCount.ValidateAll() if the designated constraints
+// aren't met.
+type RealmDailyCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmDailyCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmDailyCountMultiError) AllErrors() []error { return m }
+
+// RealmDailyCountValidationError is the validation error returned by
+// RealmDailyCount.Validate if the designated constraints aren't met.
+type RealmDailyCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmDailyCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmDailyCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmDailyCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmDailyCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmDailyCountValidationError) ErrorName() string { return "RealmDailyCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RealmDailyCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmDailyCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmDailyCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmDailyCountValidationError{}
+
+// Validate checks the field values on GetRealmStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRealmStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRealmStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRealmStatsResponseMultiError, or nil if none found.
+func (m *GetRealmStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRealmStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActiveCount
+
+	// no validation rules for DraftCount
+
+	// no validation rules for DisabledCount
+
+	// no validation rules for DeletedCount
+
+	// no validation rules for StaleDraftCount
+
+	for idx, item := range m.GetCreatedPerDay() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRealmStatsResponseValidationError{
+						field:  fmt.Sprintf("CreatedPerDay[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRealmStatsResponseValidationError{
+						field:  fmt.Sprintf("CreatedPerDay[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRealmStatsResponseValidationError{
+					field:  fmt.Sprintf("CreatedPerDay[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRealmStatsResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRealmStatsResponseValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRealmStatsResponseValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRealmStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRealmStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRealmStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRealmStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRealmStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRealmStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetRealmStatsResponseValidationError is the validation error returned by
+// GetRealmStatsResponse.Validate if the designated constraints aren't met.
+type GetRealmStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRealmStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRealmStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRealmStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRealmStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRealmStatsResponseValidationError) ErrorName() string {
+	return "GetRealmStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRealmStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRealmStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRealmStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRealmStatsResponseValidationError{}
+
+// Validate checks the field values on RealmEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RealmEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RealmEventMultiError, or
+// nil if none found.
+func (m *RealmEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RealmId
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RealmEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmEventMultiError is an error wrapping multiple validation errors
+// returned by RealmEvent.ValidateAll() if the designated constraints aren't met.
+type RealmEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmEventMultiError) AllErrors() []error { return m }
+
+// RealmEventValidationError is the validation error returned by
+// RealmEvent.Validate if the designated constraints aren't met.
+type RealmEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmEventValidationError) ErrorName() string { return "RealmEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RealmEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmEventValidationError{}
+
+// Validate checks the field values on WatchRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchRealmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchRealmsRequestMultiError, or nil if none found.
+func (m *WatchRealmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRealmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() != "" {
+
+		if err := m._validateUuid(m.GetId()); err != nil {
+			err = WatchRealmsRequestValidationError{
+				field:  "Id",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for SequenceToken
+
+	if len(errors) > 0 {
+		return WatchRealmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WatchRealmsRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchRealmsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchRealmsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchRealmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRealmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRealmsRequestMultiError) AllErrors() []error { return m }
+
+// WatchRealmsRequestValidationError is the validation error returned by
+// WatchRealmsRequest.Validate if the designated constraints aren't met.
+type WatchRealmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRealmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRealmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRealmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRealmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRealmsRequestValidationError) ErrorName() string {
+	return "WatchRealmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchRealmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRealmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRealmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRealmsRequestValidationError{}
+
+// Validate checks the field values on WatchRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchRealmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchRealmsResponseMultiError, or nil if none found.
+func (m *WatchRealmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRealmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchRealmsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchRealmsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchRealmsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SequenceToken
+
+	if len(errors) > 0 {
+		return WatchRealmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchRealmsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchRealmsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchRealmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRealmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRealmsResponseMultiError) AllErrors() []error { return m }
+
+// WatchRealmsResponseValidationError is the validation error returned by
+// WatchRealmsResponse.Validate if the designated constraints aren't met.
+type WatchRealmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRealmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRealmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRealmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRealmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRealmsResponseValidationError) ErrorName() string {
+	return "WatchRealmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchRealmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRealmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRealmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRealmsResponseValidationError{}
+
+// Validate checks the field values on CreateRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRealmRequestMultiError, or nil if none found.
+func (m *CreateRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateRealmRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for ValidateOnly
+
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRealmRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRealmRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRealmRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetParentId() != "" {
+
+		if err := m._validateUuid(m.GetParentId()); err != nil {
+			err = CreateRealmRequestValidationError{
+				field:  "ParentId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetSlug()) > 63 {
+		err := CreateRealmRequestValidationError{
+			field:  "Slug",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateRealmRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRealmRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRealmRequestMultiError) AllErrors() []error { return m }
+
+// CreateRealmRequestValidationError is the validation error returned by
+// CreateRealmRequest.Validate if the designated constraints aren't met.
+type CreateRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRealmRequestValidationError) ErrorName() string {
+	return "CreateRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRealmRequestValidationError{}
+
+// Validate checks the field values on CreateRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRealmResponseMultiError, or nil if none found.
+func (m *CreateRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRealmResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRealmResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateRealmResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRealmResponseMultiError) AllErrors() []error { return m }
+
+// CreateRealmResponseValidationError is the validation error returned by
+// CreateRealmResponse.Validate if the designated constraints aren't met.
+type CreateRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRealmResponseValidationError) ErrorName() string {
+	return "CreateRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRealmResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -726,22 +3831,7116 @@ var _ interface {
 	ErrorName() string
 } = CreateRealmResponseValidationError{}
 
-// Validate checks the field values on ReleaseRealmRequest with the rules
+// Validate checks the field values on RenameRealmSlugRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameRealmSlugRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameRealmSlugRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameRealmSlugRequestMultiError, or nil if none found.
+func (m *RenameRealmSlugRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameRealmSlugRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RenameRealmSlugRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSlug()); l < 1 || l > 63 {
+		err := RenameRealmSlugRequestValidationError{
+			field:  "Slug",
+			reason: "value length must be between 1 and 63 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return RenameRealmSlugRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RenameRealmSlugRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RenameRealmSlugRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameRealmSlugRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameRealmSlugRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameRealmSlugRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameRealmSlugRequestMultiError) AllErrors() []error { return m }
+
+// RenameRealmSlugRequestValidationError is the validation error returned by
+// RenameRealmSlugRequest.Validate if the designated constraints aren't met.
+type RenameRealmSlugRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameRealmSlugRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameRealmSlugRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameRealmSlugRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameRealmSlugRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameRealmSlugRequestValidationError) ErrorName() string {
+	return "RenameRealmSlugRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameRealmSlugRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameRealmSlugRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameRealmSlugRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameRealmSlugRequestValidationError{}
+
+// Validate checks the field values on RenameRealmSlugResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameRealmSlugResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameRealmSlugResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameRealmSlugResponseMultiError, or nil if none found.
+func (m *RenameRealmSlugResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameRealmSlugResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenameRealmSlugResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenameRealmSlugResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenameRealmSlugResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenameRealmSlugResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameRealmSlugResponseMultiError is an error wrapping multiple validation
+// errors returned by RenameRealmSlugResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenameRealmSlugResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameRealmSlugResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameRealmSlugResponseMultiError) AllErrors() []error { return m }
+
+// RenameRealmSlugResponseValidationError is the validation error returned by
+// RenameRealmSlugResponse.Validate if the designated constraints aren't met.
+type RenameRealmSlugResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameRealmSlugResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameRealmSlugResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameRealmSlugResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameRealmSlugResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameRealmSlugResponseValidationError) ErrorName() string {
+	return "RenameRealmSlugResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameRealmSlugResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameRealmSlugResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameRealmSlugResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameRealmSlugResponseValidationError{}
+
+// Validate checks the field values on CloneRealmRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CloneRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneRealmRequestMultiError, or nil if none found.
+func (m *CloneRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSourceId()); err != nil {
+		err = CloneRealmRequestValidationError{
+			field:  "SourceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SourceStatus
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CloneRealmRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return CloneRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CloneRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CloneRealmRequestMultiError is an error wrapping multiple validation errors
+// returned by CloneRealmRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CloneRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneRealmRequestMultiError) AllErrors() []error { return m }
+
+// CloneRealmRequestValidationError is the validation error returned by
+// CloneRealmRequest.Validate if the designated constraints aren't met.
+type CloneRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneRealmRequestValidationError) ErrorName() string {
+	return "CloneRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneRealmRequestValidationError{}
+
+// Validate checks the field values on CloneRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneRealmResponseMultiError, or nil if none found.
+func (m *CloneRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloneRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloneRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloneRealmResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloneRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneRealmResponseMultiError is an error wrapping multiple validation errors
+// returned by CloneRealmResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CloneRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneRealmResponseMultiError) AllErrors() []error { return m }
+
+// CloneRealmResponseValidationError is the validation error returned by
+// CloneRealmResponse.Validate if the designated constraints aren't met.
+type CloneRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneRealmResponseValidationError) ErrorName() string {
+	return "CloneRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneRealmResponseValidationError{}
+
+// Validate checks the field values on ReleaseRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseRealmRequestMultiError, or nil if none found.
+func (m *ReleaseRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ReleaseRealmRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Etag
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return ReleaseRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReleaseRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReleaseRealmRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseRealmRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseRealmRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseRealmRequestValidationError is the validation error returned by
+// ReleaseRealmRequest.Validate if the designated constraints aren't met.
+type ReleaseRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseRealmRequestValidationError) ErrorName() string {
+	return "ReleaseRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseRealmRequestValidationError{}
+
+// Validate checks the field values on ReleaseRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseRealmResponseMultiError, or nil if none found.
+func (m *ReleaseRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReleaseRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReleaseRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReleaseRealmResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReleaseRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseRealmResponseMultiError is an error wrapping multiple validation
+// errors returned by ReleaseRealmResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseRealmResponseMultiError) AllErrors() []error { return m }
+
+// ReleaseRealmResponseValidationError is the validation error returned by
+// ReleaseRealmResponse.Validate if the designated constraints aren't met.
+type ReleaseRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseRealmResponseValidationError) ErrorName() string {
+	return "ReleaseRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseRealmResponseValidationError{}
+
+// Validate checks the field values on UpdateRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRealmRequestMultiError, or nil if none found.
+func (m *UpdateRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRealmRequestValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRealmRequestValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRealmRequestValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRealmRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRealmRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRealmRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return UpdateRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRealmRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRealmRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRealmRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRealmRequestValidationError is the validation error returned by
+// UpdateRealmRequest.Validate if the designated constraints aren't met.
+type UpdateRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRealmRequestValidationError) ErrorName() string {
+	return "UpdateRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRealmRequestValidationError{}
+
+// Validate checks the field values on UpdateRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRealmResponseMultiError, or nil if none found.
+func (m *UpdateRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRealmResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRealmResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateRealmResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRealmResponseMultiError) AllErrors() []error { return m }
+
+// UpdateRealmResponseValidationError is the validation error returned by
+// UpdateRealmResponse.Validate if the designated constraints aren't met.
+type UpdateRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRealmResponseValidationError) ErrorName() string {
+	return "UpdateRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRealmResponseValidationError{}
+
+// Validate checks the field values on CreateRealmMutation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRealmMutation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRealmMutation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRealmMutationMultiError, or nil if none found.
+func (m *CreateRealmMutation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRealmMutation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateRealmMutationValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRealmMutationValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRealmMutationValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRealmMutationValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetParentId() != "" {
+
+		if err := m._validateUuid(m.GetParentId()); err != nil {
+			err = CreateRealmMutationValidationError{
+				field:  "ParentId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetSlug()) > 63 {
+		err := CreateRealmMutationValidationError{
+			field:  "Slug",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRealmMutationMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateRealmMutation) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateRealmMutationMultiError is an error wrapping multiple validation
+// errors returned by CreateRealmMutation.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRealmMutationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRealmMutationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRealmMutationMultiError) AllErrors() []error { return m }
+
+// CreateRealmMutationValidationError is the validation error returned by
+// CreateRealmMutation.Validate if the designated constraints aren't met.
+type CreateRealmMutationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRealmMutationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRealmMutationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRealmMutationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRealmMutationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRealmMutationValidationError) ErrorName() string {
+	return "CreateRealmMutationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRealmMutationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRealmMutation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRealmMutationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRealmMutationValidationError{}
+
+// Validate checks the field values on UpdateRealmMutation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRealmMutation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRealmMutation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRealmMutationMultiError, or nil if none found.
+func (m *UpdateRealmMutation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRealmMutation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRealm() == nil {
+		err := UpdateRealmMutationValidationError{
+			field:  "Realm",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRealmMutationValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRealmMutationValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRealmMutationValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRealmMutationValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRealmMutationValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRealmMutationValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return UpdateRealmMutationMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRealmMutationMultiError is an error wrapping multiple validation
+// errors returned by UpdateRealmMutation.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRealmMutationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRealmMutationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRealmMutationMultiError) AllErrors() []error { return m }
+
+// UpdateRealmMutationValidationError is the validation error returned by
+// UpdateRealmMutation.Validate if the designated constraints aren't met.
+type UpdateRealmMutationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRealmMutationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRealmMutationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRealmMutationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRealmMutationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRealmMutationValidationError) ErrorName() string {
+	return "UpdateRealmMutationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRealmMutationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRealmMutation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRealmMutationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRealmMutationValidationError{}
+
+// Validate checks the field values on ReleaseRealmMutation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseRealmMutation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseRealmMutation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseRealmMutationMultiError, or nil if none found.
+func (m *ReleaseRealmMutation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseRealmMutation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ReleaseRealmMutationValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return ReleaseRealmMutationMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReleaseRealmMutation) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReleaseRealmMutationMultiError is an error wrapping multiple validation
+// errors returned by ReleaseRealmMutation.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseRealmMutationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseRealmMutationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseRealmMutationMultiError) AllErrors() []error { return m }
+
+// ReleaseRealmMutationValidationError is the validation error returned by
+// ReleaseRealmMutation.Validate if the designated constraints aren't met.
+type ReleaseRealmMutationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseRealmMutationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseRealmMutationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseRealmMutationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseRealmMutationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseRealmMutationValidationError) ErrorName() string {
+	return "ReleaseRealmMutationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseRealmMutationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseRealmMutation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseRealmMutationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseRealmMutationValidationError{}
+
+// Validate checks the field values on DisableRealmMutation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableRealmMutation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableRealmMutation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableRealmMutationMultiError, or nil if none found.
+func (m *DisableRealmMutation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableRealmMutation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DisableRealmMutationValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := DisableRealmMutationValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cascade
+
+	if len(errors) > 0 {
+		return DisableRealmMutationMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DisableRealmMutation) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DisableRealmMutationMultiError is an error wrapping multiple validation
+// errors returned by DisableRealmMutation.ValidateAll() if the designated
+// constraints aren't met.
+type DisableRealmMutationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableRealmMutationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableRealmMutationMultiError) AllErrors() []error { return m }
+
+// DisableRealmMutationValidationError is the validation error returned by
+// DisableRealmMutation.Validate if the designated constraints aren't met.
+type DisableRealmMutationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableRealmMutationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableRealmMutationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableRealmMutationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableRealmMutationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableRealmMutationValidationError) ErrorName() string {
+	return "DisableRealmMutationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableRealmMutationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableRealmMutation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableRealmMutationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableRealmMutationValidationError{}
+
+// Validate checks the field values on RealmMutation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RealmMutation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmMutation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RealmMutationMultiError, or
+// nil if none found.
+func (m *RealmMutation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmMutation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofMutationPresent := false
+	switch v := m.Mutation.(type) {
+	case *RealmMutation_Create:
+		if v == nil {
+			err := RealmMutationValidationError{
+				field:  "Mutation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofMutationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetCreate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RealmMutationValidationError{
+						field:  "Create",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RealmMutationValidationError{
+						field:  "Create",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RealmMutationValidationError{
+					field:  "Create",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *RealmMutation_Update:
+		if v == nil {
+			err := RealmMutationValidationError{
+				field:  "Mutation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofMutationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetUpdate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RealmMutationValidationError{
+						field:  "Update",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RealmMutationValidationError{
+						field:  "Update",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RealmMutationValidationError{
+					field:  "Update",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *RealmMutation_Release:
+		if v == nil {
+			err := RealmMutationValidationError{
+				field:  "Mutation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofMutationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetRelease()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RealmMutationValidationError{
+						field:  "Release",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RealmMutationValidationError{
+						field:  "Release",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRelease()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RealmMutationValidationError{
+					field:  "Release",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *RealmMutation_Disable:
+		if v == nil {
+			err := RealmMutationValidationError{
+				field:  "Mutation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofMutationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetDisable()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RealmMutationValidationError{
+						field:  "Disable",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RealmMutationValidationError{
+						field:  "Disable",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDisable()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RealmMutationValidationError{
+					field:  "Disable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofMutationPresent {
+		err := RealmMutationValidationError{
+			field:  "Mutation",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RealmMutationMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmMutationMultiError is an error wrapping multiple validation errors
+// returned by RealmMutation.ValidateAll() if the designated constraints
+// aren't met.
+type RealmMutationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmMutationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmMutationMultiError) AllErrors() []error { return m }
+
+// RealmMutationValidationError is the validation error returned by
+// RealmMutation.Validate if the designated constraints aren't met.
+type RealmMutationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmMutationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmMutationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmMutationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmMutationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmMutationValidationError) ErrorName() string { return "RealmMutationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RealmMutationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmMutation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmMutationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmMutationValidationError{}
+
+// Validate checks the field values on BatchMutateRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchMutateRealmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchMutateRealmsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchMutateRealmsRequestMultiError, or nil if none found.
+func (m *BatchMutateRealmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchMutateRealmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetMutations()); l < 1 || l > 100 {
+		err := BatchMutateRealmsRequestValidationError{
+			field:  "Mutations",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMutations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchMutateRealmsRequestValidationError{
+						field:  fmt.Sprintf("Mutations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchMutateRealmsRequestValidationError{
+						field:  fmt.Sprintf("Mutations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchMutateRealmsRequestValidationError{
+					field:  fmt.Sprintf("Mutations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return BatchMutateRealmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchMutateRealmsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchMutateRealmsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchMutateRealmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchMutateRealmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchMutateRealmsRequestMultiError) AllErrors() []error { return m }
+
+// BatchMutateRealmsRequestValidationError is the validation error returned by
+// BatchMutateRealmsRequest.Validate if the designated constraints aren't met.
+type BatchMutateRealmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchMutateRealmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchMutateRealmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchMutateRealmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchMutateRealmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchMutateRealmsRequestValidationError) ErrorName() string {
+	return "BatchMutateRealmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchMutateRealmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchMutateRealmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchMutateRealmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchMutateRealmsRequestValidationError{}
+
+// Validate checks the field values on BatchMutateRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchMutateRealmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchMutateRealmsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchMutateRealmsResponseMultiError, or nil if none found.
+func (m *BatchMutateRealmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchMutateRealmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRealms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchMutateRealmsResponseValidationError{
+						field:  fmt.Sprintf("Realms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchMutateRealmsResponseValidationError{
+						field:  fmt.Sprintf("Realms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchMutateRealmsResponseValidationError{
+					field:  fmt.Sprintf("Realms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FailedIndex
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchMutateRealmsResponseValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchMutateRealmsResponseValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchMutateRealmsResponseValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchMutateRealmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchMutateRealmsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchMutateRealmsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type BatchMutateRealmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchMutateRealmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchMutateRealmsResponseMultiError) AllErrors() []error { return m }
+
+// BatchMutateRealmsResponseValidationError is the validation error returned by
+// BatchMutateRealmsResponse.Validate if the designated constraints aren't met.
+type BatchMutateRealmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchMutateRealmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchMutateRealmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchMutateRealmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchMutateRealmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchMutateRealmsResponseValidationError) ErrorName() string {
+	return "BatchMutateRealmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchMutateRealmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchMutateRealmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchMutateRealmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchMutateRealmsResponseValidationError{}
+
+// Validate checks the field values on RealmExportRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RealmExportRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmExportRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RealmExportRecordMultiError, or nil if none found.
+func (m *RealmExportRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmExportRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FormatVersion
+
+	if all {
+		switch v := interface{}(m.GetReleased()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmExportRecordValidationError{
+					field:  "Released",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmExportRecordValidationError{
+					field:  "Released",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleased()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmExportRecordValidationError{
+				field:  "Released",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDraft()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmExportRecordValidationError{
+					field:  "Draft",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmExportRecordValidationError{
+					field:  "Draft",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDraft()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmExportRecordValidationError{
+				field:  "Draft",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RealmExportRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmExportRecordMultiError is an error wrapping multiple validation errors
+// returned by RealmExportRecord.ValidateAll() if the designated constraints
+// aren't met.
+type RealmExportRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmExportRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmExportRecordMultiError) AllErrors() []error { return m }
+
+// RealmExportRecordValidationError is the validation error returned by
+// RealmExportRecord.Validate if the designated constraints aren't met.
+type RealmExportRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmExportRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmExportRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmExportRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmExportRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmExportRecordValidationError) ErrorName() string {
+	return "RealmExportRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RealmExportRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmExportRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmExportRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmExportRecordValidationError{}
+
+// Validate checks the field values on ExportRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportRealmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportRealmsRequestMultiError, or nil if none found.
+func (m *ExportRealmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportRealmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeDrafts
+
+	if len(errors) > 0 {
+		return ExportRealmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportRealmsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportRealmsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportRealmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportRealmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportRealmsRequestMultiError) AllErrors() []error { return m }
+
+// ExportRealmsRequestValidationError is the validation error returned by
+// ExportRealmsRequest.Validate if the designated constraints aren't met.
+type ExportRealmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRealmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRealmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRealmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRealmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRealmsRequestValidationError) ErrorName() string {
+	return "ExportRealmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportRealmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRealmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRealmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRealmsRequestValidationError{}
+
+// Validate checks the field values on ExportRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportRealmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportRealmsResponseMultiError, or nil if none found.
+func (m *ExportRealmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportRealmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportRealmsResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportRealmsResponseValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportRealmsResponseValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportRealmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportRealmsResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportRealmsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportRealmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportRealmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportRealmsResponseMultiError) AllErrors() []error { return m }
+
+// ExportRealmsResponseValidationError is the validation error returned by
+// ExportRealmsResponse.Validate if the designated constraints aren't met.
+type ExportRealmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRealmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRealmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRealmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRealmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRealmsResponseValidationError) ErrorName() string {
+	return "ExportRealmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportRealmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRealmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRealmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRealmsResponseValidationError{}
+
+// Validate checks the field values on ImportRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportRealmsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRealmsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRealmsRequestMultiError, or nil if none found.
+func (m *ImportRealmsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRealmsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRecord() == nil {
+		err := ImportRealmsRequestValidationError{
+			field:  "Record",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportRealmsRequestValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportRealmsRequestValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportRealmsRequestValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ConflictPolicy
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return ImportRealmsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRealmsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportRealmsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportRealmsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRealmsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRealmsRequestMultiError) AllErrors() []error { return m }
+
+// ImportRealmsRequestValidationError is the validation error returned by
+// ImportRealmsRequest.Validate if the designated constraints aren't met.
+type ImportRealmsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRealmsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRealmsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRealmsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRealmsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRealmsRequestValidationError) ErrorName() string {
+	return "ImportRealmsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportRealmsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRealmsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRealmsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRealmsRequestValidationError{}
+
+// Validate checks the field values on RealmImportResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RealmImportResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmImportResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RealmImportResultMultiError, or nil if none found.
+func (m *RealmImportResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmImportResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RealmId
+
+	// no validation rules for Outcome
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmImportResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmImportResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmImportResultValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RealmImportResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmImportResultMultiError is an error wrapping multiple validation errors
+// returned by RealmImportResult.ValidateAll() if the designated constraints
+// aren't met.
+type RealmImportResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmImportResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmImportResultMultiError) AllErrors() []error { return m }
+
+// RealmImportResultValidationError is the validation error returned by
+// RealmImportResult.Validate if the designated constraints aren't met.
+type RealmImportResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmImportResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmImportResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmImportResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmImportResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmImportResultValidationError) ErrorName() string {
+	return "RealmImportResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RealmImportResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmImportResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmImportResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmImportResultValidationError{}
+
+// Validate checks the field values on ImportRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportRealmsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRealmsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRealmsResponseMultiError, or nil if none found.
+func (m *ImportRealmsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRealmsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportRealmsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportRealmsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportRealmsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedCount
+
+	// no validation rules for UpdatedCount
+
+	// no validation rules for SkippedCount
+
+	// no validation rules for RejectedCount
+
+	// no validation rules for Aborted
+
+	if len(errors) > 0 {
+		return ImportRealmsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRealmsResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportRealmsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportRealmsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRealmsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRealmsResponseMultiError) AllErrors() []error { return m }
+
+// ImportRealmsResponseValidationError is the validation error returned by
+// ImportRealmsResponse.Validate if the designated constraints aren't met.
+type ImportRealmsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRealmsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRealmsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRealmsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRealmsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRealmsResponseValidationError) ErrorName() string {
+	return "ImportRealmsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportRealmsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRealmsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRealmsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRealmsResponseValidationError{}
+
+// Validate checks the field values on DisableRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableRealmRequestMultiError, or nil if none found.
+func (m *DisableRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DisableRealmRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := DisableRealmRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	// no validation rules for Cascade
+
+	if len(errors) > 0 {
+		return DisableRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DisableRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DisableRealmRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableRealmRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisableRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableRealmRequestMultiError) AllErrors() []error { return m }
+
+// DisableRealmRequestValidationError is the validation error returned by
+// DisableRealmRequest.Validate if the designated constraints aren't met.
+type DisableRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableRealmRequestValidationError) ErrorName() string {
+	return "DisableRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableRealmRequestValidationError{}
+
+// Validate checks the field values on DisableRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableRealmResponseMultiError, or nil if none found.
+func (m *DisableRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisableRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisableRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisableRealmResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DisableRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableRealmResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableRealmResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableRealmResponseMultiError) AllErrors() []error { return m }
+
+// DisableRealmResponseValidationError is the validation error returned by
+// DisableRealmResponse.Validate if the designated constraints aren't met.
+type DisableRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableRealmResponseValidationError) ErrorName() string {
+	return "DisableRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableRealmResponseValidationError{}
+
+// Validate checks the field values on EnableRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableRealmRequestMultiError, or nil if none found.
+func (m *EnableRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = EnableRealmRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := EnableRealmRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return EnableRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EnableRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EnableRealmRequestMultiError is an error wrapping multiple validation errors
+// returned by EnableRealmRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnableRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableRealmRequestMultiError) AllErrors() []error { return m }
+
+// EnableRealmRequestValidationError is the validation error returned by
+// EnableRealmRequest.Validate if the designated constraints aren't met.
+type EnableRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableRealmRequestValidationError) ErrorName() string {
+	return "EnableRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableRealmRequestValidationError{}
+
+// Validate checks the field values on EnableRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableRealmResponseMultiError, or nil if none found.
+func (m *EnableRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EnableRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EnableRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EnableRealmResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EnableRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableRealmResponseMultiError is an error wrapping multiple validation
+// errors returned by EnableRealmResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnableRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableRealmResponseMultiError) AllErrors() []error { return m }
+
+// EnableRealmResponseValidationError is the validation error returned by
+// EnableRealmResponse.Validate if the designated constraints aren't met.
+type EnableRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableRealmResponseValidationError) ErrorName() string {
+	return "EnableRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableRealmResponseValidationError{}
+
+// Validate checks the field values on DeleteRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRealmRequestMultiError, or nil if none found.
+func (m *DeleteRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteRealmRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Force
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := DeleteRealmRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	// no validation rules for Cascade
+
+	if len(errors) > 0 {
+		return DeleteRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteRealmRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRealmRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRealmRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRealmRequestValidationError is the validation error returned by
+// DeleteRealmRequest.Validate if the designated constraints aren't met.
+type DeleteRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRealmRequestValidationError) ErrorName() string {
+	return "DeleteRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRealmRequestValidationError{}
+
+// Validate checks the field values on DeleteRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRealmResponseMultiError, or nil if none found.
+func (m *DeleteRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRealmResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteRealmResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRealmResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRealmResponseValidationError is the validation error returned by
+// DeleteRealmResponse.Validate if the designated constraints aren't met.
+type DeleteRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRealmResponseValidationError) ErrorName() string {
+	return "DeleteRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRealmResponseValidationError{}
+
+// Validate checks the field values on RestoreRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRealmRequestMultiError, or nil if none found.
+func (m *RestoreRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RestoreRealmRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := RestoreRealmRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return RestoreRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreRealmRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreRealmRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRealmRequestMultiError) AllErrors() []error { return m }
+
+// RestoreRealmRequestValidationError is the validation error returned by
+// RestoreRealmRequest.Validate if the designated constraints aren't met.
+type RestoreRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRealmRequestValidationError) ErrorName() string {
+	return "RestoreRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRealmRequestValidationError{}
+
+// Validate checks the field values on RestoreRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRealmResponseMultiError, or nil if none found.
+func (m *RestoreRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreRealmResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreRealmResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreRealmResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRealmResponseMultiError) AllErrors() []error { return m }
+
+// RestoreRealmResponseValidationError is the validation error returned by
+// RestoreRealmResponse.Validate if the designated constraints aren't met.
+type RestoreRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRealmResponseValidationError) ErrorName() string {
+	return "RestoreRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRealmResponseValidationError{}
+
+// Validate checks the field values on DiscardDraftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscardDraftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscardDraftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscardDraftRequestMultiError, or nil if none found.
+func (m *DiscardDraftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscardDraftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DiscardDraftRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeleteRealm
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return DiscardDraftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DiscardDraftRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DiscardDraftRequestMultiError is an error wrapping multiple validation
+// errors returned by DiscardDraftRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiscardDraftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscardDraftRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscardDraftRequestMultiError) AllErrors() []error { return m }
+
+// DiscardDraftRequestValidationError is the validation error returned by
+// DiscardDraftRequest.Validate if the designated constraints aren't met.
+type DiscardDraftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscardDraftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscardDraftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscardDraftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscardDraftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscardDraftRequestValidationError) ErrorName() string {
+	return "DiscardDraftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscardDraftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscardDraftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscardDraftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscardDraftRequestValidationError{}
+
+// Validate checks the field values on DiscardDraftResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiscardDraftResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscardDraftResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiscardDraftResponseMultiError, or nil if none found.
+func (m *DiscardDraftResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscardDraftResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DiscardDraftResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscardDraftResponseMultiError is an error wrapping multiple validation
+// errors returned by DiscardDraftResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DiscardDraftResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscardDraftResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscardDraftResponseMultiError) AllErrors() []error { return m }
+
+// DiscardDraftResponseValidationError is the validation error returned by
+// DiscardDraftResponse.Validate if the designated constraints aren't met.
+type DiscardDraftResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscardDraftResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscardDraftResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscardDraftResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscardDraftResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscardDraftResponseValidationError) ErrorName() string {
+	return "DiscardDraftResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscardDraftResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscardDraftResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscardDraftResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscardDraftResponseValidationError{}
+
+// Validate checks the field values on RealmRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RealmRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RealmRevisionMultiError, or
+// nil if none found.
+func (m *RealmRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmRevisionValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmRevisionValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmRevisionValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReleasedBy
+
+	if all {
+		switch v := interface{}(m.GetReleasedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RealmRevisionValidationError{
+					field:  "ReleasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RealmRevisionValidationError{
+					field:  "ReleasedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleasedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RealmRevisionValidationError{
+				field:  "ReleasedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RealmRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmRevisionMultiError is an error wrapping multiple validation errors
+// returned by RealmRevision.ValidateAll() if the designated constraints
+// aren't met.
+type RealmRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmRevisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmRevisionMultiError) AllErrors() []error { return m }
+
+// RealmRevisionValidationError is the validation error returned by
+// RealmRevision.Validate if the designated constraints aren't met.
+type RealmRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmRevisionValidationError) ErrorName() string { return "RealmRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RealmRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmRevisionValidationError{}
+
+// Validate checks the field values on ListRealmRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRealmRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRealmRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRealmRevisionsRequestMultiError, or nil if none found.
+func (m *ListRealmRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRealmRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ListRealmRevisionsRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() > 100 {
+		err := ListRealmRevisionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListRealmRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListRealmRevisionsRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListRealmRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRealmRevisionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRealmRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRealmRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRealmRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListRealmRevisionsRequestValidationError is the validation error returned by
+// ListRealmRevisionsRequest.Validate if the designated constraints aren't met.
+type ListRealmRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRealmRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRealmRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRealmRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRealmRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRealmRevisionsRequestValidationError) ErrorName() string {
+	return "ListRealmRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRealmRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRealmRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRealmRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRealmRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListRealmRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRealmRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRealmRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRealmRevisionsResponseMultiError, or nil if none found.
+func (m *ListRealmRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRealmRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRealmRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRealmRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRealmRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRealmRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRealmRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRealmRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRealmRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRealmRevisionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRealmRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListRealmRevisionsResponseValidationError is the validation error returned
+// by ListRealmRevisionsResponse.Validate if the designated constraints aren't met.
+type ListRealmRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRealmRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRealmRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRealmRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRealmRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRealmRevisionsResponseValidationError) ErrorName() string {
+	return "ListRealmRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRealmRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRealmRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRealmRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRealmRevisionsResponseValidationError{}
+
+// Validate checks the field values on GetRealmRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRealmRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRealmRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRealmRevisionRequestMultiError, or nil if none found.
+func (m *GetRealmRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRealmRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetRealmRevisionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofSelectorPresent := false
+	switch v := m.Selector.(type) {
+	case *GetRealmRevisionRequest_Revision:
+		if v == nil {
+			err := GetRealmRevisionRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSelectorPresent = true
+
+		if m.GetRevision() <= 0 {
+			err := GetRealmRevisionRequestValidationError{
+				field:  "Revision",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *GetRealmRevisionRequest_AsOf:
+		if v == nil {
+			err := GetRealmRevisionRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSelectorPresent = true
+
+		if all {
+			switch v := interface{}(m.GetAsOf()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRealmRevisionRequestValidationError{
+						field:  "AsOf",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRealmRevisionRequestValidationError{
+						field:  "AsOf",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRealmRevisionRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofSelectorPresent {
+		err := GetRealmRevisionRequestValidationError{
+			field:  "Selector",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRealmRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRealmRevisionRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRealmRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRealmRevisionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRealmRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRealmRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRealmRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetRealmRevisionRequestValidationError is the validation error returned by
+// GetRealmRevisionRequest.Validate if the designated constraints aren't met.
+type GetRealmRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRealmRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRealmRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRealmRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRealmRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRealmRevisionRequestValidationError) ErrorName() string {
+	return "GetRealmRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRealmRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRealmRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRealmRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRealmRevisionRequestValidationError{}
+
+// Validate checks the field values on GetRealmRevisionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRealmRevisionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRealmRevisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRealmRevisionResponseMultiError, or nil if none found.
+func (m *GetRealmRevisionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRealmRevisionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRealmRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRealmRevisionResponseValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRealmRevisionResponseValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRealmRevisionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRealmRevisionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRealmRevisionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRealmRevisionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRealmRevisionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRealmRevisionResponseMultiError) AllErrors() []error { return m }
+
+// GetRealmRevisionResponseValidationError is the validation error returned by
+// GetRealmRevisionResponse.Validate if the designated constraints aren't met.
+type GetRealmRevisionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRealmRevisionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRealmRevisionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRealmRevisionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRealmRevisionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRealmRevisionResponseValidationError) ErrorName() string {
+	return "GetRealmRevisionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRealmRevisionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRealmRevisionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRealmRevisionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRealmRevisionResponseValidationError{}
+
+// Validate checks the field values on RollbackRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackRealmRequestMultiError, or nil if none found.
+func (m *RollbackRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RollbackRealmRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := RollbackRealmRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := RollbackRealmRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return RollbackRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RollbackRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RollbackRealmRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackRealmRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackRealmRequestMultiError) AllErrors() []error { return m }
+
+// RollbackRealmRequestValidationError is the validation error returned by
+// RollbackRealmRequest.Validate if the designated constraints aren't met.
+type RollbackRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackRealmRequestValidationError) ErrorName() string {
+	return "RollbackRealmRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackRealmRequestValidationError{}
+
+// Validate checks the field values on RollbackRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackRealmResponseMultiError, or nil if none found.
+func (m *RollbackRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackRealmResponseValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackRealmResponseValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RollbackRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackRealmResponseMultiError is an error wrapping multiple validation
+// errors returned by RollbackRealmResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackRealmResponseMultiError) AllErrors() []error { return m }
+
+// RollbackRealmResponseValidationError is the validation error returned by
+// RollbackRealmResponse.Validate if the designated constraints aren't met.
+type RollbackRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackRealmResponseValidationError) ErrorName() string {
+	return "RollbackRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackRealmResponseValidationError{}
+
+// Validate checks the field values on RealmVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RealmVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RealmVersionMultiError, or
+// nil if none found.
+func (m *RealmVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Version.(type) {
+	case *RealmVersion_Status:
+		if v == nil {
+			err := RealmVersionValidationError{
+				field:  "Version",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := _RealmVersion_Status_InLookup[m.GetStatus()]; !ok {
+			err := RealmVersionValidationError{
+				field:  "Status",
+				reason: "value must be in list [1 2]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *RealmVersion_Revision:
+		if v == nil {
+			err := RealmVersionValidationError{
+				field:  "Version",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if m.GetRevision() <= 0 {
+			err := RealmVersionValidationError{
+				field:  "Revision",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return RealmVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmVersionMultiError is an error wrapping multiple validation errors
+// returned by RealmVersion.ValidateAll() if the designated constraints aren't met.
+type RealmVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmVersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmVersionMultiError) AllErrors() []error { return m }
+
+// RealmVersionValidationError is the validation error returned by
+// RealmVersion.Validate if the designated constraints aren't met.
+type RealmVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmVersionValidationError) ErrorName() string { return "RealmVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RealmVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmVersionValidationError{}
+
+var _RealmVersion_Status_InLookup = map[EnumStatus]struct{}{
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on RealmFieldChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RealmFieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RealmFieldChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RealmFieldChangeMultiError, or nil if none found.
+func (m *RealmFieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RealmFieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for OldValue
+
+	// no validation rules for NewValue
+
+	if len(errors) > 0 {
+		return RealmFieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RealmFieldChangeMultiError is an error wrapping multiple validation errors
+// returned by RealmFieldChange.ValidateAll() if the designated constraints
+// aren't met.
+type RealmFieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RealmFieldChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RealmFieldChangeMultiError) AllErrors() []error { return m }
+
+// RealmFieldChangeValidationError is the validation error returned by
+// RealmFieldChange.Validate if the designated constraints aren't met.
+type RealmFieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RealmFieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RealmFieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RealmFieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RealmFieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RealmFieldChangeValidationError) ErrorName() string { return "RealmFieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RealmFieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRealmFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RealmFieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RealmFieldChangeValidationError{}
+
+// Validate checks the field values on DiffRealmRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiffRealmRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRealmRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRealmRequestMultiError, or nil if none found.
+func (m *DiffRealmRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRealmRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DiffRealmRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffRealmRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffRealmRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffRealmRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffRealmRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffRealmRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffRealmRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiffRealmRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DiffRealmRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DiffRealmRequestMultiError is an error wrapping multiple validation errors
+// returned by DiffRealmRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DiffRealmRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRealmRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRealmRequestMultiError) AllErrors() []error { return m }
+
+// DiffRealmRequestValidationError is the validation error returned by
+// DiffRealmRequest.Validate if the designated constraints aren't met.
+type DiffRealmRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRealmRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRealmRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRealmRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRealmRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRealmRequestValidationError) ErrorName() string { return "DiffRealmRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffRealmRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRealmRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRealmRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRealmRequestValidationError{}
+
+// Validate checks the field values on DiffRealmResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DiffRealmResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRealmResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRealmResponseMultiError, or nil if none found.
+func (m *DiffRealmResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRealmResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffRealmResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffRealmResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffRealmResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiffRealmResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffRealmResponseMultiError is an error wrapping multiple validation errors
+// returned by DiffRealmResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DiffRealmResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRealmResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRealmResponseMultiError) AllErrors() []error { return m }
+
+// DiffRealmResponseValidationError is the validation error returned by
+// DiffRealmResponse.Validate if the designated constraints aren't met.
+type DiffRealmResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRealmResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRealmResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRealmResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRealmResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRealmResponseValidationError) ErrorName() string {
+	return "DiffRealmResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffRealmResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRealmResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRealmResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRealmResponseValidationError{}
+
+// Validate checks the field values on ScheduleReleaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleReleaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleReleaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleReleaseRequestMultiError, or nil if none found.
+func (m *ScheduleReleaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleReleaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ScheduleReleaseRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReleaseAt() == nil {
+		err := ScheduleReleaseRequestValidationError{
+			field:  "ReleaseAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return ScheduleReleaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ScheduleReleaseRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ScheduleReleaseRequestMultiError is an error wrapping multiple validation
+// errors returned by ScheduleReleaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleReleaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleReleaseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleReleaseRequestMultiError) AllErrors() []error { return m }
+
+// ScheduleReleaseRequestValidationError is the validation error returned by
+// ScheduleReleaseRequest.Validate if the designated constraints aren't met.
+type ScheduleReleaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleReleaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleReleaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleReleaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleReleaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleReleaseRequestValidationError) ErrorName() string {
+	return "ScheduleReleaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleReleaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleReleaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleReleaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleReleaseRequestValidationError{}
+
+// Validate checks the field values on ScheduleReleaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleReleaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleReleaseResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleReleaseResponseMultiError, or nil if none found.
+func (m *ScheduleReleaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleReleaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReleaseSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleReleaseResponseValidationError{
+					field:  "ReleaseSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleReleaseResponseValidationError{
+					field:  "ReleaseSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleReleaseResponseValidationError{
+				field:  "ReleaseSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduleReleaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleReleaseResponseMultiError is an error wrapping multiple validation
+// errors returned by ScheduleReleaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleReleaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleReleaseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleReleaseResponseMultiError) AllErrors() []error { return m }
+
+// ScheduleReleaseResponseValidationError is the validation error returned by
+// ScheduleReleaseResponse.Validate if the designated constraints aren't met.
+type ScheduleReleaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleReleaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleReleaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleReleaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleReleaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleReleaseResponseValidationError) ErrorName() string {
+	return "ScheduleReleaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleReleaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleReleaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleReleaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleReleaseResponseValidationError{}
+
+// Validate checks the field values on CancelScheduledReleaseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduledReleaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledReleaseRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelScheduledReleaseRequestMultiError, or nil if none found.
+func (m *CancelScheduledReleaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledReleaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = CancelScheduledReleaseRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return CancelScheduledReleaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CancelScheduledReleaseRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CancelScheduledReleaseRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelScheduledReleaseRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CancelScheduledReleaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledReleaseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledReleaseRequestMultiError) AllErrors() []error { return m }
+
+// CancelScheduledReleaseRequestValidationError is the validation error
+// returned by CancelScheduledReleaseRequest.Validate if the designated
+// constraints aren't met.
+type CancelScheduledReleaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledReleaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledReleaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledReleaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledReleaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledReleaseRequestValidationError) ErrorName() string {
+	return "CancelScheduledReleaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledReleaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledReleaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledReleaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledReleaseRequestValidationError{}
+
+// Validate checks the field values on CancelScheduledReleaseResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduledReleaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledReleaseResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelScheduledReleaseResponseMultiError, or nil if none found.
+func (m *CancelScheduledReleaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledReleaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelScheduledReleaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelScheduledReleaseResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelScheduledReleaseResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CancelScheduledReleaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledReleaseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledReleaseResponseMultiError) AllErrors() []error { return m }
+
+// CancelScheduledReleaseResponseValidationError is the validation error
+// returned by CancelScheduledReleaseResponse.Validate if the designated
+// constraints aren't met.
+type CancelScheduledReleaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledReleaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledReleaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledReleaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledReleaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledReleaseResponseValidationError) ErrorName() string {
+	return "CancelScheduledReleaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledReleaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledReleaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledReleaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledReleaseResponseValidationError{}
+
+// Validate checks the field values on ReleaseApprovalDecision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseApprovalDecision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseApprovalDecision with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseApprovalDecisionMultiError, or nil if none found.
+func (m *ReleaseApprovalDecision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseApprovalDecision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reviewer
+
+	// no validation rules for Decision
+
+	// no validation rules for Comment
+
+	if all {
+		switch v := interface{}(m.GetDecidedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReleaseApprovalDecisionValidationError{
+					field:  "DecidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReleaseApprovalDecisionValidationError{
+					field:  "DecidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecidedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReleaseApprovalDecisionValidationError{
+				field:  "DecidedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReleaseApprovalDecisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseApprovalDecisionMultiError is an error wrapping multiple validation
+// errors returned by ReleaseApprovalDecision.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseApprovalDecisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseApprovalDecisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseApprovalDecisionMultiError) AllErrors() []error { return m }
+
+// ReleaseApprovalDecisionValidationError is the validation error returned by
+// ReleaseApprovalDecision.Validate if the designated constraints aren't met.
+type ReleaseApprovalDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseApprovalDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseApprovalDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseApprovalDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseApprovalDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseApprovalDecisionValidationError) ErrorName() string {
+	return "ReleaseApprovalDecisionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseApprovalDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseApprovalDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseApprovalDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseApprovalDecisionValidationError{}
+
+// Validate checks the field values on ReleaseApproval with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseApproval) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseApproval with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseApprovalMultiError, or nil if none found.
+func (m *ReleaseApproval) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseApproval) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRealm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReleaseApprovalValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReleaseApprovalValidationError{
+					field:  "Realm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReleaseApprovalValidationError{
+				field:  "Realm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequestedBy
+
+	if all {
+		switch v := interface{}(m.GetRequestedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReleaseApprovalValidationError{
+					field:  "RequestedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReleaseApprovalValidationError{
+					field:  "RequestedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequestedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReleaseApprovalValidationError{
+				field:  "RequestedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDecisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReleaseApprovalValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReleaseApprovalValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReleaseApprovalValidationError{
+					field:  fmt.Sprintf("Decisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReleaseApprovalMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseApprovalMultiError is an error wrapping multiple validation errors
+// returned by ReleaseApproval.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseApprovalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseApprovalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseApprovalMultiError) AllErrors() []error { return m }
+
+// ReleaseApprovalValidationError is the validation error returned by
+// ReleaseApproval.Validate if the designated constraints aren't met.
+type ReleaseApprovalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseApprovalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseApprovalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseApprovalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseApprovalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseApprovalValidationError) ErrorName() string { return "ReleaseApprovalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReleaseApprovalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseApproval.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseApprovalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseApprovalValidationError{}
+
+// Validate checks the field values on RequestReleaseApprovalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestReleaseApprovalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestReleaseApprovalRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestReleaseApprovalRequestMultiError, or nil if none found.
+func (m *RequestReleaseApprovalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestReleaseApprovalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RequestReleaseApprovalRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return RequestReleaseApprovalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RequestReleaseApprovalRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RequestReleaseApprovalRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestReleaseApprovalRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RequestReleaseApprovalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestReleaseApprovalRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestReleaseApprovalRequestMultiError) AllErrors() []error { return m }
+
+// RequestReleaseApprovalRequestValidationError is the validation error
+// returned by RequestReleaseApprovalRequest.Validate if the designated
+// constraints aren't met.
+type RequestReleaseApprovalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestReleaseApprovalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestReleaseApprovalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestReleaseApprovalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestReleaseApprovalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestReleaseApprovalRequestValidationError) ErrorName() string {
+	return "RequestReleaseApprovalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestReleaseApprovalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestReleaseApprovalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestReleaseApprovalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestReleaseApprovalRequestValidationError{}
+
+// Validate checks the field values on RequestReleaseApprovalResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestReleaseApprovalResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestReleaseApprovalResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RequestReleaseApprovalResponseMultiError, or nil if none found.
+func (m *RequestReleaseApprovalResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestReleaseApprovalResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApproval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestReleaseApprovalResponseValidationError{
+					field:  "Approval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestReleaseApprovalResponseValidationError{
+					field:  "Approval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApproval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestReleaseApprovalResponseValidationError{
+				field:  "Approval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestReleaseApprovalResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestReleaseApprovalResponseMultiError is an error wrapping multiple
+// validation errors returned by RequestReleaseApprovalResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RequestReleaseApprovalResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestReleaseApprovalResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestReleaseApprovalResponseMultiError) AllErrors() []error { return m }
+
+// RequestReleaseApprovalResponseValidationError is the validation error
+// returned by RequestReleaseApprovalResponse.Validate if the designated
+// constraints aren't met.
+type RequestReleaseApprovalResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestReleaseApprovalResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestReleaseApprovalResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestReleaseApprovalResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestReleaseApprovalResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestReleaseApprovalResponseValidationError) ErrorName() string {
+	return "RequestReleaseApprovalResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestReleaseApprovalResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestReleaseApprovalResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestReleaseApprovalResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestReleaseApprovalResponseValidationError{}
+
+// Validate checks the field values on ApproveReleaseRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReleaseRealmRequest) Validate() error {
+func (m *ApproveReleaseRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReleaseRealmRequest with the rules
+// ValidateAll checks the field values on ApproveReleaseRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReleaseRealmRequestMultiError, or nil if none found.
-func (m *ReleaseRealmRequest) ValidateAll() error {
+// ApproveReleaseRequestMultiError, or nil if none found.
+func (m *ApproveReleaseRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReleaseRealmRequest) validate(all bool) error {
+func (m *ApproveReleaseRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -749,7 +10948,7 @@ func (m *ReleaseRealmRequest) validate(all bool) error {
 	var errors []error
 
 	if err := m._validateUuid(m.GetId()); err != nil {
-		err = ReleaseRealmRequestValidationError{
+		err = ApproveReleaseRequestValidationError{
 			field:  "Id",
 			reason: "value must be a valid UUID",
 			cause:  err,
@@ -760,14 +10959,29 @@ func (m *ReleaseRealmRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Etag
+
+	if utf8.RuneCountInString(m.GetComment()) > 500 {
+		err := ApproveReleaseRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ValidateOnly
+
 	if len(errors) > 0 {
-		return ReleaseRealmRequestMultiError(errors)
+		return ApproveReleaseRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *ReleaseRealmRequest) _validateUuid(uuid string) error {
+func (m *ApproveReleaseRequest) _validateUuid(uuid string) error {
 	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -775,13 +10989,13 @@ func (m *ReleaseRealmRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// ReleaseRealmRequestMultiError is an error wrapping multiple validation
-// errors returned by ReleaseRealmRequest.ValidateAll() if the designated
+// ApproveReleaseRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveReleaseRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ReleaseRealmRequestMultiError []error
+type ApproveReleaseRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReleaseRealmRequestMultiError) Error() string {
+func (m ApproveReleaseRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -790,11 +11004,11 @@ func (m ReleaseRealmRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReleaseRealmRequestMultiError) AllErrors() []error { return m }
+func (m ApproveReleaseRequestMultiError) AllErrors() []error { return m }
 
-// ReleaseRealmRequestValidationError is the validation error returned by
-// ReleaseRealmRequest.Validate if the designated constraints aren't met.
-type ReleaseRealmRequestValidationError struct {
+// ApproveReleaseRequestValidationError is the validation error returned by
+// ApproveReleaseRequest.Validate if the designated constraints aren't met.
+type ApproveReleaseRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -802,24 +11016,24 @@ type ReleaseRealmRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReleaseRealmRequestValidationError) Field() string { return e.field }
+func (e ApproveReleaseRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReleaseRealmRequestValidationError) Reason() string { return e.reason }
+func (e ApproveReleaseRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReleaseRealmRequestValidationError) Cause() error { return e.cause }
+func (e ApproveReleaseRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReleaseRealmRequestValidationError) Key() bool { return e.key }
+func (e ApproveReleaseRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReleaseRealmRequestValidationError) ErrorName() string {
-	return "ReleaseRealmRequestValidationError"
+func (e ApproveReleaseRequestValidationError) ErrorName() string {
+	return "ApproveReleaseRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReleaseRealmRequestValidationError) Error() string {
+func (e ApproveReleaseRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -831,14 +11045,14 @@ func (e ReleaseRealmRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReleaseRealmRequest.%s: %s%s",
+		"invalid %sApproveReleaseRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReleaseRealmRequestValidationError{}
+var _ error = ApproveReleaseRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -846,24 +11060,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReleaseRealmRequestValidationError{}
+} = ApproveReleaseRequestValidationError{}
 
-// Validate checks the field values on ReleaseRealmResponse with the rules
+// Validate checks the field values on ApproveReleaseResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReleaseRealmResponse) Validate() error {
+func (m *ApproveReleaseResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReleaseRealmResponse with the rules
+// ValidateAll checks the field values on ApproveReleaseResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReleaseRealmResponseMultiError, or nil if none found.
-func (m *ReleaseRealmResponse) ValidateAll() error {
+// ApproveReleaseResponseMultiError, or nil if none found.
+func (m *ApproveReleaseResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReleaseRealmResponse) validate(all bool) error {
+func (m *ApproveReleaseResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -871,28 +11085,28 @@ func (m *ReleaseRealmResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetRealm()).(type) {
+		switch v := interface{}(m.GetApproval()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReleaseRealmResponseValidationError{
-					field:  "Realm",
+				errors = append(errors, ApproveReleaseResponseValidationError{
+					field:  "Approval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReleaseRealmResponseValidationError{
-					field:  "Realm",
+				errors = append(errors, ApproveReleaseResponseValidationError{
+					field:  "Approval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetApproval()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReleaseRealmResponseValidationError{
-				field:  "Realm",
+			return ApproveReleaseResponseValidationError{
+				field:  "Approval",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -900,19 +11114,19 @@ func (m *ReleaseRealmResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ReleaseRealmResponseMultiError(errors)
+		return ApproveReleaseResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReleaseRealmResponseMultiError is an error wrapping multiple validation
-// errors returned by ReleaseRealmResponse.ValidateAll() if the designated
+// ApproveReleaseResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveReleaseResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ReleaseRealmResponseMultiError []error
+type ApproveReleaseResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReleaseRealmResponseMultiError) Error() string {
+func (m ApproveReleaseResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -921,11 +11135,11 @@ func (m ReleaseRealmResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReleaseRealmResponseMultiError) AllErrors() []error { return m }
+func (m ApproveReleaseResponseMultiError) AllErrors() []error { return m }
 
-// ReleaseRealmResponseValidationError is the validation error returned by
-// ReleaseRealmResponse.Validate if the designated constraints aren't met.
-type ReleaseRealmResponseValidationError struct {
+// ApproveReleaseResponseValidationError is the validation error returned by
+// ApproveReleaseResponse.Validate if the designated constraints aren't met.
+type ApproveReleaseResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -933,24 +11147,24 @@ type ReleaseRealmResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReleaseRealmResponseValidationError) Field() string { return e.field }
+func (e ApproveReleaseResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReleaseRealmResponseValidationError) Reason() string { return e.reason }
+func (e ApproveReleaseResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReleaseRealmResponseValidationError) Cause() error { return e.cause }
+func (e ApproveReleaseResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReleaseRealmResponseValidationError) Key() bool { return e.key }
+func (e ApproveReleaseResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReleaseRealmResponseValidationError) ErrorName() string {
-	return "ReleaseRealmResponseValidationError"
+func (e ApproveReleaseResponseValidationError) ErrorName() string {
+	return "ApproveReleaseResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReleaseRealmResponseValidationError) Error() string {
+func (e ApproveReleaseResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -962,14 +11176,14 @@ func (e ReleaseRealmResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReleaseRealmResponse.%s: %s%s",
+		"invalid %sApproveReleaseResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReleaseRealmResponseValidationError{}
+var _ error = ApproveReleaseResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -977,73 +11191,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReleaseRealmResponseValidationError{}
+} = ApproveReleaseResponseValidationError{}
 
-// Validate checks the field values on UpdateRealmRequest with the rules
+// Validate checks the field values on RejectReleaseRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRealmRequest) Validate() error {
+func (m *RejectReleaseRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRealmRequest with the rules
+// ValidateAll checks the field values on RejectReleaseRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRealmRequestMultiError, or nil if none found.
-func (m *UpdateRealmRequest) ValidateAll() error {
+// RejectReleaseRequestMultiError, or nil if none found.
+func (m *RejectReleaseRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRealmRequest) validate(all bool) error {
+func (m *RejectReleaseRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRealm()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateRealmRequestValidationError{
-					field:  "Realm",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateRealmRequestValidationError{
-					field:  "Realm",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RejectReleaseRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
-	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateRealmRequestValidationError{
-				field:  "Realm",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Etag
+
+	if l := utf8.RuneCountInString(m.GetComment()); l < 1 || l > 500 {
+		err := RejectReleaseRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for ValidateOnly
+
 	if len(errors) > 0 {
-		return UpdateRealmRequestMultiError(errors)
+		return RejectReleaseRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRealmRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateRealmRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateRealmRequestMultiError []error
+func (m *RejectReleaseRequest) _validateUuid(uuid string) error {
+	if matched := _realm_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RejectReleaseRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectReleaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejectReleaseRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRealmRequestMultiError) Error() string {
+func (m RejectReleaseRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1052,11 +11272,11 @@ func (m UpdateRealmRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRealmRequestMultiError) AllErrors() []error { return m }
+func (m RejectReleaseRequestMultiError) AllErrors() []error { return m }
 
-// UpdateRealmRequestValidationError is the validation error returned by
-// UpdateRealmRequest.Validate if the designated constraints aren't met.
-type UpdateRealmRequestValidationError struct {
+// RejectReleaseRequestValidationError is the validation error returned by
+// RejectReleaseRequest.Validate if the designated constraints aren't met.
+type RejectReleaseRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1064,24 +11284,24 @@ type UpdateRealmRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRealmRequestValidationError) Field() string { return e.field }
+func (e RejectReleaseRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRealmRequestValidationError) Reason() string { return e.reason }
+func (e RejectReleaseRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRealmRequestValidationError) Cause() error { return e.cause }
+func (e RejectReleaseRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRealmRequestValidationError) Key() bool { return e.key }
+func (e RejectReleaseRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRealmRequestValidationError) ErrorName() string {
-	return "UpdateRealmRequestValidationError"
+func (e RejectReleaseRequestValidationError) ErrorName() string {
+	return "RejectReleaseRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRealmRequestValidationError) Error() string {
+func (e RejectReleaseRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1093,14 +11313,14 @@ func (e UpdateRealmRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRealmRequest.%s: %s%s",
+		"invalid %sRejectReleaseRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRealmRequestValidationError{}
+var _ error = RejectReleaseRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1108,24 +11328,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRealmRequestValidationError{}
+} = RejectReleaseRequestValidationError{}
 
-// Validate checks the field values on UpdateRealmResponse with the rules
+// Validate checks the field values on RejectReleaseResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRealmResponse) Validate() error {
+func (m *RejectReleaseResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRealmResponse with the rules
+// ValidateAll checks the field values on RejectReleaseResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRealmResponseMultiError, or nil if none found.
-func (m *UpdateRealmResponse) ValidateAll() error {
+// RejectReleaseResponseMultiError, or nil if none found.
+func (m *RejectReleaseResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRealmResponse) validate(all bool) error {
+func (m *RejectReleaseResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1133,28 +11353,28 @@ func (m *UpdateRealmResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetRealm()).(type) {
+		switch v := interface{}(m.GetApproval()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateRealmResponseValidationError{
-					field:  "Realm",
+				errors = append(errors, RejectReleaseResponseValidationError{
+					field:  "Approval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateRealmResponseValidationError{
-					field:  "Realm",
+				errors = append(errors, RejectReleaseResponseValidationError{
+					field:  "Approval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRealm()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetApproval()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateRealmResponseValidationError{
-				field:  "Realm",
+			return RejectReleaseResponseValidationError{
+				field:  "Approval",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1162,19 +11382,19 @@ func (m *UpdateRealmResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateRealmResponseMultiError(errors)
+		return RejectReleaseResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRealmResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateRealmResponse.ValidateAll() if the designated
+// RejectReleaseResponseMultiError is an error wrapping multiple validation
+// errors returned by RejectReleaseResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateRealmResponseMultiError []error
+type RejectReleaseResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRealmResponseMultiError) Error() string {
+func (m RejectReleaseResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1183,11 +11403,11 @@ func (m UpdateRealmResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRealmResponseMultiError) AllErrors() []error { return m }
+func (m RejectReleaseResponseMultiError) AllErrors() []error { return m }
 
-// UpdateRealmResponseValidationError is the validation error returned by
-// UpdateRealmResponse.Validate if the designated constraints aren't met.
-type UpdateRealmResponseValidationError struct {
+// RejectReleaseResponseValidationError is the validation error returned by
+// RejectReleaseResponse.Validate if the designated constraints aren't met.
+type RejectReleaseResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1195,24 +11415,24 @@ type UpdateRealmResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRealmResponseValidationError) Field() string { return e.field }
+func (e RejectReleaseResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRealmResponseValidationError) Reason() string { return e.reason }
+func (e RejectReleaseResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRealmResponseValidationError) Cause() error { return e.cause }
+func (e RejectReleaseResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRealmResponseValidationError) Key() bool { return e.key }
+func (e RejectReleaseResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRealmResponseValidationError) ErrorName() string {
-	return "UpdateRealmResponseValidationError"
+func (e RejectReleaseResponseValidationError) ErrorName() string {
+	return "RejectReleaseResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRealmResponseValidationError) Error() string {
+func (e RejectReleaseResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1224,14 +11444,14 @@ func (e UpdateRealmResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRealmResponse.%s: %s%s",
+		"invalid %sRejectReleaseResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRealmResponseValidationError{}
+var _ error = RejectReleaseResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1239,4 +11459,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRealmResponseValidationError{}
+} = RejectReleaseResponseValidationError{}
@@ -26,58 +26,393 @@ var file_realm_mgr_v1_service_proto_rawDesc = []byte{
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x72, 0x65,
 	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x72, 0x65, 0x61, 0x6c,
 	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xe7, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1d, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
-	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
-	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
-	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x61,
-	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf4, 0x1b, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x4b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1d, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x23,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x42, 0x79, 0x53, 0x6c, 0x75,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x23, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x21, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x41, 0x6e, 0x63,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a,
+	0x0f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x6c, 0x75, 0x67,
+	0x12, 0x24, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x53, 0x6c, 0x75, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
 	0x57, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12,
 	0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
 	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
 	0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
-	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61,
-	0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x24, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x16, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x12, 0x2b, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x75, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x2b, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x23, 0x2e, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x26,
+	0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x59, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x73, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0c,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x0b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12,
+	0x20, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x21, 0x2e, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12,
+	0x22, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1e, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x61, 0x6c, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x27, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c,
+	0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x28, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x28, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65, 0x61, 0x6c,
 	0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x61, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1b,
+	0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f,
+	0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61,
+	0x6c, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x6c, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x2c, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1b,
 	0x5a, 0x19, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x72,
 	0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x6d, 0x67, 0x72, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x33,
 }
 
 var file_realm_mgr_v1_service_proto_goTypes = []interface{}{
-	(*GetRealmRequest)(nil),      // 0: realm_mgr.v1.GetRealmRequest
-	(*CreateRealmRequest)(nil),   // 1: realm_mgr.v1.CreateRealmRequest
-	(*ReleaseRealmRequest)(nil),  // 2: realm_mgr.v1.ReleaseRealmRequest
-	(*UpdateRealmRequest)(nil),   // 3: realm_mgr.v1.UpdateRealmRequest
-	(*GetRealmResponse)(nil),     // 4: realm_mgr.v1.GetRealmResponse
-	(*CreateRealmResponse)(nil),  // 5: realm_mgr.v1.CreateRealmResponse
-	(*ReleaseRealmResponse)(nil), // 6: realm_mgr.v1.ReleaseRealmResponse
-	(*UpdateRealmResponse)(nil),  // 7: realm_mgr.v1.UpdateRealmResponse
+	(*GetRealmRequest)(nil),                 // 0: realm_mgr.v1.GetRealmRequest
+	(*GetRealmBySlugRequest)(nil),           // 1: realm_mgr.v1.GetRealmBySlugRequest
+	(*BatchGetRealmsRequest)(nil),           // 2: realm_mgr.v1.BatchGetRealmsRequest
+	(*ListRealmsRequest)(nil),               // 3: realm_mgr.v1.ListRealmsRequest
+	(*SearchRealmsRequest)(nil),             // 4: realm_mgr.v1.SearchRealmsRequest
+	(*ListChildRealmsRequest)(nil),          // 5: realm_mgr.v1.ListChildRealmsRequest
+	(*GetRealmAncestorsRequest)(nil),        // 6: realm_mgr.v1.GetRealmAncestorsRequest
+	(*GetRealmStatsRequest)(nil),            // 7: realm_mgr.v1.GetRealmStatsRequest
+	(*WatchRealmsRequest)(nil),              // 8: realm_mgr.v1.WatchRealmsRequest
+	(*CreateRealmRequest)(nil),              // 9: realm_mgr.v1.CreateRealmRequest
+	(*CloneRealmRequest)(nil),               // 10: realm_mgr.v1.CloneRealmRequest
+	(*RenameRealmSlugRequest)(nil),          // 11: realm_mgr.v1.RenameRealmSlugRequest
+	(*ReleaseRealmRequest)(nil),             // 12: realm_mgr.v1.ReleaseRealmRequest
+	(*ScheduleReleaseRequest)(nil),          // 13: realm_mgr.v1.ScheduleReleaseRequest
+	(*CancelScheduledReleaseRequest)(nil),   // 14: realm_mgr.v1.CancelScheduledReleaseRequest
+	(*RequestReleaseApprovalRequest)(nil),   // 15: realm_mgr.v1.RequestReleaseApprovalRequest
+	(*ApproveReleaseRequest)(nil),           // 16: realm_mgr.v1.ApproveReleaseRequest
+	(*RejectReleaseRequest)(nil),            // 17: realm_mgr.v1.RejectReleaseRequest
+	(*UpdateRealmRequest)(nil),              // 18: realm_mgr.v1.UpdateRealmRequest
+	(*BatchMutateRealmsRequest)(nil),        // 19: realm_mgr.v1.BatchMutateRealmsRequest
+	(*ExportRealmsRequest)(nil),             // 20: realm_mgr.v1.ExportRealmsRequest
+	(*ImportRealmsRequest)(nil),             // 21: realm_mgr.v1.ImportRealmsRequest
+	(*DisableRealmRequest)(nil),             // 22: realm_mgr.v1.DisableRealmRequest
+	(*EnableRealmRequest)(nil),              // 23: realm_mgr.v1.EnableRealmRequest
+	(*DeleteRealmRequest)(nil),              // 24: realm_mgr.v1.DeleteRealmRequest
+	(*RestoreRealmRequest)(nil),             // 25: realm_mgr.v1.RestoreRealmRequest
+	(*DiscardDraftRequest)(nil),             // 26: realm_mgr.v1.DiscardDraftRequest
+	(*RollbackRealmRequest)(nil),            // 27: realm_mgr.v1.RollbackRealmRequest
+	(*DiffRealmRequest)(nil),                // 28: realm_mgr.v1.DiffRealmRequest
+	(*ListRealmRevisionsRequest)(nil),       // 29: realm_mgr.v1.ListRealmRevisionsRequest
+	(*GetRealmRevisionRequest)(nil),         // 30: realm_mgr.v1.GetRealmRevisionRequest
+	(*CreateRealmTemplateRequest)(nil),      // 31: realm_mgr.v1.CreateRealmTemplateRequest
+	(*GetRealmTemplateRequest)(nil),         // 32: realm_mgr.v1.GetRealmTemplateRequest
+	(*ListRealmTemplatesRequest)(nil),       // 33: realm_mgr.v1.ListRealmTemplatesRequest
+	(*UpdateRealmTemplateRequest)(nil),      // 34: realm_mgr.v1.UpdateRealmTemplateRequest
+	(*DeleteRealmTemplateRequest)(nil),      // 35: realm_mgr.v1.DeleteRealmTemplateRequest
+	(*CreateRealmFromTemplateRequest)(nil),  // 36: realm_mgr.v1.CreateRealmFromTemplateRequest
+	(*GetRealmResponse)(nil),                // 37: realm_mgr.v1.GetRealmResponse
+	(*GetRealmBySlugResponse)(nil),          // 38: realm_mgr.v1.GetRealmBySlugResponse
+	(*BatchGetRealmsResponse)(nil),          // 39: realm_mgr.v1.BatchGetRealmsResponse
+	(*ListRealmsResponse)(nil),              // 40: realm_mgr.v1.ListRealmsResponse
+	(*SearchRealmsResponse)(nil),            // 41: realm_mgr.v1.SearchRealmsResponse
+	(*ListChildRealmsResponse)(nil),         // 42: realm_mgr.v1.ListChildRealmsResponse
+	(*GetRealmAncestorsResponse)(nil),       // 43: realm_mgr.v1.GetRealmAncestorsResponse
+	(*GetRealmStatsResponse)(nil),           // 44: realm_mgr.v1.GetRealmStatsResponse
+	(*WatchRealmsResponse)(nil),             // 45: realm_mgr.v1.WatchRealmsResponse
+	(*CreateRealmResponse)(nil),             // 46: realm_mgr.v1.CreateRealmResponse
+	(*CloneRealmResponse)(nil),              // 47: realm_mgr.v1.CloneRealmResponse
+	(*RenameRealmSlugResponse)(nil),         // 48: realm_mgr.v1.RenameRealmSlugResponse
+	(*ReleaseRealmResponse)(nil),            // 49: realm_mgr.v1.ReleaseRealmResponse
+	(*ScheduleReleaseResponse)(nil),         // 50: realm_mgr.v1.ScheduleReleaseResponse
+	(*CancelScheduledReleaseResponse)(nil),  // 51: realm_mgr.v1.CancelScheduledReleaseResponse
+	(*RequestReleaseApprovalResponse)(nil),  // 52: realm_mgr.v1.RequestReleaseApprovalResponse
+	(*ApproveReleaseResponse)(nil),          // 53: realm_mgr.v1.ApproveReleaseResponse
+	(*RejectReleaseResponse)(nil),           // 54: realm_mgr.v1.RejectReleaseResponse
+	(*UpdateRealmResponse)(nil),             // 55: realm_mgr.v1.UpdateRealmResponse
+	(*BatchMutateRealmsResponse)(nil),       // 56: realm_mgr.v1.BatchMutateRealmsResponse
+	(*ExportRealmsResponse)(nil),            // 57: realm_mgr.v1.ExportRealmsResponse
+	(*ImportRealmsResponse)(nil),            // 58: realm_mgr.v1.ImportRealmsResponse
+	(*DisableRealmResponse)(nil),            // 59: realm_mgr.v1.DisableRealmResponse
+	(*EnableRealmResponse)(nil),             // 60: realm_mgr.v1.EnableRealmResponse
+	(*DeleteRealmResponse)(nil),             // 61: realm_mgr.v1.DeleteRealmResponse
+	(*RestoreRealmResponse)(nil),            // 62: realm_mgr.v1.RestoreRealmResponse
+	(*DiscardDraftResponse)(nil),            // 63: realm_mgr.v1.DiscardDraftResponse
+	(*RollbackRealmResponse)(nil),           // 64: realm_mgr.v1.RollbackRealmResponse
+	(*DiffRealmResponse)(nil),               // 65: realm_mgr.v1.DiffRealmResponse
+	(*ListRealmRevisionsResponse)(nil),      // 66: realm_mgr.v1.ListRealmRevisionsResponse
+	(*GetRealmRevisionResponse)(nil),        // 67: realm_mgr.v1.GetRealmRevisionResponse
+	(*CreateRealmTemplateResponse)(nil),     // 68: realm_mgr.v1.CreateRealmTemplateResponse
+	(*GetRealmTemplateResponse)(nil),        // 69: realm_mgr.v1.GetRealmTemplateResponse
+	(*ListRealmTemplatesResponse)(nil),      // 70: realm_mgr.v1.ListRealmTemplatesResponse
+	(*UpdateRealmTemplateResponse)(nil),     // 71: realm_mgr.v1.UpdateRealmTemplateResponse
+	(*DeleteRealmTemplateResponse)(nil),     // 72: realm_mgr.v1.DeleteRealmTemplateResponse
+	(*CreateRealmFromTemplateResponse)(nil), // 73: realm_mgr.v1.CreateRealmFromTemplateResponse
 }
 var file_realm_mgr_v1_service_proto_depIdxs = []int32{
-	0, // 0: realm_mgr.v1.RealmManagerService.GetRealm:input_type -> realm_mgr.v1.GetRealmRequest
-	1, // 1: realm_mgr.v1.RealmManagerService.CreateRealm:input_type -> realm_mgr.v1.CreateRealmRequest
-	2, // 2: realm_mgr.v1.RealmManagerService.ReleaseRealm:input_type -> realm_mgr.v1.ReleaseRealmRequest
-	3, // 3: realm_mgr.v1.RealmManagerService.UpdateRealm:input_type -> realm_mgr.v1.UpdateRealmRequest
-	4, // 4: realm_mgr.v1.RealmManagerService.GetRealm:output_type -> realm_mgr.v1.GetRealmResponse
-	5, // 5: realm_mgr.v1.RealmManagerService.CreateRealm:output_type -> realm_mgr.v1.CreateRealmResponse
-	6, // 6: realm_mgr.v1.RealmManagerService.ReleaseRealm:output_type -> realm_mgr.v1.ReleaseRealmResponse
-	7, // 7: realm_mgr.v1.RealmManagerService.UpdateRealm:output_type -> realm_mgr.v1.UpdateRealmResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: realm_mgr.v1.RealmManagerService.GetRealm:input_type -> realm_mgr.v1.GetRealmRequest
+	1,  // 1: realm_mgr.v1.RealmManagerService.GetRealmBySlug:input_type -> realm_mgr.v1.GetRealmBySlugRequest
+	2,  // 2: realm_mgr.v1.RealmManagerService.BatchGetRealms:input_type -> realm_mgr.v1.BatchGetRealmsRequest
+	3,  // 3: realm_mgr.v1.RealmManagerService.ListRealms:input_type -> realm_mgr.v1.ListRealmsRequest
+	4,  // 4: realm_mgr.v1.RealmManagerService.SearchRealms:input_type -> realm_mgr.v1.SearchRealmsRequest
+	5,  // 5: realm_mgr.v1.RealmManagerService.ListChildRealms:input_type -> realm_mgr.v1.ListChildRealmsRequest
+	6,  // 6: realm_mgr.v1.RealmManagerService.GetRealmAncestors:input_type -> realm_mgr.v1.GetRealmAncestorsRequest
+	7,  // 7: realm_mgr.v1.RealmManagerService.GetRealmStats:input_type -> realm_mgr.v1.GetRealmStatsRequest
+	8,  // 8: realm_mgr.v1.RealmManagerService.WatchRealms:input_type -> realm_mgr.v1.WatchRealmsRequest
+	9,  // 9: realm_mgr.v1.RealmManagerService.CreateRealm:input_type -> realm_mgr.v1.CreateRealmRequest
+	10, // 10: realm_mgr.v1.RealmManagerService.CloneRealm:input_type -> realm_mgr.v1.CloneRealmRequest
+	11, // 11: realm_mgr.v1.RealmManagerService.RenameRealmSlug:input_type -> realm_mgr.v1.RenameRealmSlugRequest
+	12, // 12: realm_mgr.v1.RealmManagerService.ReleaseRealm:input_type -> realm_mgr.v1.ReleaseRealmRequest
+	13, // 13: realm_mgr.v1.RealmManagerService.ScheduleRelease:input_type -> realm_mgr.v1.ScheduleReleaseRequest
+	14, // 14: realm_mgr.v1.RealmManagerService.CancelScheduledRelease:input_type -> realm_mgr.v1.CancelScheduledReleaseRequest
+	15, // 15: realm_mgr.v1.RealmManagerService.RequestReleaseApproval:input_type -> realm_mgr.v1.RequestReleaseApprovalRequest
+	16, // 16: realm_mgr.v1.RealmManagerService.ApproveRelease:input_type -> realm_mgr.v1.ApproveReleaseRequest
+	17, // 17: realm_mgr.v1.RealmManagerService.RejectRelease:input_type -> realm_mgr.v1.RejectReleaseRequest
+	18, // 18: realm_mgr.v1.RealmManagerService.UpdateRealm:input_type -> realm_mgr.v1.UpdateRealmRequest
+	19, // 19: realm_mgr.v1.RealmManagerService.BatchMutateRealms:input_type -> realm_mgr.v1.BatchMutateRealmsRequest
+	20, // 20: realm_mgr.v1.RealmManagerService.ExportRealms:input_type -> realm_mgr.v1.ExportRealmsRequest
+	21, // 21: realm_mgr.v1.RealmManagerService.ImportRealms:input_type -> realm_mgr.v1.ImportRealmsRequest
+	22, // 22: realm_mgr.v1.RealmManagerService.DisableRealm:input_type -> realm_mgr.v1.DisableRealmRequest
+	23, // 23: realm_mgr.v1.RealmManagerService.EnableRealm:input_type -> realm_mgr.v1.EnableRealmRequest
+	24, // 24: realm_mgr.v1.RealmManagerService.DeleteRealm:input_type -> realm_mgr.v1.DeleteRealmRequest
+	25, // 25: realm_mgr.v1.RealmManagerService.RestoreRealm:input_type -> realm_mgr.v1.RestoreRealmRequest
+	26, // 26: realm_mgr.v1.RealmManagerService.DiscardDraft:input_type -> realm_mgr.v1.DiscardDraftRequest
+	27, // 27: realm_mgr.v1.RealmManagerService.RollbackRealm:input_type -> realm_mgr.v1.RollbackRealmRequest
+	28, // 28: realm_mgr.v1.RealmManagerService.DiffRealm:input_type -> realm_mgr.v1.DiffRealmRequest
+	29, // 29: realm_mgr.v1.RealmManagerService.ListRealmRevisions:input_type -> realm_mgr.v1.ListRealmRevisionsRequest
+	30, // 30: realm_mgr.v1.RealmManagerService.GetRealmRevision:input_type -> realm_mgr.v1.GetRealmRevisionRequest
+	31, // 31: realm_mgr.v1.RealmManagerService.CreateRealmTemplate:input_type -> realm_mgr.v1.CreateRealmTemplateRequest
+	32, // 32: realm_mgr.v1.RealmManagerService.GetRealmTemplate:input_type -> realm_mgr.v1.GetRealmTemplateRequest
+	33, // 33: realm_mgr.v1.RealmManagerService.ListRealmTemplates:input_type -> realm_mgr.v1.ListRealmTemplatesRequest
+	34, // 34: realm_mgr.v1.RealmManagerService.UpdateRealmTemplate:input_type -> realm_mgr.v1.UpdateRealmTemplateRequest
+	35, // 35: realm_mgr.v1.RealmManagerService.DeleteRealmTemplate:input_type -> realm_mgr.v1.DeleteRealmTemplateRequest
+	36, // 36: realm_mgr.v1.RealmManagerService.CreateRealmFromTemplate:input_type -> realm_mgr.v1.CreateRealmFromTemplateRequest
+	37, // 37: realm_mgr.v1.RealmManagerService.GetRealm:output_type -> realm_mgr.v1.GetRealmResponse
+	38, // 38: realm_mgr.v1.RealmManagerService.GetRealmBySlug:output_type -> realm_mgr.v1.GetRealmBySlugResponse
+	39, // 39: realm_mgr.v1.RealmManagerService.BatchGetRealms:output_type -> realm_mgr.v1.BatchGetRealmsResponse
+	40, // 40: realm_mgr.v1.RealmManagerService.ListRealms:output_type -> realm_mgr.v1.ListRealmsResponse
+	41, // 41: realm_mgr.v1.RealmManagerService.SearchRealms:output_type -> realm_mgr.v1.SearchRealmsResponse
+	42, // 42: realm_mgr.v1.RealmManagerService.ListChildRealms:output_type -> realm_mgr.v1.ListChildRealmsResponse
+	43, // 43: realm_mgr.v1.RealmManagerService.GetRealmAncestors:output_type -> realm_mgr.v1.GetRealmAncestorsResponse
+	44, // 44: realm_mgr.v1.RealmManagerService.GetRealmStats:output_type -> realm_mgr.v1.GetRealmStatsResponse
+	45, // 45: realm_mgr.v1.RealmManagerService.WatchRealms:output_type -> realm_mgr.v1.WatchRealmsResponse
+	46, // 46: realm_mgr.v1.RealmManagerService.CreateRealm:output_type -> realm_mgr.v1.CreateRealmResponse
+	47, // 47: realm_mgr.v1.RealmManagerService.CloneRealm:output_type -> realm_mgr.v1.CloneRealmResponse
+	48, // 48: realm_mgr.v1.RealmManagerService.RenameRealmSlug:output_type -> realm_mgr.v1.RenameRealmSlugResponse
+	49, // 49: realm_mgr.v1.RealmManagerService.ReleaseRealm:output_type -> realm_mgr.v1.ReleaseRealmResponse
+	50, // 50: realm_mgr.v1.RealmManagerService.ScheduleRelease:output_type -> realm_mgr.v1.ScheduleReleaseResponse
+	51, // 51: realm_mgr.v1.RealmManagerService.CancelScheduledRelease:output_type -> realm_mgr.v1.CancelScheduledReleaseResponse
+	52, // 52: realm_mgr.v1.RealmManagerService.RequestReleaseApproval:output_type -> realm_mgr.v1.RequestReleaseApprovalResponse
+	53, // 53: realm_mgr.v1.RealmManagerService.ApproveRelease:output_type -> realm_mgr.v1.ApproveReleaseResponse
+	54, // 54: realm_mgr.v1.RealmManagerService.RejectRelease:output_type -> realm_mgr.v1.RejectReleaseResponse
+	55, // 55: realm_mgr.v1.RealmManagerService.UpdateRealm:output_type -> realm_mgr.v1.UpdateRealmResponse
+	56, // 56: realm_mgr.v1.RealmManagerService.BatchMutateRealms:output_type -> realm_mgr.v1.BatchMutateRealmsResponse
+	57, // 57: realm_mgr.v1.RealmManagerService.ExportRealms:output_type -> realm_mgr.v1.ExportRealmsResponse
+	58, // 58: realm_mgr.v1.RealmManagerService.ImportRealms:output_type -> realm_mgr.v1.ImportRealmsResponse
+	59, // 59: realm_mgr.v1.RealmManagerService.DisableRealm:output_type -> realm_mgr.v1.DisableRealmResponse
+	60, // 60: realm_mgr.v1.RealmManagerService.EnableRealm:output_type -> realm_mgr.v1.EnableRealmResponse
+	61, // 61: realm_mgr.v1.RealmManagerService.DeleteRealm:output_type -> realm_mgr.v1.DeleteRealmResponse
+	62, // 62: realm_mgr.v1.RealmManagerService.RestoreRealm:output_type -> realm_mgr.v1.RestoreRealmResponse
+	63, // 63: realm_mgr.v1.RealmManagerService.DiscardDraft:output_type -> realm_mgr.v1.DiscardDraftResponse
+	64, // 64: realm_mgr.v1.RealmManagerService.RollbackRealm:output_type -> realm_mgr.v1.RollbackRealmResponse
+	65, // 65: realm_mgr.v1.RealmManagerService.DiffRealm:output_type -> realm_mgr.v1.DiffRealmResponse
+	66, // 66: realm_mgr.v1.RealmManagerService.ListRealmRevisions:output_type -> realm_mgr.v1.ListRealmRevisionsResponse
+	67, // 67: realm_mgr.v1.RealmManagerService.GetRealmRevision:output_type -> realm_mgr.v1.GetRealmRevisionResponse
+	68, // 68: realm_mgr.v1.RealmManagerService.CreateRealmTemplate:output_type -> realm_mgr.v1.CreateRealmTemplateResponse
+	69, // 69: realm_mgr.v1.RealmManagerService.GetRealmTemplate:output_type -> realm_mgr.v1.GetRealmTemplateResponse
+	70, // 70: realm_mgr.v1.RealmManagerService.ListRealmTemplates:output_type -> realm_mgr.v1.ListRealmTemplatesResponse
+	71, // 71: realm_mgr.v1.RealmManagerService.UpdateRealmTemplate:output_type -> realm_mgr.v1.UpdateRealmTemplateResponse
+	72, // 72: realm_mgr.v1.RealmManagerService.DeleteRealmTemplate:output_type -> realm_mgr.v1.DeleteRealmTemplateResponse
+	73, // 73: realm_mgr.v1.RealmManagerService.CreateRealmFromTemplate:output_type -> realm_mgr.v1.CreateRealmFromTemplateResponse
+	37, // [37:74] is the sub-list for method output_type
+	0,  // [0:37] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_realm_mgr_v1_service_proto_init() }
@@ -86,6 +421,7 @@ func file_realm_mgr_v1_service_proto_init() {
 		return
 	}
 	file_realm_mgr_v1_realm_proto_init()
+	file_realm_mgr_v1_realm_template_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -26,12 +26,80 @@ type RealmManagerServiceClient interface {
 	//
 	// Get a single active realm
 	GetRealm(ctx context.Context, in *GetRealmRequest, opts ...grpc.CallOption) (*GetRealmResponse, error)
+	// Get a single realm by its slug, following redirects of former slugs
+	GetRealmBySlug(ctx context.Context, in *GetRealmBySlugRequest, opts ...grpc.CallOption) (*GetRealmBySlugResponse, error)
+	// Get multiple realms in a single call
+	BatchGetRealms(ctx context.Context, in *BatchGetRealmsRequest, opts ...grpc.CallOption) (*BatchGetRealmsResponse, error)
+	// List realms page by page
+	ListRealms(ctx context.Context, in *ListRealmsRequest, opts ...grpc.CallOption) (*ListRealmsResponse, error)
+	// Search realms by name and description page by page
+	SearchRealms(ctx context.Context, in *SearchRealmsRequest, opts ...grpc.CallOption) (*SearchRealmsResponse, error)
+	// List the direct children of a realm page by page
+	ListChildRealms(ctx context.Context, in *ListChildRealmsRequest, opts ...grpc.CallOption) (*ListChildRealmsResponse, error)
+	// Get the ancestors of a realm up to the root
+	GetRealmAncestors(ctx context.Context, in *GetRealmAncestorsRequest, opts ...grpc.CallOption) (*GetRealmAncestorsResponse, error)
+	// Count realms by status and by day of creation
+	GetRealmStats(ctx context.Context, in *GetRealmStatsRequest, opts ...grpc.CallOption) (*GetRealmStatsResponse, error)
+	// Watch realms for changes, resuming from a sequence token after a disconnect
+	WatchRealms(ctx context.Context, in *WatchRealmsRequest, opts ...grpc.CallOption) (RealmManagerService_WatchRealmsClient, error)
 	// Create a new realm
 	CreateRealm(ctx context.Context, in *CreateRealmRequest, opts ...grpc.CallOption) (*CreateRealmResponse, error)
+	// Create a new draft realm as a copy of an existing realm
+	CloneRealm(ctx context.Context, in *CloneRealmRequest, opts ...grpc.CallOption) (*CloneRealmResponse, error)
+	// Change the slug of a realm, keeping the former slug as a redirect
+	RenameRealmSlug(ctx context.Context, in *RenameRealmSlugRequest, opts ...grpc.CallOption) (*RenameRealmSlugResponse, error)
 	// Release existing draft copy of the realm
 	ReleaseRealm(ctx context.Context, in *ReleaseRealmRequest, opts ...grpc.CallOption) (*ReleaseRealmResponse, error)
+	// Schedule the release of the realm draft at a point in time
+	ScheduleRelease(ctx context.Context, in *ScheduleReleaseRequest, opts ...grpc.CallOption) (*ScheduleReleaseResponse, error)
+	// Cancel a scheduled release of the realm draft
+	CancelScheduledRelease(ctx context.Context, in *CancelScheduledReleaseRequest, opts ...grpc.CallOption) (*CancelScheduledReleaseResponse, error)
+	// Request approval of the current realm draft before it can be released
+	RequestReleaseApproval(ctx context.Context, in *RequestReleaseApprovalRequest, opts ...grpc.CallOption) (*RequestReleaseApprovalResponse, error)
+	// Approve the release of the realm draft
+	ApproveRelease(ctx context.Context, in *ApproveReleaseRequest, opts ...grpc.CallOption) (*ApproveReleaseResponse, error)
+	// Reject the release of the realm draft
+	RejectRelease(ctx context.Context, in *RejectReleaseRequest, opts ...grpc.CallOption) (*RejectReleaseResponse, error)
 	// Update single realm
 	UpdateRealm(ctx context.Context, in *UpdateRealmRequest, opts ...grpc.CallOption) (*UpdateRealmResponse, error)
+	// Apply several realm mutations atomically
+	BatchMutateRealms(ctx context.Context, in *BatchMutateRealmsRequest, opts ...grpc.CallOption) (*BatchMutateRealmsResponse, error)
+	// Export all realms that are not deleted, one record per realm
+	ExportRealms(ctx context.Context, in *ExportRealmsRequest, opts ...grpc.CallOption) (RealmManagerService_ExportRealmsClient, error)
+	// Import exported realms, committing them in batches of the size configured by the service
+	ImportRealms(ctx context.Context, opts ...grpc.CallOption) (RealmManagerService_ImportRealmsClient, error)
+	// Disable an active realm
+	DisableRealm(ctx context.Context, in *DisableRealmRequest, opts ...grpc.CallOption) (*DisableRealmResponse, error)
+	// Enable a disabled realm
+	EnableRealm(ctx context.Context, in *EnableRealmRequest, opts ...grpc.CallOption) (*EnableRealmResponse, error)
+	// Delete a realm together with its draft
+	DeleteRealm(ctx context.Context, in *DeleteRealmRequest, opts ...grpc.CallOption) (*DeleteRealmResponse, error)
+	// Restore a deleted realm within the retention period
+	RestoreRealm(ctx context.Context, in *RestoreRealmRequest, opts ...grpc.CallOption) (*RestoreRealmResponse, error)
+	// Discard pending draft changes of a realm
+	DiscardDraft(ctx context.Context, in *DiscardDraftRequest, opts ...grpc.CallOption) (*DiscardDraftResponse, error)
+	// Restore a released revision of a realm into its draft
+	RollbackRealm(ctx context.Context, in *RollbackRealmRequest, opts ...grpc.CallOption) (*RollbackRealmResponse, error)
+	// Compare two versions of a realm field by field
+	DiffRealm(ctx context.Context, in *DiffRealmRequest, opts ...grpc.CallOption) (*DiffRealmResponse, error)
+	// List released revisions of a realm
+	ListRealmRevisions(ctx context.Context, in *ListRealmRevisionsRequest, opts ...grpc.CallOption) (*ListRealmRevisionsResponse, error)
+	// Get a single released revision of a realm
+	GetRealmRevision(ctx context.Context, in *GetRealmRevisionRequest, opts ...grpc.CallOption) (*GetRealmRevisionResponse, error)
+	// Realm template RPCs
+	//
+	// Create a new realm template
+	CreateRealmTemplate(ctx context.Context, in *CreateRealmTemplateRequest, opts ...grpc.CallOption) (*CreateRealmTemplateResponse, error)
+	// Get a single version of a realm template
+	GetRealmTemplate(ctx context.Context, in *GetRealmTemplateRequest, opts ...grpc.CallOption) (*GetRealmTemplateResponse, error)
+	// List the latest versions of realm templates page by page
+	ListRealmTemplates(ctx context.Context, in *ListRealmTemplatesRequest, opts ...grpc.CallOption) (*ListRealmTemplatesResponse, error)
+	// Store a new version of a realm template
+	UpdateRealmTemplate(ctx context.Context, in *UpdateRealmTemplateRequest, opts ...grpc.CallOption) (*UpdateRealmTemplateResponse, error)
+	// Delete a realm template with all of its versions
+	DeleteRealmTemplate(ctx context.Context, in *DeleteRealmTemplateRequest, opts ...grpc.CallOption) (*DeleteRealmTemplateResponse, error)
+	// Create a new draft realm from a realm template
+	CreateRealmFromTemplate(ctx context.Context, in *CreateRealmFromTemplateRequest, opts ...grpc.CallOption) (*CreateRealmFromTemplateResponse, error)
 }
 
 type realmManagerServiceClient struct {
@@ -42,156 +110,1299 @@ func NewRealmManagerServiceClient(cc grpc.ClientConnInterface) RealmManagerServi
 	return &realmManagerServiceClient{cc}
 }
 
-func (c *realmManagerServiceClient) GetRealm(ctx context.Context, in *GetRealmRequest, opts ...grpc.CallOption) (*GetRealmResponse, error) {
-	out := new(GetRealmResponse)
-	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/GetRealm", in, out, opts...)
-	if err != nil {
+func (c *realmManagerServiceClient) GetRealm(ctx context.Context, in *GetRealmRequest, opts ...grpc.CallOption) (*GetRealmResponse, error) {
+	out := new(GetRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/GetRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) GetRealmBySlug(ctx context.Context, in *GetRealmBySlugRequest, opts ...grpc.CallOption) (*GetRealmBySlugResponse, error) {
+	out := new(GetRealmBySlugResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/GetRealmBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) BatchGetRealms(ctx context.Context, in *BatchGetRealmsRequest, opts ...grpc.CallOption) (*BatchGetRealmsResponse, error) {
+	out := new(BatchGetRealmsResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/BatchGetRealms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) ListRealms(ctx context.Context, in *ListRealmsRequest, opts ...grpc.CallOption) (*ListRealmsResponse, error) {
+	out := new(ListRealmsResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/ListRealms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) SearchRealms(ctx context.Context, in *SearchRealmsRequest, opts ...grpc.CallOption) (*SearchRealmsResponse, error) {
+	out := new(SearchRealmsResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/SearchRealms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) ListChildRealms(ctx context.Context, in *ListChildRealmsRequest, opts ...grpc.CallOption) (*ListChildRealmsResponse, error) {
+	out := new(ListChildRealmsResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/ListChildRealms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) GetRealmAncestors(ctx context.Context, in *GetRealmAncestorsRequest, opts ...grpc.CallOption) (*GetRealmAncestorsResponse, error) {
+	out := new(GetRealmAncestorsResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/GetRealmAncestors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) GetRealmStats(ctx context.Context, in *GetRealmStatsRequest, opts ...grpc.CallOption) (*GetRealmStatsResponse, error) {
+	out := new(GetRealmStatsResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/GetRealmStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) WatchRealms(ctx context.Context, in *WatchRealmsRequest, opts ...grpc.CallOption) (RealmManagerService_WatchRealmsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RealmManagerService_ServiceDesc.Streams[0], "/realm_mgr.v1.RealmManagerService/WatchRealms", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &realmManagerServiceWatchRealmsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RealmManagerService_WatchRealmsClient interface {
+	Recv() (*WatchRealmsResponse, error)
+	grpc.ClientStream
+}
+
+type realmManagerServiceWatchRealmsClient struct {
+	grpc.ClientStream
+}
+
+func (x *realmManagerServiceWatchRealmsClient) Recv() (*WatchRealmsResponse, error) {
+	m := new(WatchRealmsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *realmManagerServiceClient) CreateRealm(ctx context.Context, in *CreateRealmRequest, opts ...grpc.CallOption) (*CreateRealmResponse, error) {
+	out := new(CreateRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/CreateRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) CloneRealm(ctx context.Context, in *CloneRealmRequest, opts ...grpc.CallOption) (*CloneRealmResponse, error) {
+	out := new(CloneRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/CloneRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) RenameRealmSlug(ctx context.Context, in *RenameRealmSlugRequest, opts ...grpc.CallOption) (*RenameRealmSlugResponse, error) {
+	out := new(RenameRealmSlugResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/RenameRealmSlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) ReleaseRealm(ctx context.Context, in *ReleaseRealmRequest, opts ...grpc.CallOption) (*ReleaseRealmResponse, error) {
+	out := new(ReleaseRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/ReleaseRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) ScheduleRelease(ctx context.Context, in *ScheduleReleaseRequest, opts ...grpc.CallOption) (*ScheduleReleaseResponse, error) {
+	out := new(ScheduleReleaseResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/ScheduleRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) CancelScheduledRelease(ctx context.Context, in *CancelScheduledReleaseRequest, opts ...grpc.CallOption) (*CancelScheduledReleaseResponse, error) {
+	out := new(CancelScheduledReleaseResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/CancelScheduledRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) RequestReleaseApproval(ctx context.Context, in *RequestReleaseApprovalRequest, opts ...grpc.CallOption) (*RequestReleaseApprovalResponse, error) {
+	out := new(RequestReleaseApprovalResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/RequestReleaseApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) ApproveRelease(ctx context.Context, in *ApproveReleaseRequest, opts ...grpc.CallOption) (*ApproveReleaseResponse, error) {
+	out := new(ApproveReleaseResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/ApproveRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) RejectRelease(ctx context.Context, in *RejectReleaseRequest, opts ...grpc.CallOption) (*RejectReleaseResponse, error) {
+	out := new(RejectReleaseResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/RejectRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) UpdateRealm(ctx context.Context, in *UpdateRealmRequest, opts ...grpc.CallOption) (*UpdateRealmResponse, error) {
+	out := new(UpdateRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/UpdateRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) BatchMutateRealms(ctx context.Context, in *BatchMutateRealmsRequest, opts ...grpc.CallOption) (*BatchMutateRealmsResponse, error) {
+	out := new(BatchMutateRealmsResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/BatchMutateRealms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) ExportRealms(ctx context.Context, in *ExportRealmsRequest, opts ...grpc.CallOption) (RealmManagerService_ExportRealmsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RealmManagerService_ServiceDesc.Streams[1], "/realm_mgr.v1.RealmManagerService/ExportRealms", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &realmManagerServiceExportRealmsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RealmManagerService_ExportRealmsClient interface {
+	Recv() (*ExportRealmsResponse, error)
+	grpc.ClientStream
+}
+
+type realmManagerServiceExportRealmsClient struct {
+	grpc.ClientStream
+}
+
+func (x *realmManagerServiceExportRealmsClient) Recv() (*ExportRealmsResponse, error) {
+	m := new(ExportRealmsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *realmManagerServiceClient) ImportRealms(ctx context.Context, opts ...grpc.CallOption) (RealmManagerService_ImportRealmsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RealmManagerService_ServiceDesc.Streams[2], "/realm_mgr.v1.RealmManagerService/ImportRealms", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &realmManagerServiceImportRealmsClient{stream}
+	return x, nil
+}
+
+type RealmManagerService_ImportRealmsClient interface {
+	Send(*ImportRealmsRequest) error
+	CloseAndRecv() (*ImportRealmsResponse, error)
+	grpc.ClientStream
+}
+
+type realmManagerServiceImportRealmsClient struct {
+	grpc.ClientStream
+}
+
+func (x *realmManagerServiceImportRealmsClient) Send(m *ImportRealmsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *realmManagerServiceImportRealmsClient) CloseAndRecv() (*ImportRealmsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportRealmsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *realmManagerServiceClient) DisableRealm(ctx context.Context, in *DisableRealmRequest, opts ...grpc.CallOption) (*DisableRealmResponse, error) {
+	out := new(DisableRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/DisableRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) EnableRealm(ctx context.Context, in *EnableRealmRequest, opts ...grpc.CallOption) (*EnableRealmResponse, error) {
+	out := new(EnableRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/EnableRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) DeleteRealm(ctx context.Context, in *DeleteRealmRequest, opts ...grpc.CallOption) (*DeleteRealmResponse, error) {
+	out := new(DeleteRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/DeleteRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) RestoreRealm(ctx context.Context, in *RestoreRealmRequest, opts ...grpc.CallOption) (*RestoreRealmResponse, error) {
+	out := new(RestoreRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/RestoreRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) DiscardDraft(ctx context.Context, in *DiscardDraftRequest, opts ...grpc.CallOption) (*DiscardDraftResponse, error) {
+	out := new(DiscardDraftResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/DiscardDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) RollbackRealm(ctx context.Context, in *RollbackRealmRequest, opts ...grpc.CallOption) (*RollbackRealmResponse, error) {
+	out := new(RollbackRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/RollbackRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) DiffRealm(ctx context.Context, in *DiffRealmRequest, opts ...grpc.CallOption) (*DiffRealmResponse, error) {
+	out := new(DiffRealmResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/DiffRealm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) ListRealmRevisions(ctx context.Context, in *ListRealmRevisionsRequest, opts ...grpc.CallOption) (*ListRealmRevisionsResponse, error) {
+	out := new(ListRealmRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/ListRealmRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) GetRealmRevision(ctx context.Context, in *GetRealmRevisionRequest, opts ...grpc.CallOption) (*GetRealmRevisionResponse, error) {
+	out := new(GetRealmRevisionResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/GetRealmRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) CreateRealmTemplate(ctx context.Context, in *CreateRealmTemplateRequest, opts ...grpc.CallOption) (*CreateRealmTemplateResponse, error) {
+	out := new(CreateRealmTemplateResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/CreateRealmTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) GetRealmTemplate(ctx context.Context, in *GetRealmTemplateRequest, opts ...grpc.CallOption) (*GetRealmTemplateResponse, error) {
+	out := new(GetRealmTemplateResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/GetRealmTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) ListRealmTemplates(ctx context.Context, in *ListRealmTemplatesRequest, opts ...grpc.CallOption) (*ListRealmTemplatesResponse, error) {
+	out := new(ListRealmTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/ListRealmTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) UpdateRealmTemplate(ctx context.Context, in *UpdateRealmTemplateRequest, opts ...grpc.CallOption) (*UpdateRealmTemplateResponse, error) {
+	out := new(UpdateRealmTemplateResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/UpdateRealmTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) DeleteRealmTemplate(ctx context.Context, in *DeleteRealmTemplateRequest, opts ...grpc.CallOption) (*DeleteRealmTemplateResponse, error) {
+	out := new(DeleteRealmTemplateResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/DeleteRealmTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realmManagerServiceClient) CreateRealmFromTemplate(ctx context.Context, in *CreateRealmFromTemplateRequest, opts ...grpc.CallOption) (*CreateRealmFromTemplateResponse, error) {
+	out := new(CreateRealmFromTemplateResponse)
+	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/CreateRealmFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RealmManagerServiceServer is the server API for RealmManagerService service.
+// All implementations must embed UnimplementedRealmManagerServiceServer
+// for forward compatibility
+type RealmManagerServiceServer interface {
+	// Realm RPCs
+	//
+	// Get a single active realm
+	GetRealm(context.Context, *GetRealmRequest) (*GetRealmResponse, error)
+	// Get a single realm by its slug, following redirects of former slugs
+	GetRealmBySlug(context.Context, *GetRealmBySlugRequest) (*GetRealmBySlugResponse, error)
+	// Get multiple realms in a single call
+	BatchGetRealms(context.Context, *BatchGetRealmsRequest) (*BatchGetRealmsResponse, error)
+	// List realms page by page
+	ListRealms(context.Context, *ListRealmsRequest) (*ListRealmsResponse, error)
+	// Search realms by name and description page by page
+	SearchRealms(context.Context, *SearchRealmsRequest) (*SearchRealmsResponse, error)
+	// List the direct children of a realm page by page
+	ListChildRealms(context.Context, *ListChildRealmsRequest) (*ListChildRealmsResponse, error)
+	// Get the ancestors of a realm up to the root
+	GetRealmAncestors(context.Context, *GetRealmAncestorsRequest) (*GetRealmAncestorsResponse, error)
+	// Count realms by status and by day of creation
+	GetRealmStats(context.Context, *GetRealmStatsRequest) (*GetRealmStatsResponse, error)
+	// Watch realms for changes, resuming from a sequence token after a disconnect
+	WatchRealms(*WatchRealmsRequest, RealmManagerService_WatchRealmsServer) error
+	// Create a new realm
+	CreateRealm(context.Context, *CreateRealmRequest) (*CreateRealmResponse, error)
+	// Create a new draft realm as a copy of an existing realm
+	CloneRealm(context.Context, *CloneRealmRequest) (*CloneRealmResponse, error)
+	// Change the slug of a realm, keeping the former slug as a redirect
+	RenameRealmSlug(context.Context, *RenameRealmSlugRequest) (*RenameRealmSlugResponse, error)
+	// Release existing draft copy of the realm
+	ReleaseRealm(context.Context, *ReleaseRealmRequest) (*ReleaseRealmResponse, error)
+	// Schedule the release of the realm draft at a point in time
+	ScheduleRelease(context.Context, *ScheduleReleaseRequest) (*ScheduleReleaseResponse, error)
+	// Cancel a scheduled release of the realm draft
+	CancelScheduledRelease(context.Context, *CancelScheduledReleaseRequest) (*CancelScheduledReleaseResponse, error)
+	// Request approval of the current realm draft before it can be released
+	RequestReleaseApproval(context.Context, *RequestReleaseApprovalRequest) (*RequestReleaseApprovalResponse, error)
+	// Approve the release of the realm draft
+	ApproveRelease(context.Context, *ApproveReleaseRequest) (*ApproveReleaseResponse, error)
+	// Reject the release of the realm draft
+	RejectRelease(context.Context, *RejectReleaseRequest) (*RejectReleaseResponse, error)
+	// Update single realm
+	UpdateRealm(context.Context, *UpdateRealmRequest) (*UpdateRealmResponse, error)
+	// Apply several realm mutations atomically
+	BatchMutateRealms(context.Context, *BatchMutateRealmsRequest) (*BatchMutateRealmsResponse, error)
+	// Export all realms that are not deleted, one record per realm
+	ExportRealms(*ExportRealmsRequest, RealmManagerService_ExportRealmsServer) error
+	// Import exported realms, committing them in batches of the size configured by the service
+	ImportRealms(RealmManagerService_ImportRealmsServer) error
+	// Disable an active realm
+	DisableRealm(context.Context, *DisableRealmRequest) (*DisableRealmResponse, error)
+	// Enable a disabled realm
+	EnableRealm(context.Context, *EnableRealmRequest) (*EnableRealmResponse, error)
+	// Delete a realm together with its draft
+	DeleteRealm(context.Context, *DeleteRealmRequest) (*DeleteRealmResponse, error)
+	// Restore a deleted realm within the retention period
+	RestoreRealm(context.Context, *RestoreRealmRequest) (*RestoreRealmResponse, error)
+	// Discard pending draft changes of a realm
+	DiscardDraft(context.Context, *DiscardDraftRequest) (*DiscardDraftResponse, error)
+	// Restore a released revision of a realm into its draft
+	RollbackRealm(context.Context, *RollbackRealmRequest) (*RollbackRealmResponse, error)
+	// Compare two versions of a realm field by field
+	DiffRealm(context.Context, *DiffRealmRequest) (*DiffRealmResponse, error)
+	// List released revisions of a realm
+	ListRealmRevisions(context.Context, *ListRealmRevisionsRequest) (*ListRealmRevisionsResponse, error)
+	// Get a single released revision of a realm
+	GetRealmRevision(context.Context, *GetRealmRevisionRequest) (*GetRealmRevisionResponse, error)
+	// Realm template RPCs
+	//
+	// Create a new realm template
+	CreateRealmTemplate(context.Context, *CreateRealmTemplateRequest) (*CreateRealmTemplateResponse, error)
+	// Get a single version of a realm template
+	GetRealmTemplate(context.Context, *GetRealmTemplateRequest) (*GetRealmTemplateResponse, error)
+	// List the latest versions of realm templates page by page
+	ListRealmTemplates(context.Context, *ListRealmTemplatesRequest) (*ListRealmTemplatesResponse, error)
+	// Store a new version of a realm template
+	UpdateRealmTemplate(context.Context, *UpdateRealmTemplateRequest) (*UpdateRealmTemplateResponse, error)
+	// Delete a realm template with all of its versions
+	DeleteRealmTemplate(context.Context, *DeleteRealmTemplateRequest) (*DeleteRealmTemplateResponse, error)
+	// Create a new draft realm from a realm template
+	CreateRealmFromTemplate(context.Context, *CreateRealmFromTemplateRequest) (*CreateRealmFromTemplateResponse, error)
+	mustEmbedUnimplementedRealmManagerServiceServer()
+}
+
+// UnimplementedRealmManagerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRealmManagerServiceServer struct {
+}
+
+func (UnimplementedRealmManagerServiceServer) GetRealm(context.Context, *GetRealmRequest) (*GetRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) GetRealmBySlug(context.Context, *GetRealmBySlugRequest) (*GetRealmBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealmBySlug not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) BatchGetRealms(context.Context, *BatchGetRealmsRequest) (*BatchGetRealmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetRealms not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ListRealms(context.Context, *ListRealmsRequest) (*ListRealmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRealms not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) SearchRealms(context.Context, *SearchRealmsRequest) (*SearchRealmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRealms not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ListChildRealms(context.Context, *ListChildRealmsRequest) (*ListChildRealmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChildRealms not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) GetRealmAncestors(context.Context, *GetRealmAncestorsRequest) (*GetRealmAncestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealmAncestors not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) GetRealmStats(context.Context, *GetRealmStatsRequest) (*GetRealmStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealmStats not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) WatchRealms(*WatchRealmsRequest, RealmManagerService_WatchRealmsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRealms not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) CreateRealm(context.Context, *CreateRealmRequest) (*CreateRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) CloneRealm(context.Context, *CloneRealmRequest) (*CloneRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) RenameRealmSlug(context.Context, *RenameRealmSlugRequest) (*RenameRealmSlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameRealmSlug not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ReleaseRealm(context.Context, *ReleaseRealmRequest) (*ReleaseRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ScheduleRelease(context.Context, *ScheduleReleaseRequest) (*ScheduleReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRelease not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) CancelScheduledRelease(context.Context, *CancelScheduledReleaseRequest) (*CancelScheduledReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledRelease not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) RequestReleaseApproval(context.Context, *RequestReleaseApprovalRequest) (*RequestReleaseApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReleaseApproval not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ApproveRelease(context.Context, *ApproveReleaseRequest) (*ApproveReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRelease not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) RejectRelease(context.Context, *RejectReleaseRequest) (*RejectReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRelease not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) UpdateRealm(context.Context, *UpdateRealmRequest) (*UpdateRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) BatchMutateRealms(context.Context, *BatchMutateRealmsRequest) (*BatchMutateRealmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMutateRealms not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ExportRealms(*ExportRealmsRequest, RealmManagerService_ExportRealmsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportRealms not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ImportRealms(RealmManagerService_ImportRealmsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportRealms not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) DisableRealm(context.Context, *DisableRealmRequest) (*DisableRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) EnableRealm(context.Context, *EnableRealmRequest) (*EnableRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) DeleteRealm(context.Context, *DeleteRealmRequest) (*DeleteRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) RestoreRealm(context.Context, *RestoreRealmRequest) (*RestoreRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) DiscardDraft(context.Context, *DiscardDraftRequest) (*DiscardDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardDraft not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) RollbackRealm(context.Context, *RollbackRealmRequest) (*RollbackRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) DiffRealm(context.Context, *DiffRealmRequest) (*DiffRealmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRealm not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ListRealmRevisions(context.Context, *ListRealmRevisionsRequest) (*ListRealmRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRealmRevisions not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) GetRealmRevision(context.Context, *GetRealmRevisionRequest) (*GetRealmRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealmRevision not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) CreateRealmTemplate(context.Context, *CreateRealmTemplateRequest) (*CreateRealmTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRealmTemplate not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) GetRealmTemplate(context.Context, *GetRealmTemplateRequest) (*GetRealmTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealmTemplate not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) ListRealmTemplates(context.Context, *ListRealmTemplatesRequest) (*ListRealmTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRealmTemplates not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) UpdateRealmTemplate(context.Context, *UpdateRealmTemplateRequest) (*UpdateRealmTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRealmTemplate not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) DeleteRealmTemplate(context.Context, *DeleteRealmTemplateRequest) (*DeleteRealmTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRealmTemplate not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) CreateRealmFromTemplate(context.Context, *CreateRealmFromTemplateRequest) (*CreateRealmFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRealmFromTemplate not implemented")
+}
+func (UnimplementedRealmManagerServiceServer) mustEmbedUnimplementedRealmManagerServiceServer() {}
+
+// UnsafeRealmManagerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RealmManagerServiceServer will
+// result in compilation errors.
+type UnsafeRealmManagerServiceServer interface {
+	mustEmbedUnimplementedRealmManagerServiceServer()
+}
+
+func RegisterRealmManagerServiceServer(s grpc.ServiceRegistrar, srv RealmManagerServiceServer) {
+	s.RegisterService(&RealmManagerService_ServiceDesc, srv)
+}
+
+func _RealmManagerService_GetRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).GetRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/GetRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).GetRealm(ctx, req.(*GetRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_GetRealmBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealmBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).GetRealmBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/GetRealmBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).GetRealmBySlug(ctx, req.(*GetRealmBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_BatchGetRealms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRealmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).BatchGetRealms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/BatchGetRealms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).BatchGetRealms(ctx, req.(*BatchGetRealmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_ListRealms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRealmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).ListRealms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/ListRealms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).ListRealms(ctx, req.(*ListRealmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_SearchRealms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRealmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).SearchRealms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/SearchRealms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).SearchRealms(ctx, req.(*SearchRealmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_ListChildRealms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildRealmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).ListChildRealms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/ListChildRealms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).ListChildRealms(ctx, req.(*ListChildRealmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_GetRealmAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealmAncestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).GetRealmAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/GetRealmAncestors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).GetRealmAncestors(ctx, req.(*GetRealmAncestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_GetRealmStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealmStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).GetRealmStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/GetRealmStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).GetRealmStats(ctx, req.(*GetRealmStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_WatchRealms_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRealmsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RealmManagerServiceServer).WatchRealms(m, &realmManagerServiceWatchRealmsServer{stream})
+}
+
+type RealmManagerService_WatchRealmsServer interface {
+	Send(*WatchRealmsResponse) error
+	grpc.ServerStream
+}
+
+type realmManagerServiceWatchRealmsServer struct {
+	grpc.ServerStream
+}
+
+func (x *realmManagerServiceWatchRealmsServer) Send(m *WatchRealmsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RealmManagerService_CreateRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRealmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).CreateRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/CreateRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).CreateRealm(ctx, req.(*CreateRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_CloneRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneRealmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).CloneRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/CloneRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).CloneRealm(ctx, req.(*CloneRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_RenameRealmSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRealmSlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).RenameRealmSlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/RenameRealmSlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).RenameRealmSlug(ctx, req.(*RenameRealmSlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_ReleaseRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRealmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).ReleaseRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/ReleaseRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).ReleaseRealm(ctx, req.(*ReleaseRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_ScheduleRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).ScheduleRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/ScheduleRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).ScheduleRelease(ctx, req.(*ScheduleReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_CancelScheduledRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).CancelScheduledRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/CancelScheduledRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).CancelScheduledRelease(ctx, req.(*CancelScheduledReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_RequestReleaseApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReleaseApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).RequestReleaseApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/RequestReleaseApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).RequestReleaseApproval(ctx, req.(*RequestReleaseApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_ApproveRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).ApproveRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/ApproveRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).ApproveRelease(ctx, req.(*ApproveReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_RejectRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).RejectRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/RejectRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).RejectRelease(ctx, req.(*RejectReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_UpdateRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRealmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).UpdateRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/UpdateRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).UpdateRealm(ctx, req.(*UpdateRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_BatchMutateRealms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMutateRealmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).BatchMutateRealms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/BatchMutateRealms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).BatchMutateRealms(ctx, req.(*BatchMutateRealmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealmManagerService_ExportRealms_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRealmsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RealmManagerServiceServer).ExportRealms(m, &realmManagerServiceExportRealmsServer{stream})
+}
+
+type RealmManagerService_ExportRealmsServer interface {
+	Send(*ExportRealmsResponse) error
+	grpc.ServerStream
+}
+
+type realmManagerServiceExportRealmsServer struct {
+	grpc.ServerStream
+}
+
+func (x *realmManagerServiceExportRealmsServer) Send(m *ExportRealmsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RealmManagerService_ImportRealms_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RealmManagerServiceServer).ImportRealms(&realmManagerServiceImportRealmsServer{stream})
+}
+
+type RealmManagerService_ImportRealmsServer interface {
+	SendAndClose(*ImportRealmsResponse) error
+	Recv() (*ImportRealmsRequest, error)
+	grpc.ServerStream
+}
+
+type realmManagerServiceImportRealmsServer struct {
+	grpc.ServerStream
+}
+
+func (x *realmManagerServiceImportRealmsServer) SendAndClose(m *ImportRealmsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *realmManagerServiceImportRealmsServer) Recv() (*ImportRealmsRequest, error) {
+	m := new(ImportRealmsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RealmManagerService_DisableRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableRealmRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).DisableRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/DisableRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).DisableRealm(ctx, req.(*DisableRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *realmManagerServiceClient) CreateRealm(ctx context.Context, in *CreateRealmRequest, opts ...grpc.CallOption) (*CreateRealmResponse, error) {
-	out := new(CreateRealmResponse)
-	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/CreateRealm", in, out, opts...)
-	if err != nil {
+func _RealmManagerService_EnableRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableRealmRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).EnableRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/EnableRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).EnableRealm(ctx, req.(*EnableRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *realmManagerServiceClient) ReleaseRealm(ctx context.Context, in *ReleaseRealmRequest, opts ...grpc.CallOption) (*ReleaseRealmResponse, error) {
-	out := new(ReleaseRealmResponse)
-	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/ReleaseRealm", in, out, opts...)
-	if err != nil {
+func _RealmManagerService_DeleteRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRealmRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).DeleteRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/DeleteRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).DeleteRealm(ctx, req.(*DeleteRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *realmManagerServiceClient) UpdateRealm(ctx context.Context, in *UpdateRealmRequest, opts ...grpc.CallOption) (*UpdateRealmResponse, error) {
-	out := new(UpdateRealmResponse)
-	err := c.cc.Invoke(ctx, "/realm_mgr.v1.RealmManagerService/UpdateRealm", in, out, opts...)
-	if err != nil {
+func _RealmManagerService_RestoreRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRealmRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).RestoreRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/RestoreRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).RestoreRealm(ctx, req.(*RestoreRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// RealmManagerServiceServer is the server API for RealmManagerService service.
-// All implementations must embed UnimplementedRealmManagerServiceServer
-// for forward compatibility
-type RealmManagerServiceServer interface {
-	// Realm RPCs
-	//
-	// Get a single active realm
-	GetRealm(context.Context, *GetRealmRequest) (*GetRealmResponse, error)
-	// Create a new realm
-	CreateRealm(context.Context, *CreateRealmRequest) (*CreateRealmResponse, error)
-	// Release existing draft copy of the realm
-	ReleaseRealm(context.Context, *ReleaseRealmRequest) (*ReleaseRealmResponse, error)
-	// Update single realm
-	UpdateRealm(context.Context, *UpdateRealmRequest) (*UpdateRealmResponse, error)
-	mustEmbedUnimplementedRealmManagerServiceServer()
+func _RealmManagerService_DiscardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).DiscardDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/DiscardDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).DiscardDraft(ctx, req.(*DiscardDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedRealmManagerServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedRealmManagerServiceServer struct {
+func _RealmManagerService_RollbackRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRealmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).RollbackRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/RollbackRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).RollbackRealm(ctx, req.(*RollbackRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedRealmManagerServiceServer) GetRealm(context.Context, *GetRealmRequest) (*GetRealmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRealm not implemented")
-}
-func (UnimplementedRealmManagerServiceServer) CreateRealm(context.Context, *CreateRealmRequest) (*CreateRealmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRealm not implemented")
+func _RealmManagerService_DiffRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRealmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).DiffRealm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/DiffRealm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).DiffRealm(ctx, req.(*DiffRealmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRealmManagerServiceServer) ReleaseRealm(context.Context, *ReleaseRealmRequest) (*ReleaseRealmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReleaseRealm not implemented")
+
+func _RealmManagerService_ListRealmRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRealmRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).ListRealmRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/ListRealmRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).ListRealmRevisions(ctx, req.(*ListRealmRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRealmManagerServiceServer) UpdateRealm(context.Context, *UpdateRealmRequest) (*UpdateRealmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRealm not implemented")
+
+func _RealmManagerService_GetRealmRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealmRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).GetRealmRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/GetRealmRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).GetRealmRevision(ctx, req.(*GetRealmRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRealmManagerServiceServer) mustEmbedUnimplementedRealmManagerServiceServer() {}
 
-// UnsafeRealmManagerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RealmManagerServiceServer will
-// result in compilation errors.
-type UnsafeRealmManagerServiceServer interface {
-	mustEmbedUnimplementedRealmManagerServiceServer()
+func _RealmManagerService_CreateRealmTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRealmTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).CreateRealmTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/CreateRealmTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).CreateRealmTemplate(ctx, req.(*CreateRealmTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRealmManagerServiceServer(s grpc.ServiceRegistrar, srv RealmManagerServiceServer) {
-	s.RegisterService(&RealmManagerService_ServiceDesc, srv)
+func _RealmManagerService_GetRealmTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealmTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealmManagerServiceServer).GetRealmTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/realm_mgr.v1.RealmManagerService/GetRealmTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealmManagerServiceServer).GetRealmTemplate(ctx, req.(*GetRealmTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RealmManagerService_GetRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRealmRequest)
+func _RealmManagerService_ListRealmTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRealmTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealmManagerServiceServer).GetRealm(ctx, in)
+		return srv.(RealmManagerServiceServer).ListRealmTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/realm_mgr.v1.RealmManagerService/GetRealm",
+		FullMethod: "/realm_mgr.v1.RealmManagerService/ListRealmTemplates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealmManagerServiceServer).GetRealm(ctx, req.(*GetRealmRequest))
+		return srv.(RealmManagerServiceServer).ListRealmTemplates(ctx, req.(*ListRealmTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealmManagerService_CreateRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRealmRequest)
+func _RealmManagerService_UpdateRealmTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRealmTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealmManagerServiceServer).CreateRealm(ctx, in)
+		return srv.(RealmManagerServiceServer).UpdateRealmTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/realm_mgr.v1.RealmManagerService/CreateRealm",
+		FullMethod: "/realm_mgr.v1.RealmManagerService/UpdateRealmTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealmManagerServiceServer).CreateRealm(ctx, req.(*CreateRealmRequest))
+		return srv.(RealmManagerServiceServer).UpdateRealmTemplate(ctx, req.(*UpdateRealmTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealmManagerService_ReleaseRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseRealmRequest)
+func _RealmManagerService_DeleteRealmTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRealmTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealmManagerServiceServer).ReleaseRealm(ctx, in)
+		return srv.(RealmManagerServiceServer).DeleteRealmTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/realm_mgr.v1.RealmManagerService/ReleaseRealm",
+		FullMethod: "/realm_mgr.v1.RealmManagerService/DeleteRealmTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealmManagerServiceServer).ReleaseRealm(ctx, req.(*ReleaseRealmRequest))
+		return srv.(RealmManagerServiceServer).DeleteRealmTemplate(ctx, req.(*DeleteRealmTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RealmManagerService_UpdateRealm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRealmRequest)
+func _RealmManagerService_CreateRealmFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRealmFromTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RealmManagerServiceServer).UpdateRealm(ctx, in)
+		return srv.(RealmManagerServiceServer).CreateRealmFromTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/realm_mgr.v1.RealmManagerService/UpdateRealm",
+		FullMethod: "/realm_mgr.v1.RealmManagerService/CreateRealmFromTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RealmManagerServiceServer).UpdateRealm(ctx, req.(*UpdateRealmRequest))
+		return srv.(RealmManagerServiceServer).CreateRealmFromTemplate(ctx, req.(*CreateRealmFromTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -207,19 +1418,155 @@ var RealmManagerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRealm",
 			Handler:    _RealmManagerService_GetRealm_Handler,
 		},
+		{
+			MethodName: "GetRealmBySlug",
+			Handler:    _RealmManagerService_GetRealmBySlug_Handler,
+		},
+		{
+			MethodName: "BatchGetRealms",
+			Handler:    _RealmManagerService_BatchGetRealms_Handler,
+		},
+		{
+			MethodName: "ListRealms",
+			Handler:    _RealmManagerService_ListRealms_Handler,
+		},
+		{
+			MethodName: "SearchRealms",
+			Handler:    _RealmManagerService_SearchRealms_Handler,
+		},
+		{
+			MethodName: "ListChildRealms",
+			Handler:    _RealmManagerService_ListChildRealms_Handler,
+		},
+		{
+			MethodName: "GetRealmAncestors",
+			Handler:    _RealmManagerService_GetRealmAncestors_Handler,
+		},
+		{
+			MethodName: "GetRealmStats",
+			Handler:    _RealmManagerService_GetRealmStats_Handler,
+		},
 		{
 			MethodName: "CreateRealm",
 			Handler:    _RealmManagerService_CreateRealm_Handler,
 		},
+		{
+			MethodName: "CloneRealm",
+			Handler:    _RealmManagerService_CloneRealm_Handler,
+		},
+		{
+			MethodName: "RenameRealmSlug",
+			Handler:    _RealmManagerService_RenameRealmSlug_Handler,
+		},
 		{
 			MethodName: "ReleaseRealm",
 			Handler:    _RealmManagerService_ReleaseRealm_Handler,
 		},
+		{
+			MethodName: "ScheduleRelease",
+			Handler:    _RealmManagerService_ScheduleRelease_Handler,
+		},
+		{
+			MethodName: "CancelScheduledRelease",
+			Handler:    _RealmManagerService_CancelScheduledRelease_Handler,
+		},
+		{
+			MethodName: "RequestReleaseApproval",
+			Handler:    _RealmManagerService_RequestReleaseApproval_Handler,
+		},
+		{
+			MethodName: "ApproveRelease",
+			Handler:    _RealmManagerService_ApproveRelease_Handler,
+		},
+		{
+			MethodName: "RejectRelease",
+			Handler:    _RealmManagerService_RejectRelease_Handler,
+		},
 		{
 			MethodName: "UpdateRealm",
 			Handler:    _RealmManagerService_UpdateRealm_Handler,
 		},
+		{
+			MethodName: "BatchMutateRealms",
+			Handler:    _RealmManagerService_BatchMutateRealms_Handler,
+		},
+		{
+			MethodName: "DisableRealm",
+			Handler:    _RealmManagerService_DisableRealm_Handler,
+		},
+		{
+			MethodName: "EnableRealm",
+			Handler:    _RealmManagerService_EnableRealm_Handler,
+		},
+		{
+			MethodName: "DeleteRealm",
+			Handler:    _RealmManagerService_DeleteRealm_Handler,
+		},
+		{
+			MethodName: "RestoreRealm",
+			Handler:    _RealmManagerService_RestoreRealm_Handler,
+		},
+		{
+			MethodName: "DiscardDraft",
+			Handler:    _RealmManagerService_DiscardDraft_Handler,
+		},
+		{
+			MethodName: "RollbackRealm",
+			Handler:    _RealmManagerService_RollbackRealm_Handler,
+		},
+		{
+			MethodName: "DiffRealm",
+			Handler:    _RealmManagerService_DiffRealm_Handler,
+		},
+		{
+			MethodName: "ListRealmRevisions",
+			Handler:    _RealmManagerService_ListRealmRevisions_Handler,
+		},
+		{
+			MethodName: "GetRealmRevision",
+			Handler:    _RealmManagerService_GetRealmRevision_Handler,
+		},
+		{
+			MethodName: "CreateRealmTemplate",
+			Handler:    _RealmManagerService_CreateRealmTemplate_Handler,
+		},
+		{
+			MethodName: "GetRealmTemplate",
+			Handler:    _RealmManagerService_GetRealmTemplate_Handler,
+		},
+		{
+			MethodName: "ListRealmTemplates",
+			Handler:    _RealmManagerService_ListRealmTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateRealmTemplate",
+			Handler:    _RealmManagerService_UpdateRealmTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteRealmTemplate",
+			Handler:    _RealmManagerService_DeleteRealmTemplate_Handler,
+		},
+		{
+			MethodName: "CreateRealmFromTemplate",
+			Handler:    _RealmManagerService_CreateRealmFromTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRealms",
+			Handler:       _RealmManagerService_WatchRealms_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportRealms",
+			Handler:       _RealmManagerService_ExportRealms_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportRealms",
+			Handler:       _RealmManagerService_ImportRealms_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "realm_mgr/v1/service.proto",
 }
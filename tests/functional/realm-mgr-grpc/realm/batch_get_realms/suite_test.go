@@ -0,0 +1,236 @@
+package batchgetrealms
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+// maxBatchSize as configured in ci-values.yaml
+const maxBatchSize = 100
+
+func TestRealmManagerBatchGetRealmsGRPCSuite(t *testing.T) {
+	testSuite := NewBatchGetRealmsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type BatchGetRealmsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	firstRealmID   uuid.UUID
+	secondRealmID  uuid.UUID
+	draftRealmID   uuid.UUID
+	deletedRealmID uuid.UUID
+}
+
+func NewBatchGetRealmsTestSuite(t *testing.T) *BatchGetRealmsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &BatchGetRealmsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *BatchGetRealmsTestSuite) SetupSuite() {
+	s.firstRealmID = uuid.New()
+	s.secondRealmID = uuid.New()
+	s.draftRealmID = uuid.New()
+	s.deletedRealmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *BatchGetRealmsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *BatchGetRealmsTestSuite) Test_BatchGetRealms_Success() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	missingRealmID := uuid.New()
+
+	// act
+	res, err := s.client.BatchGetRealms(ctx, &realm_mgr_v1.BatchGetRealmsRequest{
+		Ids: []string{
+			s.secondRealmID.String(),
+			missingRealmID.String(),
+			s.firstRealmID.String(),
+			s.deletedRealmID.String(),
+		},
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.GetResults(), 4)
+
+	assert.Equal(s.T(), s.secondRealmID.String(), res.GetResults()[0].GetId())
+	assert.Equal(s.T(), "Test Realm 2", res.GetResults()[0].GetRealm().GetName())
+	assert.Nil(s.T(), res.GetResults()[0].GetError())
+
+	for _, idx := range []int{1, 3} {
+		result := res.GetResults()[idx]
+		assert.Nil(s.T(), result.GetRealm())
+		require.NotNil(s.T(), result.GetError())
+		assert.Equal(s.T(), uint32(codes.NotFound), result.GetError().GetCode())
+		assert.Equal(s.T(), fmt.Sprintf("not found error occurred: realm with ID %s not found", result.GetId()), result.GetError().GetMessage())
+	}
+	assert.Equal(s.T(), missingRealmID.String(), res.GetResults()[1].GetId())
+	assert.Equal(s.T(), s.deletedRealmID.String(), res.GetResults()[3].GetId())
+
+	assert.Equal(s.T(), s.firstRealmID.String(), res.GetResults()[2].GetId())
+	assert.Equal(s.T(), "Test Realm 1", res.GetResults()[2].GetRealm().GetName())
+}
+
+func (s *BatchGetRealmsTestSuite) Test_BatchGetRealms_WithStatus() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.BatchGetRealms(ctx, &realm_mgr_v1.BatchGetRealmsRequest{
+		Ids:    []string{s.draftRealmID.String(), s.firstRealmID.String()},
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.GetResults(), 2)
+
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, res.GetResults()[0].GetRealm().GetStatus())
+	assert.Equal(s.T(), uint32(codes.NotFound), res.GetResults()[1].GetError().GetCode())
+}
+
+func (s *BatchGetRealmsTestSuite) Test_BatchGetRealms_InvalidArgument() {
+	tooManyIDs := make([]string, maxBatchSize+1)
+	for i := range tooManyIDs {
+		tooManyIDs[i] = uuid.New().String()
+	}
+
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.BatchGetRealmsRequest
+		expectedErrMsg string
+	}{
+		{
+			name:           "no IDs provided",
+			req:            &realm_mgr_v1.BatchGetRealmsRequest{},
+			expectedErrMsg: "invalid BatchGetRealmsRequest.Ids: value must contain at least 1 item(s)",
+		},
+		{
+			name: "malformed ID provided",
+			req: &realm_mgr_v1.BatchGetRealmsRequest{
+				Ids: []string{s.firstRealmID.String(), "not-valid-uuid"},
+			},
+			expectedErrMsg: "invalid BatchGetRealmsRequest.Ids[1]: value must be a valid UUID | caused by: invalid uuid format",
+		},
+		{
+			name: "too many IDs provided",
+			req: &realm_mgr_v1.BatchGetRealmsRequest{
+				Ids: tooManyIDs,
+			},
+			expectedErrMsg: fmt.Sprintf(
+				"an invalid argument error occurred: argument realmIDs cannot contain more than %d IDs",
+				maxBatchSize,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.BatchGetRealms(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *BatchGetRealmsTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.firstRealmID,
+			Name:        "Test Realm 1",
+			Description: "Functional test realm #1",
+			Status:      entities.StatusActive,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.secondRealmID,
+			Name:        "Test Realm 2",
+			Description: "Functional test realm #2",
+			Status:      entities.StatusActive,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.draftRealmID,
+			Name:        "Test Realm 3",
+			Description: "Functional test realm #3",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.deletedRealmID,
+			Name:        "Test Realm 4",
+			Description: "Functional test realm #4",
+			Status:      entities.StatusDeleted,
+			CreatedAt:   time.Date(2022, 10, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+			DeletedAt:   time.Date(2022, 02, 14, 12, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package batchmutaterealms
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerBatchMutateRealmsGRPCSuite(t *testing.T) {
+	testSuite := NewBatchMutateRealmsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type BatchMutateRealmsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	draftRealmID       uuid.UUID
+	activeRealmID      uuid.UUID
+	untouchedRealmID   uuid.UUID
+	untouchedRealmDesc string
+}
+
+func NewBatchMutateRealmsTestSuite(t *testing.T) *BatchMutateRealmsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &BatchMutateRealmsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *BatchMutateRealmsTestSuite) SetupSuite() {
+	s.draftRealmID = uuid.New()
+	s.activeRealmID = uuid.New()
+	s.untouchedRealmID = uuid.New()
+	s.untouchedRealmDesc = "Functional test realm #3"
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *BatchMutateRealmsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *BatchMutateRealmsTestSuite) Test_BatchMutateRealms_Success() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.BatchMutateRealms(ctx, &realm_mgr_v1.BatchMutateRealmsRequest{
+		Mutations: []*realm_mgr_v1.RealmMutation{
+			{
+				Mutation: &realm_mgr_v1.RealmMutation_Create{
+					Create: &realm_mgr_v1.CreateRealmMutation{
+						Name:        "BatchMutateRealmsTestSuite Test",
+						Description: "Created within a batch",
+					},
+				},
+			},
+			{
+				Mutation: &realm_mgr_v1.RealmMutation_Update{
+					Update: &realm_mgr_v1.UpdateRealmMutation{
+						Realm: &realm_mgr_v1.Realm{
+							Id:          s.draftRealmID.String(),
+							Description: "Updated within a batch",
+						},
+						UpdateMask: &fieldmaskpb.FieldMask{
+							Paths: []string{"description"},
+						},
+					},
+				},
+			},
+			{
+				Mutation: &realm_mgr_v1.RealmMutation_Release{
+					Release: &realm_mgr_v1.ReleaseRealmMutation{
+						Id: s.draftRealmID.String(),
+					},
+				},
+			},
+			{
+				Mutation: &realm_mgr_v1.RealmMutation_Disable{
+					Disable: &realm_mgr_v1.DisableRealmMutation{
+						Id:     s.activeRealmID.String(),
+						Reason: "Disabled within a batch",
+					},
+				},
+			},
+		},
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), res.GetError())
+	require.Len(s.T(), res.GetRealms(), 4)
+
+	assert.Equal(s.T(), "BatchMutateRealmsTestSuite Test", res.GetRealms()[0].Name)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, res.GetRealms()[0].Status)
+
+	assert.Equal(s.T(), "Updated within a batch", res.GetRealms()[1].Description)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, res.GetRealms()[1].Status)
+
+	assert.Equal(s.T(), s.draftRealmID.String(), res.GetRealms()[2].Id)
+	assert.Equal(s.T(), "Updated within a batch", res.GetRealms()[2].Description)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE, res.GetRealms()[2].Status)
+
+	assert.Equal(s.T(), s.activeRealmID.String(), res.GetRealms()[3].Id)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED, res.GetRealms()[3].Status)
+}
+
+func (s *BatchMutateRealmsTestSuite) Test_BatchMutateRealms_FailedMutation() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.BatchMutateRealms(ctx, &realm_mgr_v1.BatchMutateRealmsRequest{
+		Mutations: []*realm_mgr_v1.RealmMutation{
+			{
+				Mutation: &realm_mgr_v1.RealmMutation_Update{
+					Update: &realm_mgr_v1.UpdateRealmMutation{
+						Realm: &realm_mgr_v1.Realm{
+							Id:          s.untouchedRealmID.String(),
+							Description: "Never applied",
+						},
+						UpdateMask: &fieldmaskpb.FieldMask{
+							Paths: []string{"description"},
+						},
+					},
+				},
+			},
+			{
+				Mutation: &realm_mgr_v1.RealmMutation_Release{
+					Release: &realm_mgr_v1.ReleaseRealmMutation{
+						Id: uuid.New().String(),
+					},
+				},
+			},
+		},
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), res.GetRealms())
+
+	assert.Equal(s.T(), uint32(1), res.GetFailedIndex())
+	require.NotNil(s.T(), res.GetError())
+	assert.Equal(s.T(), uint32(codes.NotFound), res.GetError().GetCode())
+	assert.Equal(s.T(), "not found error occurred: no releasable realm found with provided ID", res.GetError().GetMessage())
+
+	// the update preceding the failed mutation was rolled back
+	getRes, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.untouchedRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), s.untouchedRealmDesc, getRes.GetRealm().Description)
+}
+
+func (s *BatchMutateRealmsTestSuite) Test_BatchMutateRealms_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.BatchMutateRealmsRequest
+		expectedErrMsg string
+	}{
+		{
+			name:           "no mutations provided",
+			req:            &realm_mgr_v1.BatchMutateRealmsRequest{},
+			expectedErrMsg: "invalid BatchMutateRealmsRequest.Mutations: value must contain between 1 and 100 items, inclusive",
+		},
+		{
+			name: "empty mutation provided",
+			req: &realm_mgr_v1.BatchMutateRealmsRequest{
+				Mutations: []*realm_mgr_v1.RealmMutation{{}},
+			},
+			expectedErrMsg: "invalid BatchMutateRealmsRequest.Mutations[0]: embedded message failed validation | " +
+				"caused by: invalid RealmMutation.Mutation: value is required",
+		},
+		{
+			name: "malformed etag provided",
+			req: &realm_mgr_v1.BatchMutateRealmsRequest{
+				Mutations: []*realm_mgr_v1.RealmMutation{
+					{
+						Mutation: &realm_mgr_v1.RealmMutation_Release{
+							Release: &realm_mgr_v1.ReleaseRealmMutation{
+								Id:   s.draftRealmID.String(),
+								Etag: "not-an-etag",
+							},
+						},
+					},
+				},
+			},
+			expectedErrMsg: "invalid mutation 0: an invalid argument error occurred: argument etag is malformed",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.BatchMutateRealms(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *BatchMutateRealmsTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.draftRealmID,
+			Name:        "Test Realm 1",
+			Description: "Functional test realm #1",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.activeRealmID,
+			Name:        "Test Realm 2",
+			Description: "Functional test realm #2",
+			Status:      entities.StatusActive,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.untouchedRealmID,
+			Name:        "Test Realm 3",
+			Description: s.untouchedRealmDesc,
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package clonerealm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerCloneRealmGRPCSuite(t *testing.T) {
+	testSuite := NewCloneRealmTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type CloneRealmTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	parentRealmID   uuid.UUID
+	activeRealmID   uuid.UUID
+	disabledRealmID uuid.UUID
+}
+
+func NewCloneRealmTestSuite(t *testing.T) *CloneRealmTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &CloneRealmTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *CloneRealmTestSuite) SetupSuite() {
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *CloneRealmTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *CloneRealmTestSuite) Test_CloneRealm_Success() {
+	testCases := []struct {
+		name                string
+		sourceStatus        realm_mgr_v1.EnumStatus
+		expectedDescription string
+	}{
+		{
+			name:                "clone active realm",
+			expectedDescription: "Active description",
+		},
+		{
+			name:                "clone draft realm",
+			sourceStatus:        realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+			expectedDescription: "Draft description",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.CloneRealm(ctx, &realm_mgr_v1.CloneRealmRequest{
+				SourceId:     s.activeRealmID.String(),
+				SourceStatus: tc.sourceStatus,
+				Name:         "CloneRealmTestSuite Clone",
+			})
+
+			// assert
+			require.NoError(t, err)
+			require.NotNil(t, res.GetRealm())
+
+			clone := res.GetRealm()
+			assert.NotEqual(t, s.activeRealmID.String(), clone.Id)
+			assert.Equal(t, "CloneRealmTestSuite Clone", clone.Name)
+			assert.Equal(t, tc.expectedDescription, clone.Description)
+			assert.Equal(t, realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, clone.Status)
+			assert.Equal(t, map[string]string{"env": "prod"}, clone.Labels)
+			assert.Equal(t, map[string]interface{}{
+				"ownership": map[string]interface{}{"team": "payments"},
+			}, clone.GetAttributes().AsMap())
+			assert.Equal(t, s.parentRealmID.String(), clone.ParentId)
+			assert.Equal(t, s.activeRealmID.String(), clone.ClonedFrom)
+
+			draft, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+				Id:     clone.Id,
+				Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, clone.Name, draft.GetRealm().Name)
+			assert.Equal(t, s.activeRealmID.String(), draft.GetRealm().ClonedFrom)
+		})
+	}
+}
+
+func (s *CloneRealmTestSuite) Test_CloneRealm_ValidateOnly() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.CloneRealm(ctx, &realm_mgr_v1.CloneRealmRequest{
+		SourceId:     s.activeRealmID.String(),
+		Name:         "CloneRealmTestSuite Dry Run",
+		ValidateOnly: true,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+
+	_, err = s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     res.GetRealm().Id,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+}
+
+func (s *CloneRealmTestSuite) Test_CloneRealm_Failure() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.CloneRealmRequest
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "source not found",
+			req: &realm_mgr_v1.CloneRealmRequest{
+				SourceId: "5c0f4f8e-1b52-4d62-a1c6-2a6b3fb4a7e1",
+				Name:     "CloneRealmTestSuite Clone",
+			},
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: "realm with ID not found: 5c0f4f8e-1b52-4d62-a1c6-2a6b3fb4a7e1",
+		},
+		{
+			name: "source not active",
+			req: &realm_mgr_v1.CloneRealmRequest{
+				SourceId: s.disabledRealmID.String(),
+				Name:     "CloneRealmTestSuite Clone",
+			},
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: fmt.Sprintf("realm with ID not found: %s", s.disabledRealmID),
+		},
+		{
+			name: "disabled source status",
+			req: &realm_mgr_v1.CloneRealmRequest{
+				SourceId:     s.disabledRealmID.String(),
+				SourceStatus: realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED,
+				Name:         "CloneRealmTestSuite Clone",
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "an invalid argument error occurred: argument sourceStatus must be active or draft",
+		},
+		{
+			name: "missing name",
+			req: &realm_mgr_v1.CloneRealmRequest{
+				SourceId: s.activeRealmID.String(),
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "invalid CloneRealmRequest.Name: value length must be at least 1 runes",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.CloneRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, tc.expectedCode, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *CloneRealmTestSuite) populateTestData() error {
+	s.parentRealmID = uuid.New()
+	s.activeRealmID = uuid.New()
+	s.disabledRealmID = uuid.New()
+
+	createdAt := time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC)
+	updatedAt := time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC)
+
+	attributes := map[string]interface{}{
+		"ownership": map[string]interface{}{"team": "payments"},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(
+		entities.Realm{
+			ID:        s.parentRealmID,
+			Name:      "Parent Realm",
+			Status:    entities.StatusActive,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		},
+		entities.Realm{
+			ID:          s.activeRealmID,
+			Name:        "Source Realm",
+			Description: "Active description",
+			Status:      entities.StatusActive,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+			Labels:      map[string]string{"env": "prod"},
+			Attributes:  attributes,
+			ParentID:    s.parentRealmID,
+		},
+		entities.Realm{
+			ID:          s.activeRealmID,
+			Name:        "Source Realm",
+			Description: "Draft description",
+			Status:      entities.StatusDraft,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+			Labels:      map[string]string{"env": "prod"},
+			Attributes:  attributes,
+			ParentID:    s.parentRealmID,
+		},
+		entities.Realm{
+			ID:        s.disabledRealmID,
+			Name:      "Disabled Realm",
+			Status:    entities.StatusDisabled,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	return err
+}
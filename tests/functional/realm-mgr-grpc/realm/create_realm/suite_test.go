@@ -100,6 +100,37 @@ func (s *CreateRealmTestSuite) Test_CreateRealm_Success() {
 	}
 }
 
+func (s *CreateRealmTestSuite) Test_CreateRealm_ValidateOnly() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name:         "CreateRealmTestSuite Validate",
+		Description:  "Test realm that is never created",
+		ValidateOnly: true,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), "CreateRealmTestSuite Validate", res.GetRealm().Name)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, res.GetRealm().Status)
+
+	_, err = s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     res.GetRealm().Id,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+}
+
 func (s *CreateRealmTestSuite) Test_CreateRealm_InvalidArgument() {
 	testCases := []struct {
 		name           string
@@ -0,0 +1,210 @@
+package deleterealm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/postgres/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerDeleteRealmGRPCSuite(t *testing.T) {
+	testSuite := NewDeleteRealmTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type DeleteRealmTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+}
+
+func NewDeleteRealmTestSuite(t *testing.T) *DeleteRealmTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &DeleteRealmTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *DeleteRealmTestSuite) TearDownTest() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *DeleteRealmTestSuite) Test_DeleteRealm_SoftDelete() {
+	// arrange
+	realmID := uuid.New()
+	require.NoError(s.T(), s.populateRealm(realmID, entities.StatusActive, entities.StatusDraft))
+
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.DeleteRealm(ctx, &realm_mgr_v1.DeleteRealmRequest{
+		Id:     realmID.String(),
+		Reason: "functional test",
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res)
+
+	realms, err := s.db.GetRealms(utils.GetRealmsQuery().Where(sq.Eq{models.RealmColumnID.String(): realmID}))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), realms, 2)
+	for _, realm := range realms {
+		assert.Equal(s.T(), entities.Status(entities.StatusDeleted), realm.Status)
+		assert.False(s.T(), realm.DeletedAt.IsZero())
+	}
+
+	_, err = s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{Id: realmID.String()})
+	s.assertGRPCCode(err, codes.NotFound)
+
+	listRes, err := s.client.ListRealms(ctx, &realm_mgr_v1.ListRealmsRequest{})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), listRes.GetRealms())
+
+	records, err := s.db.GetAuditRecords(utils.GetAuditRecordsQuery(realmID))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), records, 1)
+	assert.Equal(s.T(), entities.AuditActionDelete, records[0].Action)
+	assert.Equal(s.T(), "functional test", records[0].Reason)
+}
+
+func (s *DeleteRealmTestSuite) Test_DeleteRealm_Force() {
+	// arrange
+	realmID := uuid.New()
+	require.NoError(s.T(), s.populateRealm(realmID, entities.StatusDisabled))
+
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.DeleteRealm(ctx, &realm_mgr_v1.DeleteRealmRequest{
+		Id:    realmID.String(),
+		Force: true,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res)
+
+	realms, err := s.db.GetRealms(utils.GetRealmsQuery().Where(sq.Eq{models.RealmColumnID.String(): realmID}))
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), realms)
+
+	records, err := s.db.GetAuditRecords(utils.GetAuditRecordsQuery(realmID))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), records, 1)
+	assert.Equal(s.T(), entities.AuditActionPurge, records[0].Action)
+}
+
+func (s *DeleteRealmTestSuite) Test_DeleteRealm_NotFound() {
+	deletedRealmID := uuid.New()
+	require.NoError(s.T(), s.populateRealm(deletedRealmID, entities.StatusDeleted))
+
+	testCases := []struct {
+		name string
+		req  *realm_mgr_v1.DeleteRealmRequest
+	}{
+		{
+			name: "non-existing realm",
+			req: &realm_mgr_v1.DeleteRealmRequest{
+				Id: uuid.New().String(),
+			},
+		},
+		{
+			name: "non-existing realm with force",
+			req: &realm_mgr_v1.DeleteRealmRequest{
+				Id:    uuid.New().String(),
+				Force: true,
+			},
+		},
+		{
+			name: "already deleted realm",
+			req: &realm_mgr_v1.DeleteRealmRequest{
+				Id: deletedRealmID.String(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.DeleteRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.NotFound, gRPCError.Code())
+			assert.Equal(t, fmt.Sprintf("realm with ID not found: %s", tc.req.Id), gRPCError.Message())
+		})
+	}
+}
+
+func (s *DeleteRealmTestSuite) assertGRPCCode(err error, code codes.Code) {
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), code, gRPCError.Code())
+}
+
+func (s *DeleteRealmTestSuite) populateRealm(realmID uuid.UUID, statuses ...entities.Status) error {
+	realms := make([]entities.Realm, 0, len(statuses))
+	for _, realmStatus := range statuses {
+		realms = append(realms, entities.Realm{
+			ID:          realmID,
+			Name:        "Test Realm",
+			Description: "Functional test realm",
+			Status:      realmStatus,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		})
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package diffrealm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerDiffRealmGRPCSuite(t *testing.T) {
+	testSuite := NewDiffRealmTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type DiffRealmTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	realmID uuid.UUID
+}
+
+func NewDiffRealmTestSuite(t *testing.T) *DiffRealmTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &DiffRealmTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *DiffRealmTestSuite) SetupSuite() {
+	s.realmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *DiffRealmTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *DiffRealmTestSuite) Test_DiffRealm_Success() {
+	testCases := []struct {
+		name            string
+		req             *realm_mgr_v1.DiffRealmRequest
+		expectedChanges []*realm_mgr_v1.RealmFieldChange
+	}{
+		{
+			name: "diff active and draft realm by default",
+			req: &realm_mgr_v1.DiffRealmRequest{
+				Id: s.realmID.String(),
+			},
+			expectedChanges: []*realm_mgr_v1.RealmFieldChange{
+				{
+					Path:     "description",
+					OldValue: "Functional test realm #1",
+					NewValue: "Functional test realm #1 with pending changes",
+				},
+			},
+		},
+		{
+			name: "diff draft and active realm",
+			req: &realm_mgr_v1.DiffRealmRequest{
+				Id: s.realmID.String(),
+				From: &realm_mgr_v1.RealmVersion{
+					Version: &realm_mgr_v1.RealmVersion_Status{Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT},
+				},
+				To: &realm_mgr_v1.RealmVersion{
+					Version: &realm_mgr_v1.RealmVersion_Status{Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE},
+				},
+			},
+			expectedChanges: []*realm_mgr_v1.RealmFieldChange{
+				{
+					Path:     "description",
+					OldValue: "Functional test realm #1 with pending changes",
+					NewValue: "Functional test realm #1",
+				},
+			},
+		},
+		{
+			name: "diff realm with itself",
+			req: &realm_mgr_v1.DiffRealmRequest{
+				Id: s.realmID.String(),
+				To: &realm_mgr_v1.RealmVersion{
+					Version: &realm_mgr_v1.RealmVersion_Status{Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE},
+				},
+			},
+			expectedChanges: []*realm_mgr_v1.RealmFieldChange{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.DiffRealm(ctx, tc.req)
+
+			// assert
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			require.Len(t, res.GetChanges(), len(tc.expectedChanges))
+			for i, expectedChange := range tc.expectedChanges {
+				assert.Equal(t, expectedChange.Path, res.GetChanges()[i].Path)
+				assert.Equal(t, expectedChange.OldValue, res.GetChanges()[i].OldValue)
+				assert.Equal(t, expectedChange.NewValue, res.GetChanges()[i].NewValue)
+			}
+		})
+	}
+}
+
+func (s *DiffRealmTestSuite) Test_DiffRealm_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.DiffRealmRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "malformed ID provided",
+			req: &realm_mgr_v1.DiffRealmRequest{
+				Id: "not-valid-uuid",
+			},
+			expectedErrMsg: "invalid DiffRealmRequest.Id: value must be a valid UUID | caused by: invalid uuid format",
+		},
+		{
+			name: "disabled status provided",
+			req: &realm_mgr_v1.DiffRealmRequest{
+				Id: s.realmID.String(),
+				From: &realm_mgr_v1.RealmVersion{
+					Version: &realm_mgr_v1.RealmVersion_Status{Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED},
+				},
+			},
+			expectedErrMsg: "invalid DiffRealmRequest.From: embedded message failed validation | " +
+				"caused by: invalid RealmVersion.Status: value must be in list [1 2]",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.DiffRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *DiffRealmTestSuite) Test_DiffRealm_NotFound() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.DiffRealmRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "non-existing realm",
+			req: &realm_mgr_v1.DiffRealmRequest{
+				Id: uuid.Nil.String(),
+			},
+			expectedErrMsg: fmt.Sprintf("not found error occurred: active of realm with ID %s not found", uuid.Nil),
+		},
+		{
+			name: "non-existing revision",
+			req: &realm_mgr_v1.DiffRealmRequest{
+				Id: s.realmID.String(),
+				From: &realm_mgr_v1.RealmVersion{
+					Version: &realm_mgr_v1.RealmVersion_Revision{Revision: 999},
+				},
+			},
+			expectedErrMsg: fmt.Sprintf("not found error occurred: revision 999 of realm with ID %s not found", s.realmID),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.DiffRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.NotFound, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *DiffRealmTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.realmID,
+			Name:        "Test Realm 1",
+			Description: "Functional test realm #1",
+			Status:      entities.StatusActive,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.realmID,
+			Name:        "Test Realm 1",
+			Description: "Functional test realm #1 with pending changes",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 14, 12, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package discarddraft
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerDiscardDraftGRPCSuite(t *testing.T) {
+	testSuite := NewDiscardDraftTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type DiscardDraftTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	activeWithDraftRealmID uuid.UUID
+	unreleasedRealmID      uuid.UUID
+}
+
+func NewDiscardDraftTestSuite(t *testing.T) *DiscardDraftTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &DiscardDraftTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *DiscardDraftTestSuite) SetupTest() {
+	s.activeWithDraftRealmID = uuid.New()
+	s.unreleasedRealmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *DiscardDraftTestSuite) TearDownTest() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *DiscardDraftTestSuite) Test_DiscardDraft_Success() {
+	testCases := []struct {
+		name             string
+		req              *realm_mgr_v1.DiscardDraftRequest
+		realmID          uuid.UUID
+		expectedStatuses []entities.Status
+	}{
+		{
+			name: "discard draft of released realm",
+			req: &realm_mgr_v1.DiscardDraftRequest{
+				Id: s.activeWithDraftRealmID.String(),
+			},
+			realmID:          s.activeWithDraftRealmID,
+			expectedStatuses: []entities.Status{entities.StatusActive},
+		},
+		{
+			name: "discard draft of never released realm",
+			req: &realm_mgr_v1.DiscardDraftRequest{
+				Id:          s.unreleasedRealmID.String(),
+				DeleteRealm: true,
+			},
+			realmID:          s.unreleasedRealmID,
+			expectedStatuses: []entities.Status{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.DiscardDraft(ctx, tc.req)
+
+			// assert
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			realms, err := s.db.GetRealms(utils.GetRealmsQuery().Where("id = ?", tc.realmID))
+			require.NoError(t, err)
+
+			statuses := make([]entities.Status, 0, len(realms))
+			for _, realm := range realms {
+				statuses = append(statuses, realm.Status)
+			}
+			assert.Equal(t, tc.expectedStatuses, statuses)
+		})
+	}
+}
+
+func (s *DiscardDraftTestSuite) Test_DiscardDraft_FailedPrecondition() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.DiscardDraft(ctx, &realm_mgr_v1.DiscardDraftRequest{
+		Id: s.unreleasedRealmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.FailedPrecondition, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"failed precondition error occurred: realm with ID %s was never released, discarding its draft would delete the realm",
+			s.unreleasedRealmID,
+		),
+		gRPCError.Message(),
+	)
+}
+
+func (s *DiscardDraftTestSuite) Test_DiscardDraft_InvalidArgument() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.DiscardDraft(ctx, &realm_mgr_v1.DiscardDraftRequest{
+		Id: "not-valid-uuid",
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.InvalidArgument, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		"invalid DiscardDraftRequest.Id: value must be a valid UUID | caused by: invalid uuid format",
+		gRPCError.Message(),
+	)
+}
+
+func (s *DiscardDraftTestSuite) Test_DiscardDraft_NotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realmID := uuid.New()
+
+	// act
+	res, err := s.client.DiscardDraft(ctx, &realm_mgr_v1.DiscardDraftRequest{
+		Id: realmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("draft realm with ID not found: %s", realmID), gRPCError.Message())
+}
+
+func (s *DiscardDraftTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.activeWithDraftRealmID,
+			Name:        "Active Realm With Draft",
+			Description: "Functional test active realm",
+			Status:      entities.StatusActive,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.activeWithDraftRealmID,
+			Name:        "Active Realm With Draft",
+			Description: "Functional test abandoned draft",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 02, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.unreleasedRealmID,
+			Name:        "Unreleased Realm",
+			Description: "Functional test realm that was never released",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package enablerealm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerEnableRealmGRPCSuite(t *testing.T) {
+	testSuite := NewEnableRealmTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type EnableRealmTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	disabledRealmID          uuid.UUID
+	activeRealmID            uuid.UUID
+	disabledWithDraftRealmID uuid.UUID
+}
+
+func NewEnableRealmTestSuite(t *testing.T) *EnableRealmTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &EnableRealmTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *EnableRealmTestSuite) SetupSuite() {
+	s.disabledRealmID = uuid.New()
+	s.activeRealmID = uuid.New()
+	s.disabledWithDraftRealmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *EnableRealmTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *EnableRealmTestSuite) Test_EnableRealm_Success() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.EnableRealm(ctx, &realm_mgr_v1.EnableRealmRequest{
+		Id:     s.disabledRealmID.String(),
+		Reason: "functional test",
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), s.disabledRealmID.String(), res.GetRealm().Id)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE, res.GetRealm().Status)
+	assert.True(s.T(), res.GetRealm().UpdatedAt.AsTime().After(res.GetRealm().CreatedAt.AsTime()))
+
+	records, err := s.db.GetAuditRecords(utils.GetAuditRecordsQuery(s.disabledRealmID))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), records, 1)
+	assert.Equal(s.T(), entities.AuditActionEnable, records[0].Action)
+	assert.Equal(s.T(), "functional test", records[0].Reason)
+}
+
+func (s *EnableRealmTestSuite) Test_EnableRealm_FailedPrecondition() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.EnableRealmRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "realm already active",
+			req: &realm_mgr_v1.EnableRealmRequest{
+				Id:     s.activeRealmID.String(),
+				Reason: "functional test",
+			},
+			expectedErrMsg: fmt.Sprintf("failed precondition error occurred: realm with ID %s is already active", s.activeRealmID),
+		},
+		{
+			name: "realm with pending draft",
+			req: &realm_mgr_v1.EnableRealmRequest{
+				Id:     s.disabledWithDraftRealmID.String(),
+				Reason: "functional test",
+			},
+			expectedErrMsg: fmt.Sprintf("failed precondition error occurred: realm with ID %s has a pending draft", s.disabledWithDraftRealmID),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.EnableRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.FailedPrecondition, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *EnableRealmTestSuite) Test_EnableRealm_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.EnableRealmRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "malformed ID provided",
+			req: &realm_mgr_v1.EnableRealmRequest{
+				Id:     "not-valid-uuid",
+				Reason: "functional test",
+			},
+			expectedErrMsg: "invalid EnableRealmRequest.Id: value must be a valid UUID | caused by: invalid uuid format",
+		},
+		{
+			name: "no reason provided",
+			req: &realm_mgr_v1.EnableRealmRequest{
+				Id: uuid.New().String(),
+			},
+			expectedErrMsg: "invalid EnableRealmRequest.Reason: value length must be between 1 and 500 runes, inclusive",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.EnableRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *EnableRealmTestSuite) Test_EnableRealm_NotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realmID := uuid.New()
+
+	// act
+	res, err := s.client.EnableRealm(ctx, &realm_mgr_v1.EnableRealmRequest{
+		Id:     realmID.String(),
+		Reason: "functional test",
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("realm with ID not found: %s", realmID), gRPCError.Message())
+}
+
+func (s *EnableRealmTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.disabledRealmID,
+			Name:        "Disabled Realm",
+			Description: "Functional test disabled realm",
+			Status:      entities.StatusDisabled,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.activeRealmID,
+			Name:        "Active Realm",
+			Description: "Functional test active realm",
+			Status:      entities.StatusActive,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.disabledWithDraftRealmID,
+			Name:        "Disabled Realm With Draft",
+			Description: "Functional test disabled realm with a pending draft",
+			Status:      entities.StatusDisabled,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.disabledWithDraftRealmID,
+			Name:        "Disabled Realm With Draft",
+			Description: "Functional test pending draft",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 02, 13, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
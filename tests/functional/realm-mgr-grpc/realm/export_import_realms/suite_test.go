@@ -0,0 +1,544 @@
+package exportimportrealms
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerExportImportRealmsGRPCSuite(t *testing.T) {
+	testSuite := NewExportImportRealmsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type ExportImportRealmsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+}
+
+func NewExportImportRealmsTestSuite(t *testing.T) *ExportImportRealmsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &ExportImportRealmsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *ExportImportRealmsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *ExportImportRealmsTestSuite) Test_ExportRealms() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	draftRes, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: "Exported Draft Realm",
+		Slug: "exported-draft-realm",
+	})
+	require.NoError(s.T(), err)
+	draftID := draftRes.GetRealm().Id
+
+	releasedRes, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name:   "Exported Released Realm",
+		Slug:   "exported-released-realm",
+		Labels: map[string]string{"env": "test"},
+	})
+	require.NoError(s.T(), err)
+	releasedID := releasedRes.GetRealm().Id
+
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{Id: releasedID})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          releasedID,
+			Name:        "Exported Released Realm",
+			Description: "Pending changes",
+		},
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	withDrafts := s.exportRealms(ctx, true)
+	withoutDrafts := s.exportRealms(ctx, false)
+
+	// assert
+	require.Contains(s.T(), withDrafts, draftID)
+	draftRecord := withDrafts[draftID]
+	assert.Equal(s.T(), uint32(1), draftRecord.FormatVersion)
+	assert.Nil(s.T(), draftRecord.Released)
+	require.NotNil(s.T(), draftRecord.Draft)
+	assert.Equal(s.T(), "Exported Draft Realm", draftRecord.Draft.Name)
+	assert.Equal(s.T(), "exported-draft-realm", draftRecord.Draft.Slug)
+
+	require.Contains(s.T(), withDrafts, releasedID)
+	releasedRecord := withDrafts[releasedID]
+	require.NotNil(s.T(), releasedRecord.Released)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE, releasedRecord.Released.Status)
+	assert.Empty(s.T(), releasedRecord.Released.Description)
+	assert.Equal(s.T(), map[string]string{"env": "test"}, releasedRecord.Released.Labels)
+	require.NotNil(s.T(), releasedRecord.Draft)
+	assert.Equal(s.T(), "Pending changes", releasedRecord.Draft.Description)
+
+	assert.NotContains(s.T(), withoutDrafts, draftID)
+	require.Contains(s.T(), withoutDrafts, releasedID)
+	assert.NotNil(s.T(), withoutDrafts[releasedID].Released)
+	assert.Nil(s.T(), withoutDrafts[releasedID].Draft)
+}
+
+func (s *ExportImportRealmsTestSuite) Test_ImportRealms_ConflictPolicies() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	releasedID := uuid.New().String()
+	draftID := uuid.New().String()
+	records := []*realm_mgr_v1.RealmExportRecord{
+		{
+			FormatVersion: 1,
+			Released:      makeRealm(releasedID, "Imported Realm", "imported-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE),
+			Draft:         makeRealm(releasedID, "Imported Realm Draft", "imported-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+		{
+			FormatVersion: 1,
+			Draft:         makeRealm(draftID, "Imported Draft", "imported-draft", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+	}
+
+	// act
+	created, err := s.importRealms(ctx, realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_UNSPECIFIED, records...)
+	require.NoError(s.T(), err)
+
+	skipped, err := s.importRealms(ctx, realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_SKIP, records...)
+	require.NoError(s.T(), err)
+
+	records[1].Draft.Name = "Imported Draft Overwritten"
+	updated, err := s.importRealms(ctx, realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_OVERWRITE, records[1])
+	require.NoError(s.T(), err)
+
+	// assert
+	assert.Equal(s.T(), uint32(2), created.CreatedCount)
+	assert.False(s.T(), created.Aborted)
+	require.Len(s.T(), created.Results, 2)
+	assert.Equal(s.T(), releasedID, created.Results[0].RealmId)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_CREATED, created.Results[0].Outcome)
+	assert.Equal(s.T(), draftID, created.Results[1].RealmId)
+
+	assert.Equal(s.T(), uint32(2), skipped.SkippedCount)
+	assert.Equal(s.T(), uint32(0), skipped.CreatedCount)
+
+	assert.Equal(s.T(), uint32(1), updated.UpdatedCount)
+	require.Len(s.T(), updated.Results, 1)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_UPDATED, updated.Results[0].Outcome)
+
+	activeRes, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     releasedID,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE,
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "Imported Realm", activeRes.GetRealm().Name)
+	assert.Equal(s.T(), "imported-realm", activeRes.GetRealm().Slug)
+	assert.Equal(s.T(), "3", activeRes.GetRealm().Etag)
+
+	draftRes, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     draftID,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "Imported Draft Overwritten", draftRes.GetRealm().Name)
+}
+
+func (s *ExportImportRealmsTestSuite) Test_ImportRealms_OverwriteReleasedRealm() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	createRes, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: "Released Before Import",
+	})
+	require.NoError(s.T(), err)
+	realmID := createRes.GetRealm().GetId()
+
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          realmID,
+			Description: "Changed before import",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"description"},
+		},
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	history, err := s.client.ListRealmRevisions(ctx, &realm_mgr_v1.ListRealmRevisionsRequest{Id: realmID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), history.GetRevisions(), 2)
+	latestEtag := history.GetRevisions()[0].GetRealm().GetEtag()
+
+	// the imported copies carry the revision of the latest release, which is taken already
+	released := makeRealm(realmID, "Imported Over Released", "imported-over-released", realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE)
+	released.Etag = latestEtag
+	draft := makeRealm(realmID, "Imported Over Released Draft", "imported-over-released", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT)
+	draft.Etag = latestEtag
+
+	importRes, err := s.importRealms(
+		ctx,
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_OVERWRITE,
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Released:      released,
+			Draft:         draft,
+		},
+	)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(1), importRes.UpdatedCount)
+
+	// act
+	releaseRes, err := s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{Id: realmID})
+
+	// assert
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "Imported Over Released Draft", releaseRes.GetRealm().GetName())
+
+	history, err = s.client.ListRealmRevisions(ctx, &realm_mgr_v1.ListRealmRevisionsRequest{Id: realmID})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), history.GetRevisions(), 3)
+	assert.Equal(s.T(), "Imported Over Released Draft", history.GetRevisions()[0].GetRealm().GetName())
+	assert.Equal(s.T(), releaseRes.GetRealm().GetEtag(), history.GetRevisions()[0].GetRealm().GetEtag())
+}
+
+func (s *ExportImportRealmsTestSuite) Test_ImportRealms_FailPolicyAborts() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	existingID := uuid.New().String()
+	_, err = s.importRealms(
+		ctx,
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_FAIL,
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm(existingID, "Existing Realm", "existing-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+	)
+	require.NoError(s.T(), err)
+
+	rolledBackID := uuid.New().String()
+	unreadID := uuid.New().String()
+
+	// act
+	res, err := s.importRealms(
+		ctx,
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_FAIL,
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm(rolledBackID, "Rolled Back Realm", "rolled-back-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm(existingID, "Existing Realm", "existing-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm(unreadID, "Unread Realm", "unread-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+	)
+
+	// assert
+	require.NoError(s.T(), err)
+	assert.True(s.T(), res.Aborted)
+	assert.Equal(s.T(), uint32(2), res.RejectedCount)
+	require.Len(s.T(), res.Results, 2)
+	assert.Equal(s.T(), rolledBackID, res.Results[0].RealmId)
+	assert.Equal(s.T(), uint32(codes.Aborted), res.Results[0].Error.Code)
+	assert.Equal(s.T(), existingID, res.Results[1].RealmId)
+	assert.Equal(s.T(), uint32(codes.FailedPrecondition), res.Results[1].Error.Code)
+	assert.Equal(
+		s.T(),
+		"failed precondition error occurred: realm with ID "+existingID+" already exists",
+		res.Results[1].Error.Message,
+	)
+
+	for _, realmID := range []string{rolledBackID, unreadID} {
+		_, getErr := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+			Id:     realmID,
+			Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+		})
+		require.Error(s.T(), getErr)
+
+		gRPCError, ok := status.FromError(getErr)
+		require.True(s.T(), ok)
+		assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	}
+}
+
+func (s *ExportImportRealmsTestSuite) Test_ImportRealms_RejectedRecords() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	_, err = s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: "Slug Owner",
+		Slug: "taken-import-slug",
+	})
+	require.NoError(s.T(), err)
+
+	importedID := uuid.New().String()
+
+	// act
+	res, err := s.importRealms(
+		ctx,
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_SKIP,
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 2,
+			Draft:         makeRealm(uuid.New().String(), "Future Realm", "future-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm(uuid.New().String(), "Slug Thief", "taken-import-slug", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Released:      makeRealm(uuid.New().String(), "Draft As Released", "draft-as-released", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm(importedID, "Imported Anyway", "imported-anyway", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+	)
+
+	// assert
+	require.NoError(s.T(), err)
+	assert.False(s.T(), res.Aborted)
+	assert.Equal(s.T(), uint32(3), res.RejectedCount)
+	assert.Equal(s.T(), uint32(1), res.CreatedCount)
+	require.Len(s.T(), res.Results, 4)
+
+	expectedErrors := []*realm_mgr_v1.BatchError{
+		{
+			Code:    uint32(codes.InvalidArgument),
+			Message: "an invalid argument error occurred: argument formatVersion must be 1",
+		},
+		{
+			Code:    uint32(codes.FailedPrecondition),
+			Message: "failed precondition error occurred: realm slug taken-import-slug is already in use",
+		},
+		{
+			Code:    uint32(codes.InvalidArgument),
+			Message: "an invalid argument error occurred: argument released must be either active or disabled",
+		},
+	}
+	for i, expected := range expectedErrors {
+		assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_REJECTED, res.Results[i].Outcome)
+		assert.Equal(s.T(), expected.Code, res.Results[i].Error.Code)
+		assert.Equal(s.T(), expected.Message, res.Results[i].Error.Message)
+	}
+
+	assert.Equal(s.T(), importedID, res.Results[3].RealmId)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_CREATED, res.Results[3].Outcome)
+	assert.Nil(s.T(), res.Results[3].Error)
+}
+
+func (s *ExportImportRealmsTestSuite) Test_ImportRealms_MalformedRecord() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	firstID := uuid.New().String()
+	lastID := uuid.New().String()
+
+	// act
+	res, err := s.importRealms(
+		ctx,
+		realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_FAIL,
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm(firstID, "Before Malformed", "before-malformed", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm("not-a-uuid", "Invalid Realm", "invalid-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+		&realm_mgr_v1.RealmExportRecord{
+			FormatVersion: 1,
+			Draft:         makeRealm(lastID, "After Malformed", "after-malformed", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+		},
+	)
+
+	// assert
+	require.NoError(s.T(), err)
+	assert.False(s.T(), res.Aborted)
+	assert.Equal(s.T(), uint32(2), res.CreatedCount)
+	assert.Equal(s.T(), uint32(1), res.RejectedCount)
+	require.Len(s.T(), res.Results, 3)
+
+	assert.Equal(s.T(), firstID, res.Results[0].RealmId)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_CREATED, res.Results[0].Outcome)
+
+	assert.Empty(s.T(), res.Results[1].RealmId)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_REJECTED, res.Results[1].Outcome)
+	require.NotNil(s.T(), res.Results[1].Error)
+	assert.Equal(s.T(), uint32(codes.InvalidArgument), res.Results[1].Error.Code)
+	assert.Equal(
+		s.T(),
+		"an invalid argument error occurred: argument record is invalid: "+
+			"invalid ImportRealmsRequest.Record: embedded message failed validation | "+
+			"caused by: invalid RealmExportRecord.Draft: embedded message failed validation | "+
+			"caused by: invalid Realm.Id: value must be a valid UUID | caused by: invalid uuid format",
+		res.Results[1].Error.Message,
+	)
+
+	assert.Equal(s.T(), lastID, res.Results[2].RealmId)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_CREATED, res.Results[2].Outcome)
+}
+
+func (s *ExportImportRealmsTestSuite) Test_ImportRealms_ValidateOnly() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	createdID := uuid.New().String()
+
+	// act
+	res, err := s.sendImportRequests(
+		ctx,
+		&realm_mgr_v1.ImportRealmsRequest{
+			ConflictPolicy: realm_mgr_v1.EnumImportConflictPolicy_ENUM_IMPORT_CONFLICT_POLICY_FAIL,
+			ValidateOnly:   true,
+			Record: &realm_mgr_v1.RealmExportRecord{
+				FormatVersion: 1,
+				Draft:         makeRealm(createdID, "Validated Realm", "validated-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+			},
+		},
+		&realm_mgr_v1.ImportRealmsRequest{
+			Record: &realm_mgr_v1.RealmExportRecord{
+				FormatVersion: 2,
+				Draft:         makeRealm(uuid.New().String(), "Future Realm", "validated-future-realm", realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT),
+			},
+		},
+	)
+
+	// assert
+	require.NoError(s.T(), err)
+	assert.False(s.T(), res.Aborted)
+	assert.Equal(s.T(), uint32(1), res.CreatedCount)
+	assert.Equal(s.T(), uint32(1), res.RejectedCount)
+	require.Len(s.T(), res.Results, 2)
+	assert.Equal(s.T(), createdID, res.Results[0].RealmId)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_CREATED, res.Results[0].Outcome)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmImportOutcome_ENUM_REALM_IMPORT_OUTCOME_REJECTED, res.Results[1].Outcome)
+
+	_, err = s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     createdID,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+}
+
+// exportRealms exports all realms and returns their records by realm ID.
+func (s *ExportImportRealmsTestSuite) exportRealms(
+	ctx context.Context,
+	includeDrafts bool,
+) map[string]*realm_mgr_v1.RealmExportRecord {
+	stream, err := s.client.ExportRealms(ctx, &realm_mgr_v1.ExportRealmsRequest{
+		IncludeDrafts: includeDrafts,
+	})
+	require.NoError(s.T(), err)
+
+	records := make(map[string]*realm_mgr_v1.RealmExportRecord)
+	for {
+		res, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			return records
+		}
+		require.NoError(s.T(), recvErr)
+
+		record := res.GetRecord()
+		realmID := record.GetReleased().GetId()
+		if realmID == "" {
+			realmID = record.GetDraft().GetId()
+		}
+		require.NotContains(s.T(), records, realmID, "realm exported twice")
+		records[realmID] = record
+	}
+}
+
+func (s *ExportImportRealmsTestSuite) importRealms(
+	ctx context.Context,
+	conflictPolicy realm_mgr_v1.EnumImportConflictPolicy,
+	records ...*realm_mgr_v1.RealmExportRecord,
+) (*realm_mgr_v1.ImportRealmsResponse, error) {
+	requests := make([]*realm_mgr_v1.ImportRealmsRequest, 0, len(records))
+	for _, record := range records {
+		requests = append(requests, &realm_mgr_v1.ImportRealmsRequest{
+			Record:         record,
+			ConflictPolicy: conflictPolicy,
+		})
+	}
+	return s.sendImportRequests(ctx, requests...)
+}
+
+func (s *ExportImportRealmsTestSuite) sendImportRequests(
+	ctx context.Context,
+	requests ...*realm_mgr_v1.ImportRealmsRequest,
+) (*realm_mgr_v1.ImportRealmsResponse, error) {
+	stream, err := s.client.ImportRealms(ctx)
+	require.NoError(s.T(), err)
+
+	for _, req := range requests {
+		sendErr := stream.Send(req)
+		if errors.Is(sendErr, io.EOF) {
+			// the server ended the import early, its outcome is returned by CloseAndRecv
+			break
+		}
+		require.NoError(s.T(), sendErr)
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func makeRealm(id, name, slug string, realmStatus realm_mgr_v1.EnumStatus) *realm_mgr_v1.Realm {
+	return &realm_mgr_v1.Realm{
+		Id:     id,
+		Name:   name,
+		Slug:   slug,
+		Status: realmStatus,
+		Etag:   "3",
+	}
+}
@@ -330,5 +330,6 @@ func (s *GetRealmTestSuite) realmToGRPC(realm *entities.Realm) *realm_mgr_v1.Rea
 		Status:      grpcStatus,
 		CreatedAt:   timestamppb.New(realm.CreatedAt),
 		UpdatedAt:   timestamppb.New(realm.UpdatedAt),
+		Etag:        models.ETagFromRevision(realm.Revision),
 	}
 }
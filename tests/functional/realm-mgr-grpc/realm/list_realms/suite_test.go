@@ -0,0 +1,279 @@
+package listrealms
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+const activeRealmCount = 5
+
+func TestRealmManagerListRealmsGRPCSuite(t *testing.T) {
+	testSuite := NewListRealmsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type ListRealmsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+}
+
+func NewListRealmsTestSuite(t *testing.T) *ListRealmsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &ListRealmsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *ListRealmsTestSuite) SetupSuite() {
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *ListRealmsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *ListRealmsTestSuite) Test_ListRealms_Success() {
+	testCases := []struct {
+		name          string
+		req           *realm_mgr_v1.ListRealmsRequest
+		expectedNames []string
+	}{
+		{
+			name: "list active realms with default sorting",
+			req:  &realm_mgr_v1.ListRealmsRequest{},
+			expectedNames: []string{
+				"Test Realm 1", "Test Realm 2", "Test Realm 3", "Test Realm 4", "Test Realm 5",
+			},
+		},
+		{
+			name: "list active realms by name descending",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				SortDirection: realm_mgr_v1.EnumSortDirection_ENUM_SORT_DIRECTION_DESC,
+			},
+			expectedNames: []string{
+				"Test Realm 5", "Test Realm 4", "Test Realm 3", "Test Realm 2", "Test Realm 1",
+			},
+		},
+		{
+			name: "list active realms by creation time descending",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				SortField:     realm_mgr_v1.EnumRealmSortField_ENUM_REALM_SORT_FIELD_CREATED_AT,
+				SortDirection: realm_mgr_v1.EnumSortDirection_ENUM_SORT_DIRECTION_DESC,
+			},
+			expectedNames: []string{
+				"Test Realm 1", "Test Realm 2", "Test Realm 3", "Test Realm 4", "Test Realm 5",
+			},
+		},
+		{
+			name: "list draft realms",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+			},
+			expectedNames: []string{"Draft Realm"},
+		},
+		{
+			name: "list disabled realms",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED,
+			},
+			expectedNames: []string{"Disabled Realm"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.ListRealms(ctx, tc.req)
+
+			// assert
+			assert.NoError(t, err)
+			require.NotNil(t, res)
+
+			names := make([]string, 0, len(res.GetRealms()))
+			for _, realm := range res.GetRealms() {
+				names = append(names, realm.Name)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+			assert.Empty(t, res.GetNextPageToken())
+		})
+	}
+}
+
+func (s *ListRealmsTestSuite) Test_ListRealms_Pagination() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	req := &realm_mgr_v1.ListRealmsRequest{
+		PageSize: 2,
+	}
+
+	var names []string
+	pages := 0
+	for {
+		// act
+		res, listErr := s.client.ListRealms(ctx, req)
+
+		// assert
+		require.NoError(s.T(), listErr)
+		require.NotNil(s.T(), res)
+		assert.LessOrEqual(s.T(), len(res.GetRealms()), 2)
+
+		for _, realm := range res.GetRealms() {
+			names = append(names, realm.Name)
+		}
+		pages++
+
+		if res.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+
+	assert.Equal(s.T(), 3, pages)
+	assert.Equal(s.T(), []string{
+		"Test Realm 1", "Test Realm 2", "Test Realm 3", "Test Realm 4", "Test Realm 5",
+	}, names)
+}
+
+func (s *ListRealmsTestSuite) Test_ListRealms_InvalidArgument() {
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	firstPage, err := s.client.ListRealms(ctx, &realm_mgr_v1.ListRealmsRequest{PageSize: 1})
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), firstPage.GetNextPageToken())
+
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.ListRealmsRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "page size too big",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				PageSize: 101,
+			},
+			expectedErrMsg: "invalid ListRealmsRequest.PageSize: value must be less than or equal to 100",
+		},
+		{
+			name: "malformed page token",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				PageToken: "not-a-valid-token",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument pageToken is malformed",
+		},
+		{
+			name: "page token used with different sorting",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				PageToken: firstPage.GetNextPageToken(),
+				SortField: realm_mgr_v1.EnumRealmSortField_ENUM_REALM_SORT_FIELD_UPDATED_AT,
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument pageToken " +
+				"does not match the status, label selector and sorting of the request",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// act
+			res, err := s.client.ListRealms(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *ListRealmsTestSuite) populateTestData() error {
+	realms := make([]entities.Realm, 0, activeRealmCount+3)
+	for i := 1; i <= activeRealmCount; i++ {
+		realms = append(realms, entities.Realm{
+			ID:          uuid.New(),
+			Name:        fmt.Sprintf("Test Realm %d", i),
+			Description: fmt.Sprintf("Functional test realm #%d", i),
+			Status:      entities.StatusActive,
+			// older realms get a higher number so creation order differs from name order
+			CreatedAt: time.Date(2022, 01, activeRealmCount-i+1, 12, 30, 30, 0, time.UTC),
+			UpdatedAt: time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		})
+	}
+
+	realms = append(realms,
+		entities.Realm{
+			ID:          uuid.New(),
+			Name:        "Draft Realm",
+			Description: "Functional test draft realm",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		entities.Realm{
+			ID:          uuid.New(),
+			Name:        "Disabled Realm",
+			Description: "Functional test disabled realm",
+			Status:      entities.StatusDisabled,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		entities.Realm{
+			ID:          uuid.New(),
+			Name:        "Deleted Realm",
+			Description: "Functional test deleted realm",
+			Status:      entities.StatusDeleted,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+			DeletedAt:   time.Date(2022, 02, 14, 12, 30, 30, 0, time.UTC),
+		},
+	)
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+package realmattributes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerRealmAttributesGRPCSuite(t *testing.T) {
+	testSuite := NewRealmAttributesTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RealmAttributesTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	activeRealmID uuid.UUID
+}
+
+func NewRealmAttributesTestSuite(t *testing.T) *RealmAttributesTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RealmAttributesTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RealmAttributesTestSuite) SetupSuite() {
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *RealmAttributesTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RealmAttributesTestSuite) Test_CreateRealm_WithAttributes() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	attributes, err := structpb.NewStruct(map[string]interface{}{
+		"ownership": map[string]interface{}{
+			"team": "payments",
+			"contacts": []interface{}{
+				map[string]interface{}{"name": "Jane Doe", "email": "jane@example.com"},
+			},
+		},
+		"sla": map[string]interface{}{"tier": "gold", "uptime": 99.95},
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name:       "RealmAttributesTestSuite Create",
+		Attributes: attributes,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), attributes.AsMap(), res.GetRealm().GetAttributes().AsMap())
+
+	draft, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     res.GetRealm().Id,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), attributes.AsMap(), draft.GetRealm().GetAttributes().AsMap())
+}
+
+func (s *RealmAttributesTestSuite) Test_CreateRealm_InvalidAttributes() {
+	testCases := []struct {
+		name           string
+		attributes     map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:       "attribute without registered schema",
+			attributes: map[string]interface{}{"billing": map[string]interface{}{"account": "42"}},
+			expectedErrMsg: "an invalid argument error occurred: argument attributes " +
+				"failed validation at $.billing: no schema is registered for this attribute",
+		},
+		{
+			name:       "value of wrong type",
+			attributes: map[string]interface{}{"ownership": map[string]interface{}{"team": 42}},
+			expectedErrMsg: "an invalid argument error occurred: argument attributes " +
+				"failed validation at $.ownership.team: expected string, but got number",
+		},
+		{
+			name: "invalid array item",
+			attributes: map[string]interface{}{
+				"ownership": map[string]interface{}{
+					"team": "payments",
+					"contacts": []interface{}{
+						map[string]interface{}{"name": "Jane Doe"},
+						map[string]interface{}{"name": ""},
+					},
+				},
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument attributes " +
+				"failed validation at $.ownership.contacts[1].name: length must be >= 1, but got 0",
+		},
+		{
+			name:       "missing required property",
+			attributes: map[string]interface{}{"ownership": map[string]interface{}{}},
+			expectedErrMsg: "an invalid argument error occurred: argument attributes " +
+				"failed validation at $.ownership: missing properties: 'team'",
+		},
+		{
+			name:       "value not in enum",
+			attributes: map[string]interface{}{"sla": map[string]interface{}{"tier": "platinum"}},
+			expectedErrMsg: "an invalid argument error occurred: argument attributes " +
+				`failed validation at $.sla.tier: value must be one of "gold", "silver", "bronze"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			attributes, err := structpb.NewStruct(tc.attributes)
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+				Name:       "RealmAttributesTestSuite Invalid",
+				Attributes: attributes,
+			})
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmAttributesTestSuite) Test_UpdateRealm_AttributesDraftAndRelease() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	attributes, err := structpb.NewStruct(map[string]interface{}{
+		"ownership": map[string]interface{}{"team": "identity"},
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	updated, err := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:         s.activeRealmID.String(),
+			Attributes: attributes,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"attributes"},
+		},
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, updated.GetRealm().Status)
+	assert.Equal(s.T(), attributes.AsMap(), updated.GetRealm().GetAttributes().AsMap())
+
+	diff, err := s.client.DiffRealm(ctx, &realm_mgr_v1.DiffRealmRequest{
+		Id: s.activeRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), diff.GetChanges(), 1)
+
+	assert.Equal(s.T(), "attributes", diff.GetChanges()[0].Path)
+	assert.Equal(s.T(), `{"ownership":{"team":"payments"}}`, diff.GetChanges()[0].OldValue)
+	assert.Equal(s.T(), `{"ownership":{"team":"identity"}}`, diff.GetChanges()[0].NewValue)
+
+	released, err := s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{
+		Id: s.activeRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE, released.GetRealm().Status)
+	assert.Equal(s.T(), attributes.AsMap(), released.GetRealm().GetAttributes().AsMap())
+}
+
+func (s *RealmAttributesTestSuite) Test_UpdateRealm_InvalidAttributes() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	attributes, err := structpb.NewStruct(map[string]interface{}{
+		"sla": map[string]interface{}{"tier": "gold", "uptime": 120},
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:         s.activeRealmID.String(),
+			Attributes: attributes,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"attributes"},
+		},
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.InvalidArgument, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		"an invalid argument error occurred: argument attributes "+
+			"failed validation at $.sla.uptime: must be <= 100 but found 120",
+		gRPCError.Message(),
+	)
+}
+
+func (s *RealmAttributesTestSuite) populateTestData() error {
+	s.activeRealmID = uuid.New()
+
+	queries, err := utils.GenerateRealmInsertQueries(entities.Realm{
+		ID:        s.activeRealmID,
+		Name:      "Attributed Realm",
+		Status:    entities.StatusActive,
+		CreatedAt: time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+		UpdatedAt: time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		Attributes: map[string]interface{}{
+			"ownership": map[string]interface{}{"team": "payments"},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	return err
+}
@@ -0,0 +1,510 @@
+package realmhierarchy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+// maxDepth matches realms.hierarchy.max_depth of the CI configuration
+const maxDepth = 5
+
+func TestRealmManagerRealmHierarchyGRPCSuite(t *testing.T) {
+	testSuite := NewRealmHierarchyTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RealmHierarchyTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	rootRealmID       uuid.UUID
+	childRealmID      uuid.UUID
+	siblingRealmID    uuid.UUID
+	grandchildRealmID uuid.UUID
+
+	chainRealmIDs []uuid.UUID
+
+	disableRootRealmID  uuid.UUID
+	disableChildRealmID uuid.UUID
+
+	deleteRootRealmID  uuid.UUID
+	deleteChildRealmID uuid.UUID
+
+	restoreRootRealmID  uuid.UUID
+	restoreChildRealmID uuid.UUID
+}
+
+func NewRealmHierarchyTestSuite(t *testing.T) *RealmHierarchyTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RealmHierarchyTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RealmHierarchyTestSuite) SetupSuite() {
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *RealmHierarchyTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RealmHierarchyTestSuite) Test_CreateRealm_WithParent() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name:     "RealmHierarchyTestSuite Create",
+		ParentId: s.grandchildRealmID.String(),
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), s.grandchildRealmID.String(), res.GetRealm().ParentId)
+
+	ancestors, err := s.client.GetRealmAncestors(ctx, &realm_mgr_v1.GetRealmAncestorsRequest{
+		Id:     res.GetRealm().Id,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), ancestors.GetAncestors(), 3)
+
+	assert.Equal(s.T(), s.grandchildRealmID.String(), ancestors.GetAncestors()[0].Id)
+	assert.Equal(s.T(), s.childRealmID.String(), ancestors.GetAncestors()[1].Id)
+	assert.Equal(s.T(), s.rootRealmID.String(), ancestors.GetAncestors()[2].Id)
+}
+
+func (s *RealmHierarchyTestSuite) Test_CreateRealm_InvalidParent() {
+	testCases := []struct {
+		name           string
+		parentID       string
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name:         "parent does not exist",
+			parentID:     "8d5a3b1e-63b5-4bd4-a0b3-2fb1cc0e0a45",
+			expectedCode: codes.InvalidArgument,
+			expectedErrMsg: "an invalid argument error occurred: argument parentId " +
+				"references realm 8d5a3b1e-63b5-4bd4-a0b3-2fb1cc0e0a45 which does not exist",
+		},
+		{
+			name:           "hierarchy too deep",
+			parentID:       s.chainRealmIDs[maxDepth-1].String(),
+			expectedCode:   codes.FailedPrecondition,
+			expectedErrMsg: "failed precondition error occurred: realm hierarchy cannot be deeper than 5 levels",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+				Name:     "RealmHierarchyTestSuite Invalid",
+				ParentId: tc.parentID,
+			})
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, tc.expectedCode, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmHierarchyTestSuite) Test_UpdateRealm_InvalidParent() {
+	testCases := []struct {
+		name           string
+		realmID        uuid.UUID
+		parentID       uuid.UUID
+		expectedErrMsg string
+	}{
+		{
+			name:     "realm as its own parent",
+			realmID:  s.rootRealmID,
+			parentID: s.rootRealmID,
+			expectedErrMsg: fmt.Sprintf(
+				"failed precondition error occurred: realm with ID %s cannot be its own parent",
+				s.rootRealmID,
+			),
+		},
+		{
+			name:     "descendant as parent",
+			realmID:  s.rootRealmID,
+			parentID: s.grandchildRealmID,
+			expectedErrMsg: fmt.Sprintf(
+				"failed precondition error occurred: parent realm with ID %s is a descendant of realm with ID %s",
+				s.grandchildRealmID,
+				s.rootRealmID,
+			),
+		},
+		{
+			name:           "subtree too deep",
+			realmID:        s.rootRealmID,
+			parentID:       s.chainRealmIDs[2],
+			expectedErrMsg: "failed precondition error occurred: realm hierarchy cannot be deeper than 5 levels",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+				Realm: &realm_mgr_v1.Realm{
+					Id:       tc.realmID.String(),
+					ParentId: tc.parentID.String(),
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"parent_id"},
+				},
+			})
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.FailedPrecondition, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmHierarchyTestSuite) Test_ListChildRealms() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.ListChildRealms(ctx, &realm_mgr_v1.ListChildRealmsRequest{
+		ParentId: s.rootRealmID.String(),
+		PageSize: 1,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.GetRealms(), 1)
+	require.NotEmpty(s.T(), res.GetNextPageToken())
+
+	assert.Equal(s.T(), s.childRealmID.String(), res.GetRealms()[0].Id)
+
+	next, err := s.client.ListChildRealms(ctx, &realm_mgr_v1.ListChildRealmsRequest{
+		ParentId:  s.rootRealmID.String(),
+		PageSize:  1,
+		PageToken: res.GetNextPageToken(),
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), next.GetRealms(), 1)
+
+	assert.Equal(s.T(), s.siblingRealmID.String(), next.GetRealms()[0].Id)
+	assert.Empty(s.T(), next.GetNextPageToken())
+}
+
+func (s *RealmHierarchyTestSuite) Test_ListChildRealms_ParentNotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.ListChildRealms(ctx, &realm_mgr_v1.ListChildRealmsRequest{
+		ParentId: "8d5a3b1e-63b5-4bd4-a0b3-2fb1cc0e0a45",
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), "realm with ID not found: 8d5a3b1e-63b5-4bd4-a0b3-2fb1cc0e0a45", gRPCError.Message())
+}
+
+func (s *RealmHierarchyTestSuite) Test_GetRealm_EffectiveView() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:   s.grandchildRealmID.String(),
+		View: realm_mgr_v1.EnumRealmView_ENUM_REALM_VIEW_EFFECTIVE,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), map[string]string{
+		"env":  "prod",
+		"team": "payments",
+		"tier": "web",
+	}, res.GetRealm().GetLabels())
+	assert.Equal(s.T(), map[string]interface{}{
+		"ownership": map[string]interface{}{"team": "payments"},
+		"sla":       map[string]interface{}{"tier": "gold"},
+	}, res.GetRealm().GetAttributes().AsMap())
+
+	basic, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id: s.grandchildRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), map[string]string{"tier": "web"}, basic.GetRealm().GetLabels())
+	assert.Nil(s.T(), basic.GetRealm().GetAttributes())
+}
+
+func (s *RealmHierarchyTestSuite) Test_DisableRealm_Cascade() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	_, err = s.client.DisableRealm(ctx, &realm_mgr_v1.DisableRealmRequest{
+		Id:     s.disableRootRealmID.String(),
+		Reason: "functional test",
+	})
+
+	// assert
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.FailedPrecondition, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"failed precondition error occurred: realm with ID %s has child realms, disabling it requires cascade",
+			s.disableRootRealmID,
+		),
+		gRPCError.Message(),
+	)
+
+	res, err := s.client.DisableRealm(ctx, &realm_mgr_v1.DisableRealmRequest{
+		Id:      s.disableRootRealmID.String(),
+		Reason:  "functional test",
+		Cascade: true,
+	})
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED, res.GetRealm().Status)
+
+	child, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.disableChildRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED,
+	})
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED, child.GetRealm().Status)
+}
+
+func (s *RealmHierarchyTestSuite) Test_DeleteRealm_Cascade() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	_, err = s.client.DeleteRealm(ctx, &realm_mgr_v1.DeleteRealmRequest{
+		Id: s.deleteRootRealmID.String(),
+	})
+
+	// assert
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.FailedPrecondition, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"failed precondition error occurred: realm with ID %s has child realms, deleting it requires cascade",
+			s.deleteRootRealmID,
+		),
+		gRPCError.Message(),
+	)
+
+	_, err = s.client.DeleteRealm(ctx, &realm_mgr_v1.DeleteRealmRequest{
+		Id:      s.deleteRootRealmID.String(),
+		Cascade: true,
+	})
+	require.NoError(s.T(), err)
+
+	for _, realmID := range []uuid.UUID{s.deleteRootRealmID, s.deleteChildRealmID} {
+		_, getErr := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+			Id: realmID.String(),
+		})
+		require.Error(s.T(), getErr)
+
+		getStatus, getOK := status.FromError(getErr)
+		require.True(s.T(), getOK)
+
+		assert.Equal(s.T(), codes.NotFound, getStatus.Code())
+	}
+}
+
+func (s *RealmHierarchyTestSuite) Test_RestoreRealm_DeletedParent() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	_, err = s.client.DeleteRealm(ctx, &realm_mgr_v1.DeleteRealmRequest{
+		Id:      s.restoreRootRealmID.String(),
+		Cascade: true,
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	_, err = s.client.RestoreRealm(ctx, &realm_mgr_v1.RestoreRealmRequest{
+		Id: s.restoreChildRealmID.String(),
+	})
+
+	// assert
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.FailedPrecondition, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"failed precondition error occurred: parent realm with ID %s of realm with ID %s is deleted, restore the parent first",
+			s.restoreRootRealmID,
+			s.restoreChildRealmID,
+		),
+		gRPCError.Message(),
+	)
+
+	_, err = s.client.RestoreRealm(ctx, &realm_mgr_v1.RestoreRealmRequest{
+		Id: s.restoreRootRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	restoreRes, err := s.client.RestoreRealm(ctx, &realm_mgr_v1.RestoreRealmRequest{
+		Id: s.restoreChildRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), s.restoreRootRealmID.String(), restoreRes.GetRealm().GetParentId())
+}
+
+func (s *RealmHierarchyTestSuite) populateTestData() error {
+	createdAt := time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC)
+	updatedAt := time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC)
+
+	newRealm := func(name string, parentID uuid.UUID) entities.Realm {
+		return entities.Realm{
+			ID:        uuid.New(),
+			Name:      name,
+			Status:    entities.StatusActive,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+			ParentID:  parentID,
+		}
+	}
+
+	root := newRealm("Hierarchy Root", uuid.Nil)
+	root.Labels = map[string]string{"env": "prod", "team": "core"}
+	root.Attributes = map[string]interface{}{
+		"ownership": map[string]interface{}{"team": "platform"},
+		"sla":       map[string]interface{}{"tier": "gold"},
+	}
+	child := newRealm("Hierarchy Child A", root.ID)
+	child.Labels = map[string]string{"team": "payments"}
+	child.Attributes = map[string]interface{}{
+		"ownership": map[string]interface{}{"team": "payments"},
+	}
+	sibling := newRealm("Hierarchy Child B", root.ID)
+	grandchild := newRealm("Hierarchy Grandchild", child.ID)
+	grandchild.Labels = map[string]string{"tier": "web"}
+
+	s.rootRealmID = root.ID
+	s.childRealmID = child.ID
+	s.siblingRealmID = sibling.ID
+	s.grandchildRealmID = grandchild.ID
+
+	realms := []entities.Realm{root, child, sibling, grandchild}
+
+	parentID := uuid.Nil
+	for i := 0; i < maxDepth; i++ {
+		realm := newRealm(fmt.Sprintf("Hierarchy Chain %d", i+1), parentID)
+		s.chainRealmIDs = append(s.chainRealmIDs, realm.ID)
+		realms = append(realms, realm)
+		parentID = realm.ID
+	}
+
+	disableRoot := newRealm("Hierarchy Disable Root", uuid.Nil)
+	disableChild := newRealm("Hierarchy Disable Child", disableRoot.ID)
+	s.disableRootRealmID = disableRoot.ID
+	s.disableChildRealmID = disableChild.ID
+
+	deleteRoot := newRealm("Hierarchy Delete Root", uuid.Nil)
+	deleteChild := newRealm("Hierarchy Delete Child", deleteRoot.ID)
+	s.deleteRootRealmID = deleteRoot.ID
+	s.deleteChildRealmID = deleteChild.ID
+
+	restoreRoot := newRealm("Hierarchy Restore Root", uuid.Nil)
+	restoreChild := newRealm("Hierarchy Restore Child", restoreRoot.ID)
+	s.restoreRootRealmID = restoreRoot.ID
+	s.restoreChildRealmID = restoreChild.ID
+
+	realms = append(realms, disableRoot, disableChild, deleteRoot, deleteChild, restoreRoot, restoreChild)
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	return err
+}
@@ -0,0 +1,393 @@
+package realmlabels
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerRealmLabelsGRPCSuite(t *testing.T) {
+	testSuite := NewRealmLabelsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RealmLabelsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	activeRealmID uuid.UUID
+}
+
+func NewRealmLabelsTestSuite(t *testing.T) *RealmLabelsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RealmLabelsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RealmLabelsTestSuite) SetupSuite() {
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *RealmLabelsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RealmLabelsTestSuite) Test_CreateRealm_WithLabels() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	labels := map[string]string{
+		"env":                  "prod",
+		"example.com/team":     "payments",
+		"example.com/on-call":  "",
+		"region.example.com/a": "eu-west_1.b",
+	}
+
+	// act
+	res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name:   "RealmLabelsTestSuite Create",
+		Labels: labels,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), labels, res.GetRealm().Labels)
+
+	draft, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     res.GetRealm().Id,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), labels, draft.GetRealm().Labels)
+}
+
+func (s *RealmLabelsTestSuite) Test_CreateRealm_InvalidLabels() {
+	tooManyLabels := make(map[string]string)
+	for i := 0; i <= 64; i++ {
+		tooManyLabels[fmt.Sprintf("label-%d", i)] = "value"
+	}
+
+	testCases := []struct {
+		name           string
+		labels         map[string]string
+		expectedErrMsg string
+	}{
+		{
+			name:   "key with invalid characters",
+			labels: map[string]string{"env prod": "yes"},
+			expectedErrMsg: "an invalid argument error occurred: argument labels " +
+				`contains invalid key "env prod"`,
+		},
+		{
+			name:   "key with empty prefix",
+			labels: map[string]string{"/env": "prod"},
+			expectedErrMsg: "an invalid argument error occurred: argument labels " +
+				`contains invalid key "/env"`,
+		},
+		{
+			name:   "key name too long",
+			labels: map[string]string{strings.Repeat("a", 64): "prod"},
+			expectedErrMsg: "an invalid argument error occurred: argument labels " +
+				fmt.Sprintf("contains invalid key %q", strings.Repeat("a", 64)),
+		},
+		{
+			name:   "value with invalid characters",
+			labels: map[string]string{"env": "prod/eu"},
+			expectedErrMsg: "an invalid argument error occurred: argument labels " +
+				`contains invalid value "prod/eu" for key "env"`,
+		},
+		{
+			name:           "too many labels",
+			labels:         tooManyLabels,
+			expectedErrMsg: "an invalid argument error occurred: argument labels cannot contain more than 64 labels",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+				Name:   "RealmLabelsTestSuite Invalid",
+				Labels: tc.labels,
+			})
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmLabelsTestSuite) Test_UpdateRealm_Labels() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	labels := map[string]string{"env": "staging", "team": "identity"}
+
+	// act
+	res, err := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:     s.activeRealmID.String(),
+			Labels: labels,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"labels"},
+		},
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), "Labelled Realm 1", res.GetRealm().Name)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, res.GetRealm().Status)
+	assert.Equal(s.T(), labels, res.GetRealm().Labels)
+
+	// labels of the active realm only change once the draft is released
+	active, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.activeRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE,
+	})
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), map[string]string{"env": "prod", "team": "payments", "region": "eu"}, active.GetRealm().Labels)
+}
+
+func (s *RealmLabelsTestSuite) Test_ListRealms_LabelSelector() {
+	testCases := []struct {
+		name          string
+		labelSelector string
+		expectedNames []string
+	}{
+		{
+			name:          "equality",
+			labelSelector: "env=prod",
+			expectedNames: []string{"Labelled Realm 1", "Labelled Realm 2"},
+		},
+		{
+			name:          "double equality",
+			labelSelector: "env==prod,team==payments",
+			expectedNames: []string{"Labelled Realm 1"},
+		},
+		{
+			name:          "inequality",
+			labelSelector: "env!=prod",
+			expectedNames: []string{"Labelled Realm 3", "Unlabelled Realm"},
+		},
+		{
+			name:          "set membership",
+			labelSelector: "region in (eu, us)",
+			expectedNames: []string{"Labelled Realm 1", "Labelled Realm 2"},
+		},
+		{
+			name:          "set exclusion",
+			labelSelector: "region notin (eu)",
+			expectedNames: []string{"Labelled Realm 2", "Labelled Realm 3", "Unlabelled Realm"},
+		},
+		{
+			name:          "existence",
+			labelSelector: "team",
+			expectedNames: []string{"Labelled Realm 1", "Labelled Realm 3"},
+		},
+		{
+			name:          "non-existence",
+			labelSelector: "!team",
+			expectedNames: []string{"Labelled Realm 2", "Unlabelled Realm"},
+		},
+		{
+			name:          "combined requirements",
+			labelSelector: "env in (prod, dev), !team",
+			expectedNames: []string{"Labelled Realm 2"},
+		},
+		{
+			name:          "no match",
+			labelSelector: "env=unknown",
+			expectedNames: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.ListRealms(ctx, &realm_mgr_v1.ListRealmsRequest{
+				LabelSelector: tc.labelSelector,
+			})
+
+			// assert
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			names := make([]string, 0, len(res.GetRealms()))
+			for _, realm := range res.GetRealms() {
+				names = append(names, realm.Name)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+		})
+	}
+}
+
+func (s *RealmLabelsTestSuite) Test_ListRealms_InvalidLabelSelector() {
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	firstPage, err := s.client.ListRealms(ctx, &realm_mgr_v1.ListRealmsRequest{
+		PageSize:      1,
+		LabelSelector: "env=prod",
+	})
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), firstPage.GetNextPageToken())
+
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.ListRealmsRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "invalid key",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				LabelSelector: "env prod",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument labelSelector " +
+				`contains invalid requirement "env prod"`,
+		},
+		{
+			name: "empty requirement",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				LabelSelector: "env=prod,",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument labelSelector " +
+				`contains invalid requirement ""`,
+		},
+		{
+			name: "unbalanced parentheses",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				LabelSelector: "env in (prod",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument labelSelector contains unbalanced parentheses",
+		},
+		{
+			name: "page token used with different label selector",
+			req: &realm_mgr_v1.ListRealmsRequest{
+				PageToken:     firstPage.GetNextPageToken(),
+				LabelSelector: "env=staging",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument pageToken " +
+				"does not match the status, label selector and sorting of the request",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// act
+			res, err := s.client.ListRealms(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmLabelsTestSuite) populateTestData() error {
+	s.activeRealmID = uuid.New()
+
+	createdAt := time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC)
+	updatedAt := time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC)
+
+	realms := []entities.Realm{
+		{
+			ID:        s.activeRealmID,
+			Name:      "Labelled Realm 1",
+			Status:    entities.StatusActive,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+			Labels:    map[string]string{"env": "prod", "team": "payments", "region": "eu"},
+		},
+		{
+			ID:        uuid.New(),
+			Name:      "Labelled Realm 2",
+			Status:    entities.StatusActive,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+			Labels:    map[string]string{"env": "prod", "region": "us"},
+		},
+		{
+			ID:        uuid.New(),
+			Name:      "Labelled Realm 3",
+			Status:    entities.StatusActive,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+			Labels:    map[string]string{"env": "dev", "team": "identity"},
+		},
+		{
+			ID:        uuid.New(),
+			Name:      "Unlabelled Realm",
+			Status:    entities.StatusActive,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	return err
+}
@@ -0,0 +1,322 @@
+package realmrevisions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+const testActor = "functional-test"
+
+func TestRealmManagerRealmRevisionsGRPCSuite(t *testing.T) {
+	testSuite := NewRealmRevisionsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RealmRevisionsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	realmID uuid.UUID
+
+	firstRelease  *realm_mgr_v1.Realm
+	secondRelease *realm_mgr_v1.Realm
+}
+
+func NewRealmRevisionsTestSuite(t *testing.T) *RealmRevisionsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RealmRevisionsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RealmRevisionsTestSuite) SetupSuite() {
+	s.realmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+
+	ctx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testActor)
+	require.NoError(s.T(), err)
+
+	// release the realm twice to build up its revision history
+	releaseRes, err := s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{
+		Id: s.realmID.String(),
+	})
+	require.NoError(s.T(), err, "error releasing realm")
+	s.firstRelease = releaseRes.GetRealm()
+
+	_, err = s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          s.realmID.String(),
+			Name:        "Revised Realm",
+			Description: "Functional test realm after revision",
+		},
+	})
+	require.NoError(s.T(), err, "error updating realm")
+
+	releaseRes, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{
+		Id: s.realmID.String(),
+	})
+	require.NoError(s.T(), err, "error releasing realm")
+	s.secondRelease = releaseRes.GetRealm()
+}
+
+func (s *RealmRevisionsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RealmRevisionsTestSuite) Test_ListRealmRevisions_Success() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	firstPage, err := s.client.ListRealmRevisions(ctx, &realm_mgr_v1.ListRealmRevisionsRequest{
+		Id:       s.realmID.String(),
+		PageSize: 1,
+	})
+	require.NoError(s.T(), err)
+
+	secondPage, err := s.client.ListRealmRevisions(ctx, &realm_mgr_v1.ListRealmRevisionsRequest{
+		Id:        s.realmID.String(),
+		PageSize:  1,
+		PageToken: firstPage.GetNextPageToken(),
+	})
+	require.NoError(s.T(), err)
+
+	// assert
+	require.Len(s.T(), firstPage.GetRevisions(), 1)
+	assert.NotEmpty(s.T(), firstPage.GetNextPageToken())
+	assert.Equal(s.T(), s.secondRelease.Etag, firstPage.GetRevisions()[0].GetRealm().Etag)
+	assert.Equal(s.T(), "Revised Realm", firstPage.GetRevisions()[0].GetRealm().Name)
+	assert.Equal(s.T(), testActor, firstPage.GetRevisions()[0].ReleasedBy)
+
+	require.Len(s.T(), secondPage.GetRevisions(), 1)
+	assert.Empty(s.T(), secondPage.GetNextPageToken())
+	assert.Equal(s.T(), s.firstRelease.Etag, secondPage.GetRevisions()[0].GetRealm().Etag)
+	assert.Equal(s.T(), "Original Realm", secondPage.GetRevisions()[0].GetRealm().Name)
+}
+
+func (s *RealmRevisionsTestSuite) Test_ListRealmRevisions_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.ListRealmRevisionsRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "malformed ID provided",
+			req: &realm_mgr_v1.ListRealmRevisionsRequest{
+				Id: "not-valid-uuid",
+			},
+			expectedErrMsg: "invalid ListRealmRevisionsRequest.Id: value must be a valid UUID | caused by: invalid uuid format",
+		},
+		{
+			name: "malformed page token provided",
+			req: &realm_mgr_v1.ListRealmRevisionsRequest{
+				Id:        s.realmID.String(),
+				PageToken: "not-a-page-token",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument pageToken is malformed",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.ListRealmRevisions(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmRevisionsTestSuite) Test_GetRealmRevision_Success() {
+	testCases := []struct {
+		name         string
+		req          *realm_mgr_v1.GetRealmRevisionRequest
+		expectedName string
+	}{
+		{
+			name: "get revision by number",
+			req: &realm_mgr_v1.GetRealmRevisionRequest{
+				Id: s.realmID.String(),
+				Selector: &realm_mgr_v1.GetRealmRevisionRequest_Revision{
+					Revision: revisionFromETag(s.T(), s.firstRelease.Etag),
+				},
+			},
+			expectedName: "Original Realm",
+		},
+		{
+			name: "get revision as of now",
+			req: &realm_mgr_v1.GetRealmRevisionRequest{
+				Id: s.realmID.String(),
+				Selector: &realm_mgr_v1.GetRealmRevisionRequest_AsOf{
+					AsOf: timestamppb.New(time.Now().Add(time.Hour)),
+				},
+			},
+			expectedName: "Revised Realm",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.GetRealmRevision(ctx, tc.req)
+
+			// assert
+			require.NoError(t, err)
+			require.NotNil(t, res.GetRevision())
+
+			assert.Equal(t, s.realmID.String(), res.GetRevision().GetRealm().Id)
+			assert.Equal(t, tc.expectedName, res.GetRevision().GetRealm().Name)
+			assert.Equal(t, realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE, res.GetRevision().GetRealm().Status)
+			assert.Equal(t, testActor, res.GetRevision().ReleasedBy)
+		})
+	}
+}
+
+func (s *RealmRevisionsTestSuite) Test_GetRealmRevision_InvalidArgument() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.GetRealmRevision(ctx, &realm_mgr_v1.GetRealmRevisionRequest{
+		Id: s.realmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.InvalidArgument, gRPCError.Code())
+	assert.Equal(s.T(), "invalid GetRealmRevisionRequest.Selector: value is required", gRPCError.Message())
+}
+
+func (s *RealmRevisionsTestSuite) Test_GetRealmRevision_NotFound() {
+	testCases := []struct {
+		name string
+		req  *realm_mgr_v1.GetRealmRevisionRequest
+	}{
+		{
+			name: "non-existing revision",
+			req: &realm_mgr_v1.GetRealmRevisionRequest{
+				Id: s.realmID.String(),
+				Selector: &realm_mgr_v1.GetRealmRevisionRequest_Revision{
+					Revision: 999,
+				},
+			},
+		},
+		{
+			name: "before first release",
+			req: &realm_mgr_v1.GetRealmRevisionRequest{
+				Id: s.realmID.String(),
+				Selector: &realm_mgr_v1.GetRealmRevisionRequest_AsOf{
+					AsOf: timestamppb.New(time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC)),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.GetRealmRevision(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.NotFound, gRPCError.Code())
+			assert.Equal(t, fmt.Sprintf("realm revision with ID not found: %s", s.realmID), gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmRevisionsTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.realmID,
+			Name:        "Original Realm",
+			Description: "Functional test realm before revision",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func revisionFromETag(t *testing.T, etag string) int64 {
+	revision, err := models.RevisionFromETag(etag)
+	require.NoError(t, err)
+	return revision
+}
@@ -0,0 +1,246 @@
+package realmslugs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerRealmSlugsGRPCSuite(t *testing.T) {
+	testSuite := NewRealmSlugsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RealmSlugsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	// realm is created with the payments slug and renamed to billing
+	realm *realm_mgr_v1.Realm
+}
+
+func NewRealmSlugsTestSuite(t *testing.T) *RealmSlugsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RealmSlugsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RealmSlugsTestSuite) SetupSuite() {
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	createRes, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: "Payments",
+		Slug: "payments",
+	})
+	require.NoError(s.T(), err, "error creating realm")
+
+	renameRes, err := s.client.RenameRealmSlug(ctx, &realm_mgr_v1.RenameRealmSlugRequest{
+		Id:   createRes.GetRealm().Id,
+		Slug: "billing",
+	})
+	require.NoError(s.T(), err, "error renaming realm slug")
+	s.realm = renameRes.GetRealm()
+}
+
+func (s *RealmSlugsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RealmSlugsTestSuite) Test_GetRealmBySlug_Success() {
+	testCases := []struct {
+		name               string
+		slug               string
+		expectedRedirected bool
+	}{
+		{
+			name:               "current slug",
+			slug:               "billing",
+			expectedRedirected: false,
+		},
+		{
+			name:               "former slug",
+			slug:               "payments",
+			expectedRedirected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.GetRealmBySlug(ctx, &realm_mgr_v1.GetRealmBySlugRequest{
+				Slug:   tc.slug,
+				Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+			})
+
+			// assert
+			require.NoError(t, err)
+
+			assert.Equal(t, s.realm.Id, res.GetRealm().Id)
+			assert.Equal(t, "billing", res.GetRealm().Slug)
+			assert.Equal(t, tc.expectedRedirected, res.Redirected)
+		})
+	}
+}
+
+func (s *RealmSlugsTestSuite) Test_GetRealmBySlug_NotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.GetRealmBySlug(ctx, &realm_mgr_v1.GetRealmBySlugRequest{
+		Slug:   "unknown-realm",
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), "realm with slug not found: unknown-realm", gRPCError.Message())
+}
+
+func (s *RealmSlugsTestSuite) Test_CreateRealm_DerivedSlug() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	first, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: "Order Processing",
+	})
+	require.NoError(s.T(), err)
+
+	second, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: "Order Processing",
+	})
+	require.NoError(s.T(), err)
+
+	// assert
+	assert.Equal(s.T(), "order-processing", first.GetRealm().Slug)
+	assert.Equal(s.T(), fmt.Sprintf("order-processing-%s", second.GetRealm().Id[:8]), second.GetRealm().Slug)
+}
+
+func (s *RealmSlugsTestSuite) Test_CreateRealm_InvalidSlug() {
+	testCases := []struct {
+		name           string
+		slug           string
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name:           "malformed slug",
+			slug:           "Not_A_Slug",
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "an invalid argument error occurred: argument slug must consist of lowercase letters and digits separated by single hyphens",
+		},
+		{
+			name:           "reserved slug",
+			slug:           "admin",
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "an invalid argument error occurred: argument slug \"admin\" is reserved",
+		},
+		{
+			name:           "UUID slug",
+			slug:           uuid.New().String(),
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: "an invalid argument error occurred: argument slug cannot be a UUID",
+		},
+		{
+			name:           "slug of another realm",
+			slug:           "billing",
+			expectedCode:   codes.FailedPrecondition,
+			expectedErrMsg: "failed precondition error occurred: realm slug billing is already in use",
+		},
+		{
+			name:           "redirect of another realm",
+			slug:           "payments",
+			expectedCode:   codes.FailedPrecondition,
+			expectedErrMsg: "failed precondition error occurred: realm slug payments is already in use",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+				Name: "Rejected",
+				Slug: tc.slug,
+			})
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, tc.expectedCode, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmSlugsTestSuite) Test_RenameRealmSlug_NotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realmID := uuid.New().String()
+
+	// act
+	res, err := s.client.RenameRealmSlug(ctx, &realm_mgr_v1.RenameRealmSlugRequest{
+		Id:   realmID,
+		Slug: "orphan",
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("realm with ID not found: %s", realmID), gRPCError.Message())
+}
@@ -0,0 +1,350 @@
+package realmstats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+const (
+	statsLabelSelector = "suite=realm-stats"
+	cacheLabelSelector = "suite=realm-stats-cache"
+
+	// cacheTTL matches realms.stats.cache_ttl_seconds of the CI configuration
+	cacheTTL = 2 * time.Second
+
+	day = 24 * time.Hour
+)
+
+func TestRealmManagerRealmStatsGRPCSuite(t *testing.T) {
+	testSuite := NewRealmStatsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RealmStatsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	today time.Time
+}
+
+func NewRealmStatsTestSuite(t *testing.T) *RealmStatsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RealmStatsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RealmStatsTestSuite) SetupSuite() {
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *RealmStatsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RealmStatsTestSuite) Test_GetRealmStats() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.GetRealmStats(ctx, &realm_mgr_v1.GetRealmStatsRequest{
+		LabelSelector: statsLabelSelector,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res)
+
+	assert.Equal(s.T(), uint32(1), res.ActiveCount)
+	assert.Equal(s.T(), uint32(2), res.DraftCount)
+	assert.Equal(s.T(), uint32(1), res.DisabledCount)
+	assert.Equal(s.T(), uint32(1), res.DeletedCount)
+	assert.Equal(s.T(), uint32(1), res.StaleDraftCount)
+	assert.NotNil(s.T(), res.GeneratedAt)
+
+	require.Len(s.T(), res.CreatedPerDay, 2)
+	assert.Equal(s.T(), s.today.Add(-3*day), res.CreatedPerDay[0].Day.AsTime())
+	assert.Equal(s.T(), uint32(1), res.CreatedPerDay[0].Count)
+	assert.Equal(s.T(), s.today.Add(-2*day), res.CreatedPerDay[1].Day.AsTime())
+	assert.Equal(s.T(), uint32(2), res.CreatedPerDay[1].Count)
+}
+
+func (s *RealmStatsTestSuite) Test_GetRealmStats_CreatedRange() {
+	testCases := []struct {
+		name                    string
+		createdAfter            time.Time
+		createdBefore           time.Time
+		expectedActiveCount     uint32
+		expectedDraftCount      uint32
+		expectedDisabledCount   uint32
+		expectedDeletedCount    uint32
+		expectedStaleDraftCount uint32
+		expectedDays            int
+	}{
+		{
+			name:                    "range including stale draft",
+			createdAfter:            s.today.Add(-45 * day),
+			createdBefore:           s.today,
+			expectedActiveCount:     1,
+			expectedDraftCount:      2,
+			expectedDisabledCount:   1,
+			expectedDeletedCount:    1,
+			expectedStaleDraftCount: 1,
+			expectedDays:            3,
+		},
+		{
+			name:                    "range excluding stale draft",
+			createdAfter:            s.today.Add(-10 * day),
+			createdBefore:           s.today,
+			expectedActiveCount:     1,
+			expectedDraftCount:      1,
+			expectedDisabledCount:   1,
+			expectedDeletedCount:    1,
+			expectedStaleDraftCount: 0,
+			expectedDays:            2,
+		},
+		{
+			name:          "range without realms",
+			createdAfter:  s.today.Add(-100 * day),
+			createdBefore: s.today.Add(-90 * day),
+			expectedDays:  0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.GetRealmStats(ctx, &realm_mgr_v1.GetRealmStatsRequest{
+				LabelSelector: statsLabelSelector,
+				CreatedAfter:  timestamppb.New(tc.createdAfter),
+				CreatedBefore: timestamppb.New(tc.createdBefore),
+			})
+
+			// assert
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			assert.Equal(t, tc.expectedActiveCount, res.ActiveCount)
+			assert.Equal(t, tc.expectedDraftCount, res.DraftCount)
+			assert.Equal(t, tc.expectedDisabledCount, res.DisabledCount)
+			assert.Equal(t, tc.expectedDeletedCount, res.DeletedCount)
+			assert.Equal(t, tc.expectedStaleDraftCount, res.StaleDraftCount)
+			assert.Len(t, res.CreatedPerDay, tc.expectedDays)
+		})
+	}
+}
+
+func (s *RealmStatsTestSuite) Test_GetRealmStats_StaleDraftDays() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.GetRealmStats(ctx, &realm_mgr_v1.GetRealmStatsRequest{
+		LabelSelector:  statsLabelSelector,
+		StaleDraftDays: 60,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res)
+
+	assert.Equal(s.T(), uint32(2), res.DraftCount)
+	assert.Equal(s.T(), uint32(0), res.StaleDraftCount)
+}
+
+func (s *RealmStatsTestSuite) Test_GetRealmStats_Cached() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	request := &realm_mgr_v1.GetRealmStatsRequest{
+		LabelSelector: cacheLabelSelector,
+	}
+
+	first, err := s.client.GetRealmStats(ctx, request)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint32(0), first.DraftCount)
+
+	_, err = s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name:   "RealmStatsTestSuite Cached",
+		Labels: map[string]string{"suite": "realm-stats-cache"},
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	cached, err := s.client.GetRealmStats(ctx, request)
+	require.NoError(s.T(), err)
+
+	time.Sleep(cacheTTL + 500*time.Millisecond)
+
+	refreshed, err := s.client.GetRealmStats(ctx, request)
+	require.NoError(s.T(), err)
+
+	// assert
+	assert.Equal(s.T(), first.GeneratedAt.AsTime(), cached.GeneratedAt.AsTime())
+	assert.Equal(s.T(), uint32(0), cached.DraftCount)
+
+	assert.True(s.T(), refreshed.GeneratedAt.AsTime().After(first.GeneratedAt.AsTime()))
+	assert.Equal(s.T(), uint32(1), refreshed.DraftCount)
+}
+
+func (s *RealmStatsTestSuite) Test_GetRealmStats_InvalidArgument() {
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name           string
+		request        *realm_mgr_v1.GetRealmStatsRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "stale draft days too large",
+			request: &realm_mgr_v1.GetRealmStatsRequest{
+				StaleDraftDays: 3651,
+			},
+			expectedErrMsg: "invalid GetRealmStatsRequest.StaleDraftDays: value must be less than or equal to 3650",
+		},
+		{
+			name: "created before not after created after",
+			request: &realm_mgr_v1.GetRealmStatsRequest{
+				CreatedAfter:  timestamppb.New(now),
+				CreatedBefore: timestamppb.New(now.Add(-time.Hour)),
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument createdBefore must be after createdAfter",
+		},
+		{
+			name: "created range too long",
+			request: &realm_mgr_v1.GetRealmStatsRequest{
+				CreatedAfter:  timestamppb.New(now.Add(-400 * day)),
+				CreatedBefore: timestamppb.New(now),
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument createdAfter must be at most 366 days before createdBefore",
+		},
+		{
+			name: "created after too long ago",
+			request: &realm_mgr_v1.GetRealmStatsRequest{
+				CreatedAfter: timestamppb.New(now.Add(-400 * day)),
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument createdAfter must be at most 366 days before createdBefore",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.GetRealmStats(ctx, tc.request)
+
+			// assert
+			require.Error(t, err)
+			assert.Nil(t, res)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmStatsTestSuite) populateTestData() error {
+	s.today = time.Now().UTC().Truncate(day)
+
+	labels := map[string]string{"suite": "realm-stats"}
+	releasedRealmID := uuid.New()
+
+	realms := []entities.Realm{
+		{
+			ID:        releasedRealmID,
+			Name:      "Stats Active Realm",
+			Status:    entities.StatusActive,
+			CreatedAt: s.today.Add(-3*day + time.Hour),
+			UpdatedAt: s.today.Add(-3*day + time.Hour),
+			Labels:    labels,
+		},
+		{
+			ID:        releasedRealmID,
+			Name:      "Stats Active Realm Draft",
+			Status:    entities.StatusDraft,
+			CreatedAt: s.today.Add(-3*day + time.Hour),
+			UpdatedAt: time.Now().UTC().Add(-time.Hour),
+			Labels:    labels,
+		},
+		{
+			ID:        uuid.New(),
+			Name:      "Stats Stale Draft Realm",
+			Status:    entities.StatusDraft,
+			CreatedAt: s.today.Add(-40*day + time.Hour),
+			UpdatedAt: s.today.Add(-40*day + time.Hour),
+			Labels:    labels,
+		},
+		{
+			ID:        uuid.New(),
+			Name:      "Stats Disabled Realm",
+			Status:    entities.StatusDisabled,
+			CreatedAt: s.today.Add(-2*day + time.Hour),
+			UpdatedAt: s.today.Add(-2*day + time.Hour),
+			Labels:    labels,
+		},
+		{
+			ID:             uuid.New(),
+			Name:           "Stats Deleted Realm",
+			Status:         entities.StatusDeleted,
+			CreatedAt:      s.today.Add(-2*day + 2*time.Hour),
+			UpdatedAt:      s.today.Add(-day),
+			DeletedAt:      s.today.Add(-day),
+			PreviousStatus: entities.StatusActive,
+			Labels:         labels,
+		},
+		{
+			ID:        uuid.New(),
+			Name:      "Stats Unlabelled Realm",
+			Status:    entities.StatusActive,
+			CreatedAt: s.today.Add(-2*day + time.Hour),
+			UpdatedAt: s.today.Add(-2*day + time.Hour),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	return err
+}
@@ -0,0 +1,369 @@
+package realmtemplates
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+const testActor = "functional-test"
+
+func TestRealmManagerRealmTemplatesGRPCSuite(t *testing.T) {
+	testSuite := NewRealmTemplatesTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RealmTemplatesTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	ctx context.Context
+
+	// template has two versions, the second one adds the tier label
+	template *realm_mgr_v1.RealmTemplate
+}
+
+func NewRealmTemplatesTestSuite(t *testing.T) *RealmTemplatesTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RealmTemplatesTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RealmTemplatesTestSuite) SetupSuite() {
+	ctx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testActor)
+	require.NoError(s.T(), err)
+	s.ctx = ctx
+
+	attributes, err := structpb.NewStruct(map[string]interface{}{
+		"owner": "${team}",
+	})
+	require.NoError(s.T(), err)
+
+	createRes, err := s.client.CreateRealmTemplate(s.ctx, &realm_mgr_v1.CreateRealmTemplateRequest{
+		Name:             "Service Template",
+		Description:      "Functional test template",
+		RealmDescription: "Realm ${name} of ${team}",
+		Labels: map[string]string{
+			"env": "${environment}",
+		},
+		Attributes: attributes,
+		Parameters: []*realm_mgr_v1.RealmTemplateParameter{
+			{Name: "team", Required: true},
+			{Name: "environment", DefaultValue: "dev"},
+		},
+	})
+	require.NoError(s.T(), err, "error creating realm template")
+
+	updateRes, err := s.client.UpdateRealmTemplate(s.ctx, &realm_mgr_v1.UpdateRealmTemplateRequest{
+		Id:               createRes.GetTemplate().Id,
+		Version:          createRes.GetTemplate().Version,
+		Name:             "Service Template",
+		Description:      "Functional test template",
+		RealmDescription: "Realm ${name} of ${team}",
+		Labels: map[string]string{
+			"env":  "${environment}",
+			"tier": "backend",
+		},
+		Attributes: attributes,
+		Parameters: createRes.GetTemplate().Parameters,
+	})
+	require.NoError(s.T(), err, "error updating realm template")
+	s.template = updateRes.GetTemplate()
+}
+
+func (s *RealmTemplatesTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RealmTemplatesTestSuite) Test_GetRealmTemplate_Success() {
+	testCases := []struct {
+		name           string
+		version        int64
+		expectedLabels map[string]string
+	}{
+		{
+			name:    "latest version",
+			version: 0,
+			expectedLabels: map[string]string{
+				"env":  "${environment}",
+				"tier": "backend",
+			},
+		},
+		{
+			name:    "first version",
+			version: 1,
+			expectedLabels: map[string]string{
+				"env": "${environment}",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// act
+			res, err := s.client.GetRealmTemplate(s.ctx, &realm_mgr_v1.GetRealmTemplateRequest{
+				Id:      s.template.Id,
+				Version: tc.version,
+			})
+
+			// assert
+			require.NoError(t, err)
+
+			assert.Equal(t, s.template.Id, res.GetTemplate().Id)
+			assert.Equal(t, "Service Template", res.GetTemplate().Name)
+			assert.Equal(t, tc.expectedLabels, res.GetTemplate().Labels)
+			assert.Equal(t, testActor, res.GetTemplate().CreatedBy)
+			require.Len(t, res.GetTemplate().Parameters, 2)
+		})
+	}
+}
+
+func (s *RealmTemplatesTestSuite) Test_UpdateRealmTemplate_Aborted() {
+	// act
+	res, err := s.client.UpdateRealmTemplate(s.ctx, &realm_mgr_v1.UpdateRealmTemplateRequest{
+		Id:      s.template.Id,
+		Version: 1,
+		Name:    "Stale Template",
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.Aborted, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf("realm template with ID %s is at version 2, expected version 1", s.template.Id),
+		gRPCError.Message(),
+	)
+}
+
+func (s *RealmTemplatesTestSuite) Test_CreateRealmTemplate_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.CreateRealmTemplateRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "undeclared parameter referenced",
+			req: &realm_mgr_v1.CreateRealmTemplateRequest{
+				Name:             "Broken Template",
+				RealmDescription: "Realm of ${owner}",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument realmDescription references undeclared parameter \"owner\"",
+		},
+		{
+			name: "built-in parameter declared",
+			req: &realm_mgr_v1.CreateRealmTemplateRequest{
+				Name: "Broken Template",
+				Parameters: []*realm_mgr_v1.RealmTemplateParameter{
+					{Name: "name"},
+				},
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument parameters cannot declare built-in parameter \"name\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// act
+			res, err := s.client.CreateRealmTemplate(s.ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmTemplatesTestSuite) Test_CreateRealmFromTemplate_Success() {
+	// act
+	res, err := s.client.CreateRealmFromTemplate(s.ctx, &realm_mgr_v1.CreateRealmFromTemplateRequest{
+		TemplateId: s.template.Id,
+		Name:       "Payments",
+		Parameters: map[string]string{
+			"team": "billing",
+		},
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+
+	realm := res.GetRealm()
+	assert.Equal(s.T(), "Payments", realm.Name)
+	assert.Equal(s.T(), "Realm Payments of billing", realm.Description)
+	assert.Equal(s.T(), map[string]string{"env": "dev", "tier": "backend"}, realm.Labels)
+	assert.Equal(s.T(), "billing", realm.GetAttributes().AsMap()["owner"])
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, realm.Status)
+	assert.Equal(s.T(), s.template.Id, realm.TemplateId)
+	assert.Equal(s.T(), int64(2), realm.TemplateVersion)
+
+	realms, err := s.db.GetRealms(utils.GetRealmsQuery())
+	require.NoError(s.T(), err)
+
+	var found bool
+	for _, dbRealm := range realms {
+		if dbRealm.ID.String() == realm.Id {
+			found = true
+			assert.Equal(s.T(), s.template.Id, dbRealm.Template.ID.String())
+			assert.Equal(s.T(), int64(2), dbRealm.Template.Version)
+		}
+	}
+	assert.True(s.T(), found, "realm created from template not stored")
+}
+
+func (s *RealmTemplatesTestSuite) Test_CreateRealmFromTemplate_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		parameters     map[string]string
+		expectedErrMsg string
+	}{
+		{
+			name:           "required parameter missing",
+			parameters:     map[string]string{},
+			expectedErrMsg: "an invalid argument error occurred: argument parameters is missing required parameter \"team\"",
+		},
+		{
+			name: "unknown parameter supplied",
+			parameters: map[string]string{
+				"team":   "billing",
+				"region": "eu",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument parameters contains unknown parameter \"region\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// act
+			res, err := s.client.CreateRealmFromTemplate(s.ctx, &realm_mgr_v1.CreateRealmFromTemplateRequest{
+				TemplateId: s.template.Id,
+				Name:       "Rejected",
+				Parameters: tc.parameters,
+			})
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RealmTemplatesTestSuite) Test_DeleteRealmTemplate_Success() {
+	// arrange
+	createRes, err := s.client.CreateRealmTemplate(s.ctx, &realm_mgr_v1.CreateRealmTemplateRequest{
+		Name: "Short Lived Template",
+	})
+	require.NoError(s.T(), err)
+
+	templateID := createRes.GetTemplate().Id
+
+	// act
+	_, err = s.client.DeleteRealmTemplate(s.ctx, &realm_mgr_v1.DeleteRealmTemplateRequest{
+		Id: templateID,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+
+	res, err := s.client.GetRealmTemplate(s.ctx, &realm_mgr_v1.GetRealmTemplateRequest{
+		Id: templateID,
+	})
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("realm template with ID not found: %s", templateID), gRPCError.Message())
+
+	listRes, err := s.client.ListRealmTemplates(s.ctx, &realm_mgr_v1.ListRealmTemplatesRequest{})
+	require.NoError(s.T(), err)
+	for _, template := range listRes.GetTemplates() {
+		assert.NotEqual(s.T(), templateID, template.Id)
+	}
+}
+
+func (s *RealmTemplatesTestSuite) Test_GetRealmTemplate_NotFound() {
+	// arrange
+	templateID := uuid.New().String()
+
+	// act
+	res, err := s.client.GetRealmTemplate(s.ctx, &realm_mgr_v1.GetRealmTemplateRequest{
+		Id: templateID,
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("realm template with ID not found: %s", templateID), gRPCError.Message())
+}
+
+func (s *RealmTemplatesTestSuite) Test_ListRealmTemplates_Success() {
+	// act
+	res, err := s.client.ListRealmTemplates(s.ctx, &realm_mgr_v1.ListRealmTemplatesRequest{})
+
+	// assert
+	require.NoError(s.T(), err)
+
+	var found bool
+	for _, template := range res.GetTemplates() {
+		if template.Id == s.template.Id {
+			found = true
+			assert.Equal(s.T(), int64(2), template.Version)
+		}
+	}
+	assert.True(s.T(), found, "template not listed")
+}
@@ -0,0 +1,407 @@
+package releaseapproval
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+const (
+	testRequester = "functional-test-requester"
+	testApprover  = "functional-test-approver"
+	testRejecter  = "functional-test-rejecter"
+)
+
+func TestRealmManagerReleaseApprovalGRPCSuite(t *testing.T) {
+	testSuite := NewReleaseApprovalTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type ReleaseApprovalTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	reviewedRealmID     uuid.UUID
+	changedRealmID      uuid.UUID
+	selfReviewedRealmID uuid.UUID
+	releasedRealmID     uuid.UUID
+	activeRealmID       uuid.UUID
+}
+
+func NewReleaseApprovalTestSuite(t *testing.T) *ReleaseApprovalTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &ReleaseApprovalTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *ReleaseApprovalTestSuite) SetupSuite() {
+	s.reviewedRealmID = uuid.New()
+	s.changedRealmID = uuid.New()
+	s.selfReviewedRealmID = uuid.New()
+	s.releasedRealmID = uuid.New()
+	s.activeRealmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *ReleaseApprovalTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *ReleaseApprovalTestSuite) Test_ReleaseApproval_Success() {
+	// arrange
+	requesterCtx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testRequester)
+	require.NoError(s.T(), err)
+	approverCtx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testApprover)
+	require.NoError(s.T(), err)
+	rejecterCtx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testRejecter)
+	require.NoError(s.T(), err)
+
+	// act
+	requestRes, err := s.client.RequestReleaseApproval(requesterCtx, &realm_mgr_v1.RequestReleaseApprovalRequest{
+		Id: s.reviewedRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	approveRes, err := s.client.ApproveRelease(approverCtx, &realm_mgr_v1.ApproveReleaseRequest{
+		Id:      s.reviewedRealmID.String(),
+		Etag:    requestRes.GetApproval().GetRealm().GetEtag(),
+		Comment: "looks good",
+	})
+	require.NoError(s.T(), err)
+
+	rejectRes, err := s.client.RejectRelease(rejecterCtx, &realm_mgr_v1.RejectReleaseRequest{
+		Id:      s.reviewedRealmID.String(),
+		Comment: "description is incomplete",
+	})
+	require.NoError(s.T(), err)
+
+	// assert
+	require.NotNil(s.T(), requestRes.GetApproval())
+	assert.Equal(s.T(), s.reviewedRealmID.String(), requestRes.GetApproval().GetRealm().GetId())
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, requestRes.GetApproval().GetRealm().GetStatus())
+	assert.Equal(s.T(), testRequester, requestRes.GetApproval().GetRequestedBy())
+	assert.Empty(s.T(), requestRes.GetApproval().GetDecisions())
+
+	require.Len(s.T(), approveRes.GetApproval().GetDecisions(), 1)
+	assert.Equal(s.T(), testApprover, approveRes.GetApproval().GetDecisions()[0].GetReviewer())
+	assert.Equal(
+		s.T(),
+		realm_mgr_v1.EnumApprovalDecision_ENUM_APPROVAL_DECISION_APPROVE,
+		approveRes.GetApproval().GetDecisions()[0].GetDecision(),
+	)
+	assert.Equal(s.T(), "looks good", approveRes.GetApproval().GetDecisions()[0].GetComment())
+
+	decisions := make(map[string]realm_mgr_v1.EnumApprovalDecision)
+	for _, decision := range rejectRes.GetApproval().GetDecisions() {
+		decisions[decision.GetReviewer()] = decision.GetDecision()
+	}
+	assert.Equal(s.T(), map[string]realm_mgr_v1.EnumApprovalDecision{
+		testApprover: realm_mgr_v1.EnumApprovalDecision_ENUM_APPROVAL_DECISION_APPROVE,
+		testRejecter: realm_mgr_v1.EnumApprovalDecision_ENUM_APPROVAL_DECISION_REJECT,
+	}, decisions)
+}
+
+func (s *ReleaseApprovalTestSuite) Test_ApproveRelease_DraftChanged() {
+	// arrange
+	requesterCtx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testRequester)
+	require.NoError(s.T(), err)
+	ctx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testApprover)
+	require.NoError(s.T(), err)
+
+	requestRes, err := s.client.RequestReleaseApproval(requesterCtx, &realm_mgr_v1.RequestReleaseApprovalRequest{
+		Id: s.changedRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          s.changedRealmID.String(),
+			Description: "Changed after approval was requested",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"description"},
+		},
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.ApproveRelease(ctx, &realm_mgr_v1.ApproveReleaseRequest{
+		Id: s.changedRealmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.FailedPrecondition, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"failed precondition error occurred: draft of realm with ID %s changed after its approval was requested at revision %s, approval must be requested again",
+			s.changedRealmID,
+			requestRes.GetApproval().GetRealm().GetEtag(),
+		),
+		gRPCError.Message(),
+	)
+}
+
+func (s *ReleaseApprovalTestSuite) Test_ApproveRelease_ByRequester() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testRequester)
+	require.NoError(s.T(), err)
+
+	_, err = s.client.RequestReleaseApproval(ctx, &realm_mgr_v1.RequestReleaseApprovalRequest{
+		Id: s.selfReviewedRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.ApproveRelease(ctx, &realm_mgr_v1.ApproveReleaseRequest{
+		Id: s.selfReviewedRealmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.FailedPrecondition, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"failed precondition error occurred: release approval of realm with ID %s cannot be reviewed by its requester %s",
+			s.selfReviewedRealmID,
+			testRequester,
+		),
+		gRPCError.Message(),
+	)
+}
+
+func (s *ReleaseApprovalTestSuite) Test_ReleaseRealm_ClearsApproval() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testApprover)
+	require.NoError(s.T(), err)
+
+	_, err = s.client.RequestReleaseApproval(ctx, &realm_mgr_v1.RequestReleaseApprovalRequest{
+		Id: s.releasedRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{
+		Id: s.releasedRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.ApproveRelease(ctx, &realm_mgr_v1.ApproveReleaseRequest{
+		Id: s.releasedRealmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("release approval of realm with ID not found: %s", s.releasedRealmID), gRPCError.Message())
+}
+
+func (s *ReleaseApprovalTestSuite) Test_ReviewRelease_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		review         func(ctx context.Context) error
+		expectedErrMsg string
+	}{
+		{
+			name: "rejection without comment",
+			review: func(ctx context.Context) error {
+				_, err := s.client.RejectRelease(ctx, &realm_mgr_v1.RejectReleaseRequest{
+					Id: s.reviewedRealmID.String(),
+				})
+				return err
+			},
+			expectedErrMsg: "invalid RejectReleaseRequest.Comment: value length must be between 1 and 500 runes, inclusive",
+		},
+		{
+			name: "malformed etag",
+			review: func(ctx context.Context) error {
+				_, err := s.client.ApproveRelease(ctx, &realm_mgr_v1.ApproveReleaseRequest{
+					Id:   s.reviewedRealmID.String(),
+					Etag: "not-an-etag",
+				})
+				return err
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument etag is malformed",
+		},
+		{
+			name: "anonymous reviewer",
+			review: func(ctx context.Context) error {
+				_, err := s.client.ApproveRelease(ctx, &realm_mgr_v1.ApproveReleaseRequest{
+					Id: s.reviewedRealmID.String(),
+				})
+				return err
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument reviewer cannot be blank",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			err = tc.review(ctx)
+
+			// assert
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *ReleaseApprovalTestSuite) Test_RequestReleaseApproval_AnonymousRequester() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.RequestReleaseApproval(ctx, &realm_mgr_v1.RequestReleaseApprovalRequest{
+		Id: s.reviewedRealmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.InvalidArgument, gRPCError.Code())
+	assert.Equal(s.T(), "an invalid argument error occurred: argument requestedBy cannot be blank", gRPCError.Message())
+}
+
+func (s *ReleaseApprovalTestSuite) Test_RequestReleaseApproval_NotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testRequester)
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.RequestReleaseApproval(ctx, &realm_mgr_v1.RequestReleaseApprovalRequest{
+		Id: s.activeRealmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("draft realm with ID not found: %s", s.activeRealmID), gRPCError.Message())
+}
+
+func (s *ReleaseApprovalTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.reviewedRealmID,
+			Name:        "Test Realm 1",
+			Description: "Functional test realm #1",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.changedRealmID,
+			Name:        "Test Realm 2",
+			Description: "Functional test realm #2",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.releasedRealmID,
+			Name:        "Test Realm 3",
+			Description: "Functional test realm #3",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.selfReviewedRealmID,
+			Name:        "Test Realm 5",
+			Description: "Functional test realm #5",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.activeRealmID,
+			Name:        "Test Realm 4",
+			Description: "Functional test realm #4",
+			Status:      entities.StatusActive,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -103,9 +103,16 @@ func (s *ReleaseRealmTestSuite) Test_ReleaseRealm_Success() {
 	testCases := []struct {
 		name             string
 		realmID          uuid.UUID
+		validateOnly     bool
 		expectedResponse *realm_mgr_v1.Realm
 		skip             bool
 	}{
+		{
+			name:             "validate release of draft realm",
+			realmID:          s.draftRealmID,
+			validateOnly:     true,
+			expectedResponse: s.realmToGRPC(s.draftRealm),
+		},
 		{
 			name:             "release draft realm",
 			realmID:          s.draftRealmID,
@@ -125,7 +132,8 @@ func (s *ReleaseRealmTestSuite) Test_ReleaseRealm_Success() {
 
 			// act
 			res, err := s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{
-				Id: tc.realmID.String(),
+				Id:           tc.realmID.String(),
+				ValidateOnly: tc.validateOnly,
 			})
 
 			// assert
@@ -138,10 +146,57 @@ func (s *ReleaseRealmTestSuite) Test_ReleaseRealm_Success() {
 			assert.Equal(t, tc.expectedResponse.Name, res.GetRealm().Name)
 			assert.Equal(t, tc.expectedResponse.Description, res.GetRealm().Description)
 			assert.Equal(t, tc.expectedResponse.Status, res.GetRealm().Status)
+
+			// a validated release leaves the draft in place
+			_, err = s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+				Id:     tc.realmID.String(),
+				Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+			})
+			if tc.validateOnly {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
 		})
 	}
 }
 
+func (s *ReleaseRealmTestSuite) Test_ReleaseRealm_Aborted() {
+	if s.draftRealm == nil {
+		s.T().Skip("environment not setup for this test case")
+	}
+
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{
+		Id:   s.draftRealmID.String(),
+		Etag: fmt.Sprintf("%d", s.draftRealm.Revision+1),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.Aborted, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"aborted error occurred: realm with ID %s is at revision %d, expected revision %d",
+			s.draftRealmID,
+			s.draftRealm.Revision,
+			s.draftRealm.Revision+1,
+		),
+		gRPCError.Message(),
+	)
+}
+
 func (s *ReleaseRealmTestSuite) Test_ReleaseRealm_InvalidArgument() {
 	testCases := []struct {
 		name           string
@@ -160,6 +215,14 @@ func (s *ReleaseRealmTestSuite) Test_ReleaseRealm_InvalidArgument() {
 			},
 			expectedErrMsg: "invalid ReleaseRealmRequest.Id: value must be a valid UUID | caused by: invalid uuid format",
 		},
+		{
+			name: "malformed etag provided",
+			req: &realm_mgr_v1.ReleaseRealmRequest{
+				Id:   uuid.New().String(),
+				Etag: "not-an-etag",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument etag is malformed",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,247 @@
+package restorerealm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerRestoreRealmGRPCSuite(t *testing.T) {
+	testSuite := NewRestoreRealmTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RestoreRealmTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	deletedRealmID         uuid.UUID
+	expiredRealmID         uuid.UUID
+	unknownPreviousRealmID uuid.UUID
+}
+
+func NewRestoreRealmTestSuite(t *testing.T) *RestoreRealmTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RestoreRealmTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RestoreRealmTestSuite) SetupSuite() {
+	s.deletedRealmID = uuid.New()
+	s.expiredRealmID = uuid.New()
+	s.unknownPreviousRealmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *RestoreRealmTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RestoreRealmTestSuite) Test_RestoreRealm_Success() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.RestoreRealm(ctx, &realm_mgr_v1.RestoreRealmRequest{
+		Id:     s.deletedRealmID.String(),
+		Reason: "functional test",
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), s.deletedRealmID.String(), res.GetRealm().Id)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED, res.GetRealm().Status)
+
+	realms, err := s.db.GetRealms(utils.GetRealmsQuery().Where("id = ?", s.deletedRealmID))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), realms, 1)
+	assert.Equal(s.T(), entities.Status(entities.StatusDisabled), realms[0].Status)
+	assert.True(s.T(), realms[0].DeletedAt.IsZero())
+	assert.Zero(s.T(), realms[0].PreviousStatus)
+
+	records, err := s.db.GetAuditRecords(utils.GetAuditRecordsQuery(s.deletedRealmID))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), records, 1)
+	assert.Equal(s.T(), entities.AuditActionRestore, records[0].Action)
+	assert.Equal(s.T(), "functional test", records[0].Reason)
+}
+
+func (s *RestoreRealmTestSuite) Test_RestoreRealm_FailedPrecondition() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.RestoreRealmRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "realm deleted outside of retention period",
+			req: &realm_mgr_v1.RestoreRealmRequest{
+				Id: s.expiredRealmID.String(),
+			},
+			expectedErrMsg: fmt.Sprintf(
+				"failed precondition error occurred: realm with ID %s was deleted outside of the 720h0m0s retention period",
+				s.expiredRealmID,
+			),
+		},
+		{
+			name: "realm deleted without previous status",
+			req: &realm_mgr_v1.RestoreRealmRequest{
+				Id: s.unknownPreviousRealmID.String(),
+			},
+			expectedErrMsg: fmt.Sprintf(
+				"failed precondition error occurred: realm with ID %s was deleted without its previous status and cannot be restored",
+				s.unknownPreviousRealmID,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.RestoreRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.FailedPrecondition, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RestoreRealmTestSuite) Test_RestoreRealm_InvalidArgument() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.RestoreRealm(ctx, &realm_mgr_v1.RestoreRealmRequest{
+		Id: "not-valid-uuid",
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.InvalidArgument, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		"invalid RestoreRealmRequest.Id: value must be a valid UUID | caused by: invalid uuid format",
+		gRPCError.Message(),
+	)
+}
+
+func (s *RestoreRealmTestSuite) Test_RestoreRealm_NotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realmID := uuid.New()
+
+	// act
+	res, err := s.client.RestoreRealm(ctx, &realm_mgr_v1.RestoreRealmRequest{
+		Id: realmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("deleted realm with ID not found: %s", realmID), gRPCError.Message())
+}
+
+func (s *RestoreRealmTestSuite) populateTestData() error {
+	now := time.Now().UTC()
+
+	realms := []entities.Realm{
+		{
+			ID:             s.deletedRealmID,
+			Name:           "Deleted Realm",
+			Description:    "Functional test recently deleted realm",
+			Status:         entities.StatusDeleted,
+			CreatedAt:      time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:      now.Add(-time.Hour),
+			DeletedAt:      now.Add(-time.Hour),
+			PreviousStatus: entities.StatusDisabled,
+		},
+		{
+			ID:             s.expiredRealmID,
+			Name:           "Expired Realm",
+			Description:    "Functional test realm deleted outside of retention period",
+			Status:         entities.StatusDeleted,
+			CreatedAt:      time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:      time.Date(2022, 02, 01, 12, 30, 30, 0, time.UTC),
+			DeletedAt:      time.Date(2022, 02, 01, 12, 30, 30, 0, time.UTC),
+			PreviousStatus: entities.StatusActive,
+		},
+		{
+			ID:          s.unknownPreviousRealmID,
+			Name:        "Unknown Previous Status Realm",
+			Description: "Functional test realm deleted without previous status",
+			Status:      entities.StatusDeleted,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   now.Add(-time.Hour),
+			DeletedAt:   now.Add(-time.Hour),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
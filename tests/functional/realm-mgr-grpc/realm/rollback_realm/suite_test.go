@@ -0,0 +1,241 @@
+package rollbackrealm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/models"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerRollbackRealmGRPCSuite(t *testing.T) {
+	testSuite := NewRollbackRealmTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type RollbackRealmTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	realmID uuid.UUID
+
+	firstRevision int64
+}
+
+func NewRollbackRealmTestSuite(t *testing.T) *RollbackRealmTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &RollbackRealmTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *RollbackRealmTestSuite) SetupSuite() {
+	s.realmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// release the realm twice, so that there is a previous revision to roll back to
+	releaseRes, err := s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{
+		Id: s.realmID.String(),
+	})
+	require.NoError(s.T(), err, "error releasing realm")
+
+	s.firstRevision, err = models.RevisionFromETag(releaseRes.GetRealm().Etag)
+	require.NoError(s.T(), err)
+
+	_, err = s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          s.realmID.String(),
+			Name:        "Bad Realm",
+			Description: "Functional test realm with a bad release",
+		},
+	})
+	require.NoError(s.T(), err, "error updating realm")
+
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{
+		Id: s.realmID.String(),
+	})
+	require.NoError(s.T(), err, "error releasing realm")
+}
+
+func (s *RollbackRealmTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *RollbackRealmTestSuite) Test_RollbackRealm_Success() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.RollbackRealm(ctx, &realm_mgr_v1.RollbackRealmRequest{
+		Id:       s.realmID.String(),
+		Revision: s.firstRevision,
+		Reason:   "functional test",
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), s.realmID.String(), res.GetRealm().Id)
+	assert.Equal(s.T(), "Good Realm", res.GetRealm().Name)
+	assert.Equal(s.T(), "Functional test realm with a good release", res.GetRealm().Description)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, res.GetRealm().Status)
+
+	// the active realm stays untouched until the draft is released
+	activeRes, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id: s.realmID.String(),
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "Bad Realm", activeRes.GetRealm().Name)
+
+	records, err := s.db.GetAuditRecords(utils.GetAuditRecordsQuery(s.realmID))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), records, 1)
+	assert.Equal(s.T(), entities.AuditActionRollback, records[0].Action)
+	assert.Equal(s.T(), "functional test", records[0].Reason)
+}
+
+func (s *RollbackRealmTestSuite) Test_RollbackRealm_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.RollbackRealmRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "malformed ID provided",
+			req: &realm_mgr_v1.RollbackRealmRequest{
+				Id:       "not-valid-uuid",
+				Revision: 1,
+			},
+			expectedErrMsg: "invalid RollbackRealmRequest.Id: value must be a valid UUID | caused by: invalid uuid format",
+		},
+		{
+			name: "no revision provided",
+			req: &realm_mgr_v1.RollbackRealmRequest{
+				Id: s.realmID.String(),
+			},
+			expectedErrMsg: "invalid RollbackRealmRequest.Revision: value must be greater than 0",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.RollbackRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *RollbackRealmTestSuite) Test_RollbackRealm_NotFound() {
+	testCases := []struct {
+		name string
+		req  *realm_mgr_v1.RollbackRealmRequest
+	}{
+		{
+			name: "non-existing realm",
+			req: &realm_mgr_v1.RollbackRealmRequest{
+				Id:       uuid.New().String(),
+				Revision: 1,
+			},
+		},
+		{
+			name: "non-existing revision",
+			req: &realm_mgr_v1.RollbackRealmRequest{
+				Id:       s.realmID.String(),
+				Revision: 999,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.RollbackRealm(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.NotFound, gRPCError.Code())
+			assert.Equal(t, fmt.Sprintf("realm revision with ID not found: %s", tc.req.Id), gRPCError.Message())
+		})
+	}
+}
+
+func (s *RollbackRealmTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.realmID,
+			Name:        "Good Realm",
+			Description: "Functional test realm with a good release",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package scheduledrelease
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/adapters/realmmgrgrpc/interceptors"
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+const testActor = "functional-test"
+
+func TestRealmManagerScheduledReleaseGRPCSuite(t *testing.T) {
+	testSuite := NewScheduledReleaseTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type ScheduledReleaseTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+
+	draftRealmID  uuid.UUID
+	activeRealmID uuid.UUID
+}
+
+func NewScheduledReleaseTestSuite(t *testing.T) *ScheduledReleaseTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &ScheduledReleaseTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *ScheduledReleaseTestSuite) SetupSuite() {
+	s.draftRealmID = uuid.New()
+	s.activeRealmID = uuid.New()
+
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *ScheduledReleaseTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *ScheduledReleaseTestSuite) Test_ScheduleRelease_Success() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background(), interceptors.ActorHeader, testActor)
+	require.NoError(s.T(), err)
+
+	releaseAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	// act
+	res, err := s.client.ScheduleRelease(ctx, &realm_mgr_v1.ScheduleReleaseRequest{
+		Id:        s.draftRealmID.String(),
+		ReleaseAt: timestamppb.New(releaseAt),
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.GetReleaseSchedule())
+
+	assert.Equal(s.T(), releaseAt, res.GetReleaseSchedule().ReleaseAt.AsTime())
+	assert.Equal(s.T(), testActor, res.GetReleaseSchedule().ScheduledBy)
+	assert.Equal(s.T(), realm_mgr_v1.EnumReleaseScheduleState_ENUM_RELEASE_SCHEDULE_STATE_PENDING, res.GetReleaseSchedule().State)
+	assert.Zero(s.T(), res.GetReleaseSchedule().Attempts)
+
+	// the schedule is reported as part of the draft realm
+	getRes, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.draftRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), getRes.GetRealm().GetReleaseSchedule())
+	assert.Equal(s.T(), releaseAt, getRes.GetRealm().GetReleaseSchedule().ReleaseAt.AsTime())
+
+	// cancel the schedule again
+	_, err = s.client.CancelScheduledRelease(ctx, &realm_mgr_v1.CancelScheduledReleaseRequest{
+		Id: s.draftRealmID.String(),
+	})
+	require.NoError(s.T(), err)
+
+	getRes, err = s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.draftRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), getRes.GetRealm().GetReleaseSchedule())
+}
+
+func (s *ScheduledReleaseTestSuite) Test_ScheduleRelease_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.ScheduleReleaseRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "no release time provided",
+			req: &realm_mgr_v1.ScheduleReleaseRequest{
+				Id: s.draftRealmID.String(),
+			},
+			expectedErrMsg: "invalid ScheduleReleaseRequest.ReleaseAt: value is required",
+		},
+		{
+			name: "release time in the past provided",
+			req: &realm_mgr_v1.ScheduleReleaseRequest{
+				Id:        s.draftRealmID.String(),
+				ReleaseAt: timestamppb.New(time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC)),
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument releaseAt must be in the future",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.ScheduleRelease(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *ScheduledReleaseTestSuite) Test_ScheduleRelease_NotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.ScheduleRelease(ctx, &realm_mgr_v1.ScheduleReleaseRequest{
+		Id:        s.activeRealmID.String(),
+		ReleaseAt: timestamppb.New(time.Now().Add(time.Hour)),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("draft realm with ID not found: %s", s.activeRealmID), gRPCError.Message())
+}
+
+func (s *ScheduledReleaseTestSuite) Test_CancelScheduledRelease_NotFound() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.CancelScheduledRelease(ctx, &realm_mgr_v1.CancelScheduledReleaseRequest{
+		Id: s.activeRealmID.String(),
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("scheduled release of realm with ID not found: %s", s.activeRealmID), gRPCError.Message())
+}
+
+func (s *ScheduledReleaseTestSuite) Test_DeleteRealm_CancelsScheduledRelease() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	created, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: "Deleted Scheduled Realm",
+	})
+	require.NoError(s.T(), err)
+	realmID := created.GetRealm().Id
+
+	_, err = s.client.ScheduleRelease(ctx, &realm_mgr_v1.ScheduleReleaseRequest{
+		Id:        realmID,
+		ReleaseAt: timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	_, err = s.client.DeleteRealm(ctx, &realm_mgr_v1.DeleteRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.RestoreRealm(ctx, &realm_mgr_v1.RestoreRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	// assert
+	res, err := s.client.CancelScheduledRelease(ctx, &realm_mgr_v1.CancelScheduledReleaseRequest{
+		Id: realmID,
+	})
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.NotFound, gRPCError.Code())
+	assert.Equal(s.T(), fmt.Sprintf("scheduled release of realm with ID not found: %s", realmID), gRPCError.Message())
+}
+
+func (s *ScheduledReleaseTestSuite) populateTestData() error {
+	realms := []entities.Realm{
+		{
+			ID:          s.draftRealmID,
+			Name:        "Test Realm 1",
+			Description: "Functional test realm #1",
+			Status:      entities.StatusDraft,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC),
+		},
+		{
+			ID:          s.activeRealmID,
+			Name:        "Test Realm 2",
+			Description: "Functional test realm #2",
+			Status:      entities.StatusActive,
+			CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+			UpdatedAt:   time.Date(2022, 04, 01, 13, 30, 30, 0, time.UTC),
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,347 @@
+package searchrealms
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+func TestRealmManagerSearchRealmsGRPCSuite(t *testing.T) {
+	testSuite := NewSearchRealmsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type SearchRealmsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+}
+
+func NewSearchRealmsTestSuite(t *testing.T) *SearchRealmsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &SearchRealmsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *SearchRealmsTestSuite) SetupSuite() {
+	require.NoError(s.T(), s.populateTestData(), "error populating test data")
+}
+
+func (s *SearchRealmsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *SearchRealmsTestSuite) Test_SearchRealms_Success() {
+	testCases := []struct {
+		name          string
+		req           *realm_mgr_v1.SearchRealmsRequest
+		expectedNames []string
+	}{
+		{
+			name: "name matches rank above description matches",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query: "payments",
+			},
+			expectedNames: []string{"Payments", "Checkout"},
+		},
+		{
+			name: "stemmed query",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query: "invoicing",
+			},
+			expectedNames: []string{"Invoices"},
+		},
+		{
+			name: "excluded term",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query: "payments -card",
+			},
+			expectedNames: []string{"Payments"},
+		},
+		{
+			name: "draft realms",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query:  "payments",
+				Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+			},
+			expectedNames: []string{"Payments Sandbox"},
+		},
+		{
+			name: "no matches",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query: "shipping",
+			},
+			expectedNames: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			// act
+			res, err := s.client.SearchRealms(ctx, tc.req)
+
+			// assert
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			names := make([]string, 0, len(res.GetResults()))
+			for _, result := range res.GetResults() {
+				names = append(names, result.GetRealm().Name)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+			assert.Empty(t, res.GetNextPageToken())
+		})
+	}
+}
+
+func (s *SearchRealmsTestSuite) Test_SearchRealms_Highlights() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.SearchRealms(ctx, &realm_mgr_v1.SearchRealmsRequest{
+		Query: "payments",
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.GetResults(), 2)
+
+	nameMatch := res.GetResults()[0]
+	assert.Equal(s.T(), "<mark>Payments</mark>", nameMatch.NameHighlight)
+	assert.Contains(s.T(), nameMatch.DescriptionHighlight, "<mark>payments</mark>")
+
+	descriptionMatch := res.GetResults()[1]
+	assert.Equal(s.T(), "Checkout", descriptionMatch.NameHighlight)
+	assert.Contains(s.T(), descriptionMatch.DescriptionHighlight, "<mark>payments</mark>")
+
+	assert.Greater(s.T(), nameMatch.Rank, descriptionMatch.Rank)
+}
+
+func (s *SearchRealmsTestSuite) Test_SearchRealms_HighlightsEscaped() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.SearchRealms(ctx, &realm_mgr_v1.SearchRealmsRequest{
+		Query:  "widgets",
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.Len(s.T(), res.GetResults(), 1)
+
+	result := res.GetResults()[0]
+	assert.Contains(s.T(), result.NameHighlight, "<mark>Widgets</mark>")
+	assert.Contains(s.T(), result.NameHighlight, "&lt;b&gt;&amp;&lt;/b&gt;")
+	assert.NotContains(s.T(), result.NameHighlight, "<b>")
+	assert.Contains(s.T(), result.DescriptionHighlight, "&lt;script&gt;")
+	assert.NotContains(s.T(), result.DescriptionHighlight, "<script>")
+	assert.Contains(s.T(), result.DescriptionHighlight, "<mark>widgets</mark>")
+}
+
+func (s *SearchRealmsTestSuite) Test_SearchRealms_Pagination() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	req := &realm_mgr_v1.SearchRealmsRequest{
+		Query:    "realm",
+		PageSize: 2,
+	}
+
+	ids := make(map[string]struct{})
+	pages := 0
+	for {
+		// act
+		res, searchErr := s.client.SearchRealms(ctx, req)
+
+		// assert
+		require.NoError(s.T(), searchErr)
+		require.NotNil(s.T(), res)
+		assert.LessOrEqual(s.T(), len(res.GetResults()), 2)
+
+		for _, result := range res.GetResults() {
+			ids[result.GetRealm().Id] = struct{}{}
+		}
+		pages++
+
+		if res.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+
+	assert.Equal(s.T(), 2, pages)
+	assert.Len(s.T(), ids, 4)
+}
+
+func (s *SearchRealmsTestSuite) Test_SearchRealms_InvalidArgument() {
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	firstPage, err := s.client.SearchRealms(ctx, &realm_mgr_v1.SearchRealmsRequest{Query: "realm", PageSize: 1})
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), firstPage.GetNextPageToken())
+
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.SearchRealmsRequest
+		expectedErrMsg string
+	}{
+		{
+			name:           "empty query",
+			req:            &realm_mgr_v1.SearchRealmsRequest{},
+			expectedErrMsg: "invalid SearchRealmsRequest.Query: value length must be between 1 and 256 runes, inclusive",
+		},
+		{
+			name: "blank query",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query: "   ",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument query cannot be blank",
+		},
+		{
+			name: "page size too big",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query:    "realm",
+				PageSize: 101,
+			},
+			expectedErrMsg: "invalid SearchRealmsRequest.PageSize: value must be less than or equal to 100",
+		},
+		{
+			name: "malformed page token",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query:     "realm",
+				PageToken: "not-a-valid-token",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument pageToken is malformed",
+		},
+		{
+			name: "page token used with different query",
+			req: &realm_mgr_v1.SearchRealmsRequest{
+				Query:     "payments",
+				PageToken: firstPage.GetNextPageToken(),
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument pageToken " +
+				"does not match the query and status of the request",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// act
+			res, err := s.client.SearchRealms(ctx, tc.req)
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *SearchRealmsTestSuite) populateTestData() error {
+	createdAt := time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC)
+	updatedAt := time.Date(2022, 01, 14, 12, 30, 30, 0, time.UTC)
+
+	realms := []entities.Realm{
+		{
+			ID:          uuid.New(),
+			Name:        "Payments",
+			Description: "Realm processing card payments",
+			Status:      entities.StatusActive,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		},
+		{
+			ID:          uuid.New(),
+			Name:        "Checkout",
+			Description: "Realm collecting payments of shopping baskets",
+			Status:      entities.StatusActive,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		},
+		{
+			ID:          uuid.New(),
+			Name:        "Invoices",
+			Description: "Realm issuing monthly invoices",
+			Status:      entities.StatusActive,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		},
+		{
+			ID:          uuid.New(),
+			Name:        "Accounts",
+			Description: "Realm managing customer accounts",
+			Status:      entities.StatusActive,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		},
+		{
+			ID:          uuid.New(),
+			Name:        "Payments Sandbox",
+			Description: "Realm testing payment integrations",
+			Status:      entities.StatusDraft,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		},
+		{
+			ID:          uuid.New(),
+			Name:        "Widgets <b>&</b>",
+			Description: `Sells <script>alert("x")</script> widgets`,
+			Status:      entities.StatusDisabled,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		},
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realms...)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecuteInsertQueries(context.Background(), queries...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -166,6 +167,274 @@ func (s *UpdateRealmTestSuite) Test_UpdateRealm_Success() {
 	}
 }
 
+func (s *UpdateRealmTestSuite) Test_UpdateRealm_WithUpdateMask() {
+	if s.draftRealm == nil {
+		s.T().Skip("environment not setup for this test case")
+	}
+
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	current, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.draftRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          s.draftRealmID.String(),
+			Description: "Updated description only",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"description"},
+		},
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), s.draftRealmID.String(), res.GetRealm().Id)
+	assert.Equal(s.T(), current.GetRealm().Name, res.GetRealm().Name)
+	assert.Equal(s.T(), "Updated description only", res.GetRealm().Description)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT, res.GetRealm().Status)
+	assert.NotEqual(s.T(), current.GetRealm().Etag, res.GetRealm().Etag)
+}
+
+func (s *UpdateRealmTestSuite) Test_UpdateRealm_WithoutUpdateMaskKeepsUnsetFields() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	parent, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: "Unmasked Update Parent",
+	})
+	require.NoError(s.T(), err)
+
+	child, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name:     "Unmasked Update Child",
+		Labels:   map[string]string{"team": "payments"},
+		ParentId: parent.GetRealm().GetId(),
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          child.GetRealm().GetId(),
+			Name:        "Unmasked Update Child Renamed",
+			Description: "Updated without a mask",
+		},
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), "Unmasked Update Child Renamed", res.GetRealm().Name)
+	assert.Equal(s.T(), "Updated without a mask", res.GetRealm().Description)
+	assert.Equal(s.T(), map[string]string{"team": "payments"}, res.GetRealm().Labels)
+	assert.Equal(s.T(), parent.GetRealm().GetId(), res.GetRealm().ParentId)
+}
+
+func (s *UpdateRealmTestSuite) Test_UpdateRealm_Aborted() {
+	if s.draftRealm == nil {
+		s.T().Skip("environment not setup for this test case")
+	}
+
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	current, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.draftRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          s.draftRealmID.String(),
+			Name:        "StaleDraftUpdate",
+			Description: "Update based on a stale etag",
+		},
+		Etag: "999",
+	})
+
+	// assert
+	assert.Nil(s.T(), res)
+
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.Aborted, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"aborted error occurred: realm with ID %s is at revision %s, expected revision 999",
+			s.draftRealmID,
+			current.GetRealm().Etag,
+		),
+		gRPCError.Message(),
+	)
+}
+
+func (s *UpdateRealmTestSuite) Test_UpdateRealm_ConcurrentFirstDraft() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realm := entities.Realm{
+		ID:          uuid.New(),
+		Name:        "ConcurrentDraft",
+		Description: "Realm edited concurrently",
+		Status:      entities.StatusActive,
+		CreatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+		UpdatedAt:   time.Date(2022, 01, 01, 12, 30, 30, 0, time.UTC),
+	}
+
+	queries, err := utils.GenerateRealmInsertQueries(realm)
+	require.NoError(s.T(), err)
+	_, err = s.db.ExecuteInsertQueries(ctx, queries...)
+	require.NoError(s.T(), err)
+
+	// the first edit creates the draft in a transaction that is still open
+	draft := realm
+	draft.Status = entities.StatusDraft
+	draft.Revision = 2
+
+	queries, err = utils.GenerateRealmInsertQueries(draft)
+	require.NoError(s.T(), err)
+	commit, rollback, err := s.db.ExecuteOpenInsertQueries(ctx, queries...)
+	require.NoError(s.T(), err)
+	//nolint:errcheck // ignore rollback error of the committed transaction
+	defer rollback()
+
+	// act
+	type updateResult struct {
+		res *realm_mgr_v1.UpdateRealmResponse
+		err error
+	}
+	results := make(chan updateResult, 1)
+	go func() {
+		res, updateErr := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+			Realm: &realm_mgr_v1.Realm{
+				Id:          realm.ID.String(),
+				Name:        "ConcurrentDraft",
+				Description: "Second first edit",
+			},
+		})
+		results <- updateResult{res: res, err: updateErr}
+	}()
+
+	// the second edit waits on the draft of the first one before it is committed
+	time.Sleep(500 * time.Millisecond)
+	require.NoError(s.T(), commit())
+
+	result := <-results
+
+	// assert
+	assert.Nil(s.T(), result.res)
+
+	require.Error(s.T(), result.err)
+
+	gRPCError, ok := status.FromError(result.err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.Aborted, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf("aborted error occurred: realm with ID %s in status draft was created concurrently", realm.ID),
+		gRPCError.Message(),
+	)
+
+	drafts, err := s.db.GetRealms(utils.GetRealmsQuery().Where("id = ? AND status = 'draft'", realm.ID))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), drafts, 1)
+	assert.Equal(s.T(), realm.Description, drafts[0].Description)
+}
+
+func (s *UpdateRealmTestSuite) Test_UpdateRealm_ValidateOnly() {
+	if s.draftRealm == nil {
+		s.T().Skip("environment not setup for this test case")
+	}
+
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	current, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.draftRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+
+	// act
+	res, err := s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          s.draftRealmID.String(),
+			Description: "Validated description only",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"description"},
+		},
+		Etag:         current.GetRealm().Etag,
+		ValidateOnly: true,
+	})
+
+	// assert
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), res.GetRealm())
+
+	assert.Equal(s.T(), "Validated description only", res.GetRealm().Description)
+	assert.NotEqual(s.T(), current.GetRealm().Etag, res.GetRealm().Etag)
+
+	// the draft remains unchanged
+	after, err := s.client.GetRealm(ctx, &realm_mgr_v1.GetRealmRequest{
+		Id:     s.draftRealmID.String(),
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT,
+	})
+	require.NoError(s.T(), err)
+
+	assert.Equal(s.T(), current.GetRealm().Description, after.GetRealm().Description)
+	assert.Equal(s.T(), current.GetRealm().Etag, after.GetRealm().Etag)
+
+	// conflicts are reported the same way as for applied updates
+	_, err = s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          s.draftRealmID.String(),
+			Description: "Validated description only",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"description"},
+		},
+		Etag:         "999",
+		ValidateOnly: true,
+	})
+	require.Error(s.T(), err)
+
+	gRPCError, ok := status.FromError(err)
+	require.True(s.T(), ok)
+
+	assert.Equal(s.T(), codes.Aborted, gRPCError.Code())
+	assert.Equal(
+		s.T(),
+		fmt.Sprintf(
+			"aborted error occurred: realm with ID %s is at revision %s, expected revision 999",
+			s.draftRealmID,
+			current.GetRealm().Etag,
+		),
+		gRPCError.Message(),
+	)
+}
+
 func (s *UpdateRealmTestSuite) Test_UpdateRealm_InvalidArgument() {
 	testCases := []struct {
 		name           string
@@ -184,7 +453,7 @@ func (s *UpdateRealmTestSuite) Test_UpdateRealm_InvalidArgument() {
 				Realm: &realm_mgr_v1.Realm{},
 			},
 			expectedErrMsg: "invalid UpdateRealmRequest.Realm: embedded message failed validation | caused by: invalid Realm.Id: " +
-				"value must be a valid UUID | caused by: invalid uuid format; invalid Realm.Name: value length must be at least 1 runes",
+				"value must be a valid UUID | caused by: invalid uuid format",
 		},
 		{
 			name: "malformed ID provided",
@@ -194,7 +463,7 @@ func (s *UpdateRealmTestSuite) Test_UpdateRealm_InvalidArgument() {
 				},
 			},
 			expectedErrMsg: "invalid UpdateRealmRequest.Realm: embedded message failed validation | caused by: invalid Realm.Id: " +
-				"value must be a valid UUID | caused by: invalid uuid format; invalid Realm.Name: value length must be at least 1 runes",
+				"value must be a valid UUID | caused by: invalid uuid format",
 		},
 		{
 			name: "realm with blank name",
@@ -204,9 +473,61 @@ func (s *UpdateRealmTestSuite) Test_UpdateRealm_InvalidArgument() {
 					Description: "This is test",
 				},
 			},
-			expectedErrMsg: "invalid UpdateRealmRequest.Realm: embedded message failed validation | caused by: invalid Realm.Name: " +
-				"value length must be at least 1 runes",
-			skip: s.draftRealm == nil,
+			expectedErrMsg: "an invalid argument error occurred: argument name cannot be blank",
+			skip:           s.draftRealm == nil,
+		},
+		{
+			name: "update mask with unknown path",
+			req: &realm_mgr_v1.UpdateRealmRequest{
+				Realm: &realm_mgr_v1.Realm{
+					Id:          s.draftRealmID.String(),
+					Description: "This is test",
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"description", "unknown"},
+				},
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument updateMask contains unknown path unknown",
+			skip:           s.draftRealm == nil,
+		},
+		{
+			name: "update mask with immutable path",
+			req: &realm_mgr_v1.UpdateRealmRequest{
+				Realm: &realm_mgr_v1.Realm{
+					Id:     s.draftRealmID.String(),
+					Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE,
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"status"},
+				},
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument updateMask contains immutable path status",
+			skip:           s.draftRealm == nil,
+		},
+		{
+			name: "malformed etag",
+			req: &realm_mgr_v1.UpdateRealmRequest{
+				Realm: &realm_mgr_v1.Realm{
+					Id:   s.draftRealmID.String(),
+					Name: "DraftRealmUpdated",
+				},
+				Etag: "not-an-etag",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument etag is malformed",
+			skip:           s.draftRealm == nil,
+		},
+		{
+			name: "blank name in update mask",
+			req: &realm_mgr_v1.UpdateRealmRequest{
+				Realm: &realm_mgr_v1.Realm{
+					Id: s.draftRealmID.String(),
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"name"},
+				},
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument name cannot be blank",
+			skip:           s.draftRealm == nil,
 		},
 	}
 
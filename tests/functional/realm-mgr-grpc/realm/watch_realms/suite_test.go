@@ -0,0 +1,327 @@
+package watchrealms
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alexZaicev/realm-mgr/internal/domain/entities"
+	realm_mgr_v1 "github.com/alexZaicev/realm-mgr/proto/go/realm_mgr/v1"
+	"github.com/alexZaicev/realm-mgr/tests/functional/utils"
+)
+
+const (
+	// watchTimeout bounds every watch, events are expected well within a few poll intervals
+	watchTimeout = 10 * time.Second
+
+	// pollInterval matches realms.watch.poll_interval_milliseconds of the CI configuration
+	pollInterval = 250 * time.Millisecond
+)
+
+func TestRealmManagerWatchRealmsGRPCSuite(t *testing.T) {
+	testSuite := NewWatchRealmsTestSuite(t)
+	suite.Run(t, testSuite)
+}
+
+type WatchRealmsTestSuite struct {
+	suite.Suite
+
+	db     *utils.DB
+	client realm_mgr_v1.RealmManagerServiceClient
+}
+
+func NewWatchRealmsTestSuite(t *testing.T) *WatchRealmsTestSuite {
+	cfg, err := utils.LoadConfig()
+	require.NoError(t, err, "error loading configuration file")
+
+	db, err := utils.NewDB(cfg)
+	require.NoError(t, err, "error creating database connection")
+
+	client, err := utils.NewRealmManagerGRPCClient(cfg)
+	require.NoError(t, err, "error creating gRPC client")
+
+	return &WatchRealmsTestSuite{
+		db:     db,
+		client: client,
+	}
+}
+
+func (s *WatchRealmsTestSuite) TearDownSuite() {
+	err := s.db.Wipe()
+	require.NoError(s.T(), err, "error wiping database")
+}
+
+func (s *WatchRealmsTestSuite) Test_WatchRealms_Lifecycle() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realmID := s.createRealm(ctx, "Watched Realm")
+
+	watchCtx, cancel := context.WithTimeout(ctx, watchTimeout)
+	defer cancel()
+
+	stream, err := s.client.WatchRealms(watchCtx, &realm_mgr_v1.WatchRealmsRequest{
+		Id: realmID,
+	})
+	require.NoError(s.T(), err)
+
+	first, err := stream.Recv()
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), first.GetEvent())
+	assert.NotEmpty(s.T(), first.GetSequenceToken())
+
+	// act
+	_, err = s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:          realmID,
+			Name:        "Watched Realm",
+			Description: "Updated while watched",
+		},
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.DisableRealm(ctx, &realm_mgr_v1.DisableRealmRequest{
+		Id:     realmID,
+		Reason: "Watched",
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.DeleteRealm(ctx, &realm_mgr_v1.DeleteRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	// assert
+	expected := []struct {
+		eventType realm_mgr_v1.EnumRealmEventType
+		status    realm_mgr_v1.EnumStatus
+	}{
+		{realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_UPDATED, realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT},
+		{realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_RELEASED, realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE},
+		{realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_DISABLED, realm_mgr_v1.EnumStatus_ENUM_STATUS_DISABLED},
+		{realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_DELETED, realm_mgr_v1.EnumStatus_ENUM_STATUS_UNSPECIFIED},
+	}
+
+	for _, want := range expected {
+		res, recvErr := stream.Recv()
+		require.NoError(s.T(), recvErr)
+
+		assert.Equal(s.T(), realmID, res.GetEvent().RealmId)
+		assert.Equal(s.T(), want.eventType, res.GetEvent().Type)
+		assert.Equal(s.T(), want.status, res.GetEvent().Status)
+		assert.NotNil(s.T(), res.GetEvent().OccurredAt)
+		assert.NotEmpty(s.T(), res.GetSequenceToken())
+	}
+}
+
+func (s *WatchRealmsTestSuite) Test_WatchRealms_Resume() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realmID := s.createRealm(ctx, "Resumed Realm")
+
+	watchCtx, cancel := context.WithTimeout(ctx, watchTimeout)
+	stream, err := s.client.WatchRealms(watchCtx, &realm_mgr_v1.WatchRealmsRequest{
+		Id: realmID,
+	})
+	require.NoError(s.T(), err)
+
+	first, err := stream.Recv()
+	require.NoError(s.T(), err)
+	sequenceToken := first.GetSequenceToken()
+
+	// disconnect before the realm is released
+	cancel()
+
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	// act
+	resumeCtx, resumeCancel := context.WithTimeout(ctx, watchTimeout)
+	defer resumeCancel()
+
+	resumed, err := s.client.WatchRealms(resumeCtx, &realm_mgr_v1.WatchRealmsRequest{
+		Id:            realmID,
+		SequenceToken: sequenceToken,
+	})
+	require.NoError(s.T(), err)
+
+	// assert
+	start, err := resumed.Recv()
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), start.GetEvent())
+	assert.Equal(s.T(), sequenceToken, start.GetSequenceToken())
+
+	res, err := resumed.Recv()
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), realmID, res.GetEvent().RealmId)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_RELEASED, res.GetEvent().Type)
+}
+
+func (s *WatchRealmsTestSuite) Test_WatchRealms_TransactionsCommittedOutOfOrder() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realmID := s.createRealm(ctx, "Out Of Order Realm")
+
+	watchCtx, cancel := context.WithTimeout(ctx, watchTimeout)
+	defer cancel()
+
+	stream, err := s.client.WatchRealms(watchCtx, &realm_mgr_v1.WatchRealmsRequest{
+		Id: realmID,
+	})
+	require.NoError(s.T(), err)
+
+	_, err = stream.Recv()
+	require.NoError(s.T(), err)
+
+	// the event is recorded by a transaction that stays open until a later one committed
+	query, err := utils.GenerateRealmEventInsertQuery(entities.RealmEvent{
+		RealmID:    uuid.MustParse(realmID),
+		Type:       entities.RealmEventTypeUpdated,
+		Status:     entities.StatusDraft,
+		OccurredAt: time.Now().UTC(),
+	})
+	require.NoError(s.T(), err)
+
+	commit, rollback, err := s.db.ExecuteOpenInsertQueries(ctx, query)
+	require.NoError(s.T(), err)
+	//nolint:errcheck // ignore rollback error of the committed transaction
+	defer rollback()
+
+	// act
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	// the watcher polls a few times while the earlier transaction is still open
+	time.Sleep(4 * pollInterval)
+
+	require.NoError(s.T(), commit())
+
+	// assert
+	expected := []struct {
+		eventType realm_mgr_v1.EnumRealmEventType
+		status    realm_mgr_v1.EnumStatus
+	}{
+		{realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_UPDATED, realm_mgr_v1.EnumStatus_ENUM_STATUS_DRAFT},
+		{realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_RELEASED, realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE},
+	}
+
+	for _, want := range expected {
+		res, recvErr := stream.Recv()
+		require.NoError(s.T(), recvErr)
+
+		assert.Equal(s.T(), realmID, res.GetEvent().RealmId)
+		assert.Equal(s.T(), want.eventType, res.GetEvent().Type)
+		assert.Equal(s.T(), want.status, res.GetEvent().Status)
+	}
+}
+
+func (s *WatchRealmsTestSuite) Test_WatchRealms_StatusFilter() {
+	// arrange
+	ctx, err := utils.MakeGRPCRequestContext(context.Background())
+	require.NoError(s.T(), err)
+
+	realmID := s.createRealm(ctx, "Filtered Realm")
+
+	watchCtx, cancel := context.WithTimeout(ctx, watchTimeout)
+	defer cancel()
+
+	stream, err := s.client.WatchRealms(watchCtx, &realm_mgr_v1.WatchRealmsRequest{
+		Id:     realmID,
+		Status: realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE,
+	})
+	require.NoError(s.T(), err)
+
+	_, err = stream.Recv()
+	require.NoError(s.T(), err)
+
+	// act
+	_, err = s.client.UpdateRealm(ctx, &realm_mgr_v1.UpdateRealmRequest{
+		Realm: &realm_mgr_v1.Realm{
+			Id:   realmID,
+			Name: "Filtered Realm Renamed",
+		},
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.client.ReleaseRealm(ctx, &realm_mgr_v1.ReleaseRealmRequest{Id: realmID})
+	require.NoError(s.T(), err)
+
+	// assert
+	res, err := stream.Recv()
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), realm_mgr_v1.EnumRealmEventType_ENUM_REALM_EVENT_TYPE_RELEASED, res.GetEvent().Type)
+	assert.Equal(s.T(), realm_mgr_v1.EnumStatus_ENUM_STATUS_ACTIVE, res.GetEvent().Status)
+}
+
+func (s *WatchRealmsTestSuite) Test_WatchRealms_InvalidArgument() {
+	testCases := []struct {
+		name           string
+		req            *realm_mgr_v1.WatchRealmsRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "malformed sequence token",
+			req: &realm_mgr_v1.WatchRealmsRequest{
+				SequenceToken: "not-a-valid-token",
+			},
+			expectedErrMsg: "an invalid argument error occurred: argument sequenceToken is malformed",
+		},
+		{
+			name: "invalid realm ID",
+			req: &realm_mgr_v1.WatchRealmsRequest{
+				Id: "not-a-uuid",
+			},
+			expectedErrMsg: "invalid WatchRealmsRequest.Id: value must be a valid UUID | caused by: invalid uuid format",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			// arrange
+			ctx, err := utils.MakeGRPCRequestContext(context.Background())
+			require.NoError(t, err)
+
+			watchCtx, cancel := context.WithTimeout(ctx, watchTimeout)
+			defer cancel()
+
+			// act
+			stream, err := s.client.WatchRealms(watchCtx, tc.req)
+			require.NoError(t, err)
+
+			res, err := stream.Recv()
+
+			// assert
+			assert.Nil(t, res)
+
+			require.Error(t, err)
+
+			gRPCError, ok := status.FromError(err)
+			require.True(t, ok)
+
+			assert.Equal(t, codes.InvalidArgument, gRPCError.Code())
+			assert.Equal(t, tc.expectedErrMsg, gRPCError.Message())
+		})
+	}
+}
+
+func (s *WatchRealmsTestSuite) createRealm(ctx context.Context, name string) string {
+	res, err := s.client.CreateRealm(ctx, &realm_mgr_v1.CreateRealmRequest{
+		Name: name,
+	})
+	require.NoError(s.T(), err, "error creating realm")
+	return res.GetRealm().Id
+}
@@ -25,6 +25,14 @@ const (
 
 var Tables = []string{
 	models.RealmTableName,
+	models.AuditTableName,
+	models.RevisionTableName,
+	models.ReleaseScheduleTableName,
+	models.DecisionTableName,
+	models.ApprovalTableName,
+	models.TemplateTableName,
+	models.SlugTableName,
+	models.EventTableName,
 }
 
 type DB struct {
@@ -105,6 +113,35 @@ func (d *DB) ExecuteInsertQueries(ctx context.Context, queries ...sq.InsertBuild
 	return results, nil
 }
 
+// ExecuteOpenInsertQueries runs the queries in a transaction that is left open, so their
+// changes are held back from other connections until the returned commit function is called.
+// The returned rollback function is safe to defer.
+func (d *DB) ExecuteOpenInsertQueries(
+	ctx context.Context,
+	queries ...sq.InsertBuilder,
+) (commit func() error, rollback func() error, err error) {
+	conn, err := d.connProvider.TransactionalConn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, query := range queries {
+		if _, execErr := query.RunWith(conn).ExecContext(ctx); execErr != nil {
+			//nolint:errcheck // ignore transaction rollback error
+			d.connProvider.Rollback(conn)
+			return nil, nil, execErr
+		}
+	}
+
+	commit = func() error {
+		return d.connProvider.Commit(conn)
+	}
+	rollback = func() error {
+		return d.connProvider.Rollback(conn)
+	}
+	return commit, rollback, nil
+}
+
 func (d *DB) RunQuery(ctx context.Context, query sq.SelectBuilder) (*sql.Rows, error) {
 	conn := d.connProvider.Conn()
 	rows, err := query.RunWith(conn).QueryContext(ctx)
@@ -128,6 +165,14 @@ func (d *DB) GetRealms(query sq.SelectBuilder) ([]*entities.Realm, error) {
 		var realmKey uuid.UUID
 		var dbStatus string
 		var deletedAt sql.NullTime
+		var dbPreviousStatus sql.NullString
+		var dbLabels []byte
+		var dbAttributes []byte
+		var dbParentID uuid.NullUUID
+		var dbClonedFrom uuid.NullUUID
+		var dbTemplateID uuid.NullUUID
+		var dbTemplateVersion sql.NullInt64
+		var dbSlug sql.NullString
 
 		if scanErr := rows.Scan(
 			&realmKey,
@@ -138,6 +183,15 @@ func (d *DB) GetRealms(query sq.SelectBuilder) ([]*entities.Realm, error) {
 			&realm.CreatedAt,
 			&realm.UpdatedAt,
 			&deletedAt,
+			&dbPreviousStatus,
+			&realm.Revision,
+			&dbLabels,
+			&dbAttributes,
+			&dbParentID,
+			&dbClonedFrom,
+			&dbTemplateID,
+			&dbTemplateVersion,
+			&dbSlug,
 		); scanErr != nil {
 			return nil, scanErr
 		}
@@ -152,6 +206,33 @@ func (d *DB) GetRealms(query sq.SelectBuilder) ([]*entities.Realm, error) {
 			realm.DeletedAt = deletedAt.Time
 		}
 
+		if dbPreviousStatus.Valid {
+			previousStatus, previousOK := models.StatusDBValues[dbPreviousStatus.String]
+			if !previousOK {
+				return nil, fmt.Errorf("unexpected previous status type: %s", dbPreviousStatus.String)
+			}
+			realm.PreviousStatus = previousStatus
+		}
+
+		labels, labelsErr := models.LabelsFromDB(dbLabels)
+		if labelsErr != nil {
+			return nil, labelsErr
+		}
+		realm.Labels = labels
+
+		attributes, attributesErr := models.AttributesFromDB(dbAttributes)
+		if attributesErr != nil {
+			return nil, attributesErr
+		}
+		realm.Attributes = attributes
+		realm.ParentID = dbParentID.UUID
+		realm.ClonedFrom = dbClonedFrom.UUID
+		realm.Template = entities.RealmTemplateRef{
+			ID:      dbTemplateID.UUID,
+			Version: dbTemplateVersion.Int64,
+		}
+		realm.Slug = dbSlug.String
+
 		realms = append(realms, &realm)
 	}
 
@@ -161,3 +242,41 @@ func (d *DB) GetRealms(query sq.SelectBuilder) ([]*entities.Realm, error) {
 
 	return realms, nil
 }
+
+func (d *DB) GetAuditRecords(query sq.SelectBuilder) ([]*entities.AuditRecord, error) {
+	records := make([]*entities.AuditRecord, 0)
+
+	rows, err := d.RunQuery(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var record entities.AuditRecord
+
+		var dbAction string
+
+		if scanErr := rows.Scan(
+			&record.RealmID,
+			&dbAction,
+			&record.Reason,
+			&record.CreatedAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+
+		action, ok := models.AuditActionDBValues[dbAction]
+		if !ok {
+			return nil, fmt.Errorf("unexpected audit action type: %s", dbAction)
+		}
+		record.Action = action
+
+		records = append(records, &record)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, rowsErr
+	}
+
+	return records, nil
+}
@@ -42,5 +42,5 @@ func MakeGRPCRequestContext(ctx context.Context, headers ...string) (context.Con
 	if len(headers)%2 == 1 {
 		return nil, fmt.Errorf("headers should have even element count")
 	}
-	return metadata.AppendToOutgoingContext(ctx), nil
+	return metadata.AppendToOutgoingContext(ctx, headers...), nil
 }
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"database/sql"
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
@@ -19,6 +20,35 @@ func GenerateRealmInsertQueries(realms ...entities.Realm) ([]sq.InsertBuilder, e
 			return nil, fmt.Errorf("unexpected status type: %d", realm.Status)
 		}
 
+		var deletedAt interface{}
+		if !realm.DeletedAt.IsZero() {
+			deletedAt = realm.DeletedAt
+		}
+
+		var dbPreviousStatus interface{}
+		if realm.PreviousStatus != 0 {
+			previousStatus, previousOK := models.StatusEnumValues[realm.PreviousStatus]
+			if !previousOK {
+				return nil, fmt.Errorf("unexpected previous status type: %d", realm.PreviousStatus)
+			}
+			dbPreviousStatus = previousStatus
+		}
+
+		revision := realm.Revision
+		if revision == 0 {
+			revision = 1
+		}
+
+		labels, err := models.LabelsToDB(realm.Labels)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes, err := models.AttributesToDB(realm.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
 		query := sq.StatementBuilder.
 			PlaceholderFormat(sq.Dollar).
 			Insert(models.RealmTableName).
@@ -30,6 +60,16 @@ func GenerateRealmInsertQueries(realms ...entities.Realm) ([]sq.InsertBuilder, e
 				models.RealmColumnStatus.String(),
 				models.RealmColumnCreatedAt.String(),
 				models.RealmColumnUpdatedAt.String(),
+				models.RealmColumnDeletedAt.String(),
+				models.RealmColumnPreviousStatus.String(),
+				models.RealmColumnRevision.String(),
+				models.RealmColumnLabels.String(),
+				models.RealmColumnAttributes.String(),
+				models.RealmColumnParentID.String(),
+				models.RealmColumnClonedFrom.String(),
+				models.RealmColumnTemplateID.String(),
+				models.RealmColumnTemplateVer.String(),
+				models.RealmColumnSlug.String(),
 			).
 			Values(
 				uuid.New(),
@@ -39,6 +79,16 @@ func GenerateRealmInsertQueries(realms ...entities.Realm) ([]sq.InsertBuilder, e
 				dbStatus,
 				realm.CreatedAt,
 				realm.UpdatedAt,
+				deletedAt,
+				dbPreviousStatus,
+				revision,
+				labels,
+				attributes,
+				models.NullUUIDToDB(realm.ParentID),
+				models.NullUUIDToDB(realm.ClonedFrom),
+				models.NullUUIDToDB(realm.Template.ID),
+				sql.NullInt64{Int64: realm.Template.Version, Valid: realm.Template.ID != uuid.Nil},
+				sql.NullString{String: realm.Slug, Valid: realm.Slug != ""},
 			)
 		queries = append(queries, query)
 	}
@@ -46,6 +96,36 @@ func GenerateRealmInsertQueries(realms ...entities.Realm) ([]sq.InsertBuilder, e
 	return queries, nil
 }
 
+func GenerateRealmEventInsertQuery(event entities.RealmEvent) (sq.InsertBuilder, error) {
+	eventType, ok := models.RealmEventTypeEnumValues[event.Type]
+	if !ok {
+		return sq.InsertBuilder{}, fmt.Errorf("unexpected realm event type: %d", event.Type)
+	}
+
+	dbStatus, ok := models.StatusEnumValues[event.Status]
+	if !ok {
+		return sq.InsertBuilder{}, fmt.Errorf("unexpected status type: %d", event.Status)
+	}
+
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Insert(models.EventTableName).
+		Columns(
+			models.EventColumnRealmID.String(),
+			models.EventColumnType.String(),
+			models.EventColumnStatus.String(),
+			models.EventColumnOccurredAt.String(),
+		).
+		Values(
+			event.RealmID,
+			eventType,
+			dbStatus,
+			event.OccurredAt,
+		)
+
+	return query, nil
+}
+
 func GetRealmsQuery() sq.SelectBuilder {
 	query := sq.StatementBuilder.
 		PlaceholderFormat(sq.Dollar).
@@ -58,8 +138,35 @@ func GetRealmsQuery() sq.SelectBuilder {
 			models.RealmColumnCreatedAt.String(),
 			models.RealmColumnUpdatedAt.String(),
 			models.RealmColumnDeletedAt.String(),
+			models.RealmColumnPreviousStatus.String(),
+			models.RealmColumnRevision.String(),
+			models.RealmColumnLabels.String(),
+			models.RealmColumnAttributes.String(),
+			models.RealmColumnParentID.String(),
+			models.RealmColumnClonedFrom.String(),
+			models.RealmColumnTemplateID.String(),
+			models.RealmColumnTemplateVer.String(),
+			models.RealmColumnSlug.String(),
 		).
 		From(models.RealmTableName)
 
 	return query
 }
+
+func GetAuditRecordsQuery(realmID uuid.UUID) sq.SelectBuilder {
+	query := sq.StatementBuilder.
+		PlaceholderFormat(sq.Dollar).
+		Select(
+			models.AuditColumnRealmID.String(),
+			models.AuditColumnAction.String(),
+			models.AuditColumnReason.String(),
+			models.AuditColumnCreatedAt.String(),
+		).
+		From(models.AuditTableName).
+		Where(sq.Eq{
+			models.AuditColumnRealmID.String(): realmID,
+		}).
+		OrderBy(models.AuditColumnCreatedAt.String())
+
+	return query
+}